@@ -16,12 +16,13 @@ var ConsoleConfig *Config
 type (
 	// Config -.
 	Config struct {
-		App  `yaml:"app"`
-		HTTP `yaml:"http"`
-		Log  `yaml:"logger"`
-		DB   `yaml:"postgres"`
-		EA   `yaml:"ea"`
-		Auth `yaml:"auth"`
+		App     `yaml:"app"`
+		HTTP    `yaml:"http"`
+		Log     `yaml:"logger"`
+		DB      `yaml:"postgres"`
+		EA      `yaml:"ea"`
+		Auth    `yaml:"auth"`
+		Redfish `yaml:"redfish"`
 	}
 
 	// App -.
@@ -73,6 +74,41 @@ type (
 		UI                       UIAuthConfig  `yaml:"ui"`
 	}
 
+	// Redfish -.
+	Redfish struct {
+		Product                  string                    `yaml:"product" env:"REDFISH_PRODUCT"`
+		Vendor                   string                    `yaml:"vendor" env:"REDFISH_VENDOR"`
+		BasePath                 string                    `yaml:"basePath" env:"REDFISH_BASE_PATH"`
+		BasicAuth                bool                      `yaml:"basicAuth" env:"REDFISH_BASIC_AUTH"`
+		OEM                      map[string]map[string]any `yaml:"oem"`
+		DeviceTimeout            time.Duration             `yaml:"deviceTimeout" env:"REDFISH_DEVICE_TIMEOUT"`
+		DeviceLockTimeout        time.Duration             `yaml:"deviceLockTimeout" env:"REDFISH_DEVICE_LOCK_TIMEOUT"`
+		AsyncResets              bool                      `yaml:"asyncResets" env:"REDFISH_ASYNC_RESETS"`
+		ExpandWorkers            int                       `yaml:"expandWorkers" env:"REDFISH_EXPAND_WORKERS"`
+		BulkResetWorkers         int                       `yaml:"bulkResetWorkers" env:"REDFISH_BULK_RESET_WORKERS"`
+		BulkResetMaxTargets      int                       `yaml:"bulkResetMaxTargets" env:"REDFISH_BULK_RESET_MAX_TARGETS"`
+		DefaultPageSize          int                       `yaml:"defaultPageSize" env:"REDFISH_DEFAULT_PAGE_SIZE"`
+		FirmwareCacheSeconds     int                       `yaml:"firmwareCacheSeconds" env:"REDFISH_FIRMWARE_CACHE_SECONDS"`
+		FirmwareComponents       []string                  `yaml:"firmwareComponents" env:"REDFISH_FIRMWARE_COMPONENTS"`
+		UpdateableFirmware       []string                  `yaml:"updateableFirmware" env:"REDFISH_UPDATEABLE_FIRMWARE"`
+		EventDestinationNetworks []string                  `yaml:"eventDestinationNetworks" env:"REDFISH_EVENT_DESTINATION_NETWORKS"`
+		VerboseLogging           bool                      `yaml:"verboseLogging" env:"REDFISH_VERBOSE_LOGGING"`
+		StructuredLogging        bool                      `yaml:"structuredLogging" env:"REDFISH_STRUCTURED_LOGGING"`
+		MaxRequestBytes          int64                     `yaml:"maxRequestBytes" env:"REDFISH_MAX_REQUEST_BYTES"`
+		ActionRate               float64                   `yaml:"actionRate" env:"REDFISH_ACTION_RATE"`
+		ActionBurst              int                       `yaml:"actionBurst" env:"REDFISH_ACTION_BURST"`
+		ActionRateLimitPerDevice bool                      `yaml:"actionRateLimitPerDevice" env:"REDFISH_ACTION_RATE_LIMIT_PER_DEVICE"`
+		RetryAfterSeconds        int                       `yaml:"retryAfterSeconds" env:"REDFISH_RETRY_AFTER_SECONDS"`
+		CORSAllowedOrigins       []string                  `yaml:"corsAllowedOrigins" env:"REDFISH_CORS_ALLOWED_ORIGINS"`
+		HardwareInfoCacheTTL     time.Duration             `yaml:"hardwareInfoCacheTTL" env:"REDFISH_HARDWARE_INFO_CACHE_TTL"`
+		HardwareInfoCacheSize    int                       `yaml:"hardwareInfoCacheSize" env:"REDFISH_HARDWARE_INFO_CACHE_SIZE"`
+		CircuitBreakerThreshold  int                       `yaml:"circuitBreakerThreshold" env:"REDFISH_CIRCUIT_BREAKER_THRESHOLD"`
+		CircuitBreakerCooldown   time.Duration             `yaml:"circuitBreakerCooldown" env:"REDFISH_CIRCUIT_BREAKER_COOLDOWN"`
+		TimeoutErrorPatterns     []string                  `yaml:"timeoutErrorPatterns" env:"REDFISH_TIMEOUT_ERROR_PATTERNS"`
+		UpstreamErrorPatterns    []string                  `yaml:"upstreamErrorPatterns" env:"REDFISH_UPSTREAM_ERROR_PATTERNS"`
+		DeviceAuthErrorPatterns  []string                  `yaml:"deviceAuthErrorPatterns" env:"REDFISH_DEVICE_AUTH_ERROR_PATTERNS"`
+	}
+
 	// UIAuthConfig -.
 	UIAuthConfig struct {
 		ClientID                          string `yaml:"clientId"`
@@ -134,6 +170,24 @@ func NewConfig() (*Config, error) {
 				StrictDiscoveryDocumentValidation: true,
 			},
 		},
+		Redfish: Redfish{
+			BasePath:                "/redfish/v1",
+			DeviceTimeout:           30 * time.Second,
+			DeviceLockTimeout:       5 * time.Second,
+			ExpandWorkers:           8,
+			BulkResetWorkers:        8,
+			BulkResetMaxTargets:     100,
+			DefaultPageSize:         50,
+			FirmwareCacheSeconds:    300,
+			MaxRequestBytes:         1 << 20,
+			ActionRate:              1,
+			ActionBurst:             5,
+			RetryAfterSeconds:       30,
+			HardwareInfoCacheTTL:    10 * time.Second,
+			HardwareInfoCacheSize:   256,
+			CircuitBreakerThreshold: 5,
+			CircuitBreakerCooldown:  30 * time.Second,
+		},
 	}
 
 	// Define a command line flag for the config path
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	"github.com/gin-contrib/cors"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/device-management-toolkit/console/config"
 	consolehttp "github.com/device-management-toolkit/console/internal/controller/http"
+	redfishv1 "github.com/device-management-toolkit/console/internal/controller/http/redfish/v1"
 	wsv1 "github.com/device-management-toolkit/console/internal/controller/ws/v1"
 	"github.com/device-management-toolkit/console/internal/usecase"
 	"github.com/device-management-toolkit/console/pkg/db"
@@ -25,6 +27,26 @@ import (
 
 var Version = "DEVELOPMENT"
 
+// corsMiddleware applies the Redfish CORS policy to /redfish requests and the console policy to the rest
+func corsMiddleware(cfg *config.Config) gin.HandlerFunc {
+	defaultConfig := cors.DefaultConfig()
+	defaultConfig.AllowOrigins = cfg.AllowedOrigins
+	defaultConfig.AllowHeaders = cfg.AllowedHeaders
+
+	consoleCORS := cors.New(defaultConfig)
+	redfishCORS := redfishv1.RedfishCORSMiddleware(cfg)
+
+	return func(c *gin.Context) {
+		if strings.HasPrefix(c.Request.URL.Path, "/redfish") {
+			redfishCORS(c)
+
+			return
+		}
+
+		consoleCORS(c)
+	}
+}
+
 // Run creates objects via constructors.
 func Run(cfg *config.Config) {
 	log := logger.New(cfg.Level)
@@ -47,11 +69,7 @@ func Run(cfg *config.Config) {
 	// HTTP Server
 	handler := gin.New()
 
-	defaultConfig := cors.DefaultConfig()
-	defaultConfig.AllowOrigins = cfg.AllowedOrigins
-	defaultConfig.AllowHeaders = cfg.AllowedHeaders
-
-	handler.Use(cors.New(defaultConfig))
+	handler.Use(corsMiddleware(cfg))
 	consolehttp.NewRouter(handler, log, *usecases, cfg)
 
 	// Optionally enable pprof endpoints (e.g., for staging) via env ENABLE_PPROF=true
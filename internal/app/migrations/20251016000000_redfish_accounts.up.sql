@@ -0,0 +1,13 @@
+/*********************************************************************
+* Copyright (c) Intel Corporation 2025
+* SPDX-License-Identifier: Apache-2.0
+**********************************************************************/
+
+CREATE TABLE IF NOT EXISTS redfish_accounts(
+  user_name TEXT NOT NULL,
+  password_hash TEXT NOT NULL,
+  role_id TEXT NOT NULL,
+  enabled BOOLEAN NOT NULL,
+  locked BOOLEAN NOT NULL,
+  PRIMARY KEY (user_name)
+);
@@ -0,0 +1,411 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements Redfish API v1 AccountService resources.
+package v1
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"regexp"
+	"slices"
+	"sync"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// AccountService constants
+const (
+	accountServicePath = "/redfish/v1/AccountService"
+	accountsPath       = accountServicePath + "/Accounts"
+	roleAdministrator  = "Administrator"
+	roleOperator       = "Operator"
+	roleReadOnly       = "ReadOnly"
+	propertyUserName   = "UserName"
+	propertyPassword   = "Password"
+	propertyRoleID     = "RoleId"
+	minPasswordLength  = 8
+	// maxPasswordLength is the longest password bcrypt accepts
+	maxPasswordLength = 72
+)
+
+var (
+	// ErrAccountExists is returned when creating an account whose user name is already taken
+	ErrAccountExists = errors.New("account already exists")
+	// ErrWeakPassword is returned when a password does not meet the password policy
+	ErrWeakPassword = errors.New("password does not meet the password policy")
+)
+
+// allowedRoles are the Redfish standard roles an account can be assigned
+var allowedRoles = []string{roleAdministrator, roleOperator, roleReadOnly}
+
+// userNamePattern keeps user names safe to use as a URI path segment: it starts with a letter or
+// digit and contains only letters, digits, '.', '_', '-' and '@'
+var userNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._@-]{0,63}$`)
+
+// AccountRepository persists the accounts created through the AccountService
+type AccountRepository interface {
+	Get(ctx context.Context) ([]entity.RedfishAccount, error)
+	Insert(ctx context.Context, a *entity.RedfishAccount) error
+}
+
+// ManagerAccount represents a Redfish ManagerAccount. Password is always null on read.
+type ManagerAccount struct {
+	ODataID   string  `json:"@odata.id"`
+	ODataType string  `json:"@odata.type"`
+	ID        string  `json:"Id"`
+	Name      string  `json:"Name"`
+	UserName  string  `json:"UserName"`
+	RoleID    string  `json:"RoleId"`
+	Enabled   bool    `json:"Enabled"`
+	Locked    bool    `json:"Locked"`
+	Password  *string `json:"Password"`
+}
+
+// linkedUnder returns the account with its @odata.id under base
+func (a ManagerAccount) linkedUnder(base string) ManagerAccount {
+	a.ODataID = rebaseLink(base, a.ODataID)
+
+	return a
+}
+
+// createAccountRequest is the body of a POST to the Accounts collection
+type createAccountRequest struct {
+	UserName string `json:"UserName"`
+	Password string `json:"Password"`
+	RoleID   string `json:"RoleId"`
+}
+
+// storedAccount pairs an account with its password hash. Accounts seeded from
+// the configuration have no hash; their credentials stay in the config.
+type storedAccount struct {
+	account      ManagerAccount
+	passwordHash []byte
+}
+
+// AccountStore keeps the manager accounts known to the AccountService. Created accounts are
+// written through to the repository and cached in memory for authentication.
+type AccountStore struct {
+	mu       sync.RWMutex
+	accounts map[string]storedAccount
+	order    []string
+	cfg      *config.Config
+	repo     AccountRepository
+}
+
+// NewAccountStore returns a store seeded with the accounts defined by the auth configuration and
+// the accounts persisted in repo. A nil repo keeps created accounts in memory only.
+func NewAccountStore(ctx context.Context, cfg *config.Config, repo AccountRepository) (*AccountStore, error) {
+	s := &AccountStore{accounts: make(map[string]storedAccount), cfg: cfg, repo: repo}
+
+	for _, account := range configuredAccounts(cfg) {
+		s.accounts[account.ID] = storedAccount{account: account}
+		s.order = append(s.order, account.ID)
+	}
+
+	if repo == nil {
+		return s, nil
+	}
+
+	persisted, err := repo.Get(ctx)
+	if err != nil {
+		return s, err
+	}
+
+	for i := range persisted {
+		// the configured accounts take precedence over a persisted account of the same name
+		if _, exists := s.accounts[persisted[i].UserName]; exists {
+			continue
+		}
+
+		account := newManagerAccount(persisted[i].UserName, persisted[i].RoleID, persisted[i].Enabled)
+		account.Locked = persisted[i].Locked
+
+		s.accounts[account.ID] = storedAccount{account: account, passwordHash: []byte(persisted[i].PasswordHash)}
+		s.order = append(s.order, account.ID)
+	}
+
+	return s, nil
+}
+
+// List returns the accounts in creation order
+func (s *AccountStore) List() []ManagerAccount {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	accounts := make([]ManagerAccount, 0, len(s.order))
+	for _, username := range s.order {
+		accounts = append(accounts, s.accounts[username].account)
+	}
+
+	return accounts
+}
+
+// Get returns the account with the given user name
+func (s *AccountStore) Get(username string) (ManagerAccount, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stored, ok := s.accounts[username]
+
+	return stored.account, ok
+}
+
+// Authenticate returns the account username when it is enabled, not locked and password is its
+// password. Accounts seeded from the configuration are checked against the configured password.
+func (s *AccountStore) Authenticate(username, password string) (ManagerAccount, bool) {
+	s.mu.RLock()
+	stored, ok := s.accounts[username]
+	s.mu.RUnlock()
+
+	if !ok || !stored.account.Enabled || stored.account.Locked {
+		return ManagerAccount{}, false
+	}
+
+	if stored.passwordHash == nil {
+		if s.cfg == nil || s.cfg.Auth.AdminPassword == "" ||
+			subtle.ConstantTimeCompare([]byte(password), []byte(s.cfg.Auth.AdminPassword)) != 1 {
+			return ManagerAccount{}, false
+		}
+
+		return stored.account, true
+	}
+
+	if bcrypt.CompareHashAndPassword(stored.passwordHash, []byte(password)) != nil {
+		return ManagerAccount{}, false
+	}
+
+	return stored.account, true
+}
+
+// Create hashes the password and persists a new enabled account
+func (s *AccountStore) Create(ctx context.Context, username, password, role string) (ManagerAccount, error) {
+	if !strongPassword(password) {
+		return ManagerAccount{}, ErrWeakPassword
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return ManagerAccount{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.accounts[username]; exists {
+		return ManagerAccount{}, ErrAccountExists
+	}
+
+	if s.repo != nil {
+		err = s.repo.Insert(ctx, &entity.RedfishAccount{
+			UserName:     username,
+			PasswordHash: string(hash),
+			RoleID:       role,
+			Enabled:      true,
+		})
+
+		var notUnique sqldb.NotUniqueError
+		if errors.As(err, &notUnique) {
+			return ManagerAccount{}, ErrAccountExists
+		}
+
+		if err != nil {
+			return ManagerAccount{}, err
+		}
+	}
+
+	account := newManagerAccount(username, role, true)
+	s.accounts[username] = storedAccount{account: account, passwordHash: hash}
+	s.order = append(s.order, username)
+
+	return account, nil
+}
+
+// NewAccountServiceRoutes registers the Redfish AccountService routes over store, which is shared
+// with the Basic authentication of RedfishJWTAuthMiddleware.
+// Accounts are seeded from the configured auth users and only administrators may create accounts.
+// It exposes:
+// - GET /redfish/v1/AccountService
+// - GET /redfish/v1/AccountService/Accounts
+// - POST /redfish/v1/AccountService/Accounts
+// - GET /redfish/v1/AccountService/Accounts/:username
+func NewAccountServiceRoutes(r *gin.RouterGroup, store *AccountStore, cfg *config.Config, l logger.Interface) {
+	r.GET("/AccountService", accountServiceHandler(cfg))
+	r.GET("/AccountService/Accounts", accountsCollectionHandler(store))
+	r.POST("/AccountService/Accounts", RequireRole(roleAdministrator), createAccountHandler(store, l))
+	r.GET("/AccountService/Accounts/:username", accountHandler(store))
+
+	l.Info("Registered Redfish AccountService routes under %s", r.BasePath()+"/AccountService")
+}
+
+func accountServiceHandler(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		base := linkBase(c)
+
+		SetRedfishHeaders(c)
+		c.JSON(http.StatusOK, map[string]any{
+			"@odata.type":       "#AccountService.v1_5_0.AccountService",
+			"@odata.id":         rebaseLink(base, accountServicePath),
+			"Id":                "AccountService",
+			"Name":              "Account Service",
+			"ServiceEnabled":    cfg != nil && !cfg.Auth.Disabled,
+			"MinPasswordLength": minPasswordLength,
+			"MaxPasswordLength": maxPasswordLength,
+			"Accounts":          map[string]any{"@odata.id": rebaseLink(base, accountsPath)},
+		})
+	}
+}
+
+func accountsCollectionHandler(store *AccountStore) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		accounts := store.List()
+		base := linkBase(c)
+		members := make([]any, 0, len(accounts))
+
+		for i := range accounts {
+			members = append(members, map[string]any{"@odata.id": rebaseLink(base, accounts[i].ODataID)})
+		}
+
+		SetRedfishHeaders(c)
+		c.JSON(http.StatusOK, map[string]any{
+			"@odata.type":         "#ManagerAccountCollection.ManagerAccountCollection",
+			"@odata.id":           rebaseLink(base, accountsPath),
+			"Name":                "Accounts Collection",
+			"Members@odata.count": len(members),
+			"Members":             members,
+		})
+	}
+}
+
+func accountHandler(store *AccountStore) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username := c.Param("username")
+
+		account, ok := store.Get(username)
+		if !ok {
+			ResourceNotFoundError(c, "ManagerAccount", username)
+
+			return
+		}
+
+		SetRedfishHeaders(c)
+		c.JSON(http.StatusOK, account.linkedUnder(linkBase(c)))
+	}
+}
+
+// createAccountHandler validates and stores a new account
+func createAccountHandler(store *AccountStore, l logger.Interface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body createAccountRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			MalformedJSONError(c, err)
+
+			return
+		}
+
+		switch {
+		case body.UserName == "":
+			PropertyMissingError(c, propertyUserName)
+
+			return
+		case !userNamePattern.MatchString(body.UserName):
+			PropertyValueFormatError(c, body.UserName, propertyUserName)
+
+			return
+		case body.Password == "":
+			PropertyMissingError(c, propertyPassword)
+
+			return
+		case body.RoleID == "":
+			PropertyMissingError(c, propertyRoleID)
+
+			return
+		case !slices.Contains(allowedRoles, body.RoleID):
+			PropertyValueNotInListError(c, body.RoleID, propertyRoleID)
+
+			return
+		}
+
+		account, err := store.Create(c.Request.Context(), body.UserName, body.Password, body.RoleID)
+
+		switch {
+		case errors.Is(err, ErrWeakPassword):
+			PasswordPolicyError(c, minPasswordLength, maxPasswordLength)
+
+			return
+		case errors.Is(err, ErrAccountExists):
+			ResourceAlreadyExistsError(c, "ManagerAccount", body.UserName)
+
+			return
+		case err != nil:
+			l.Error(err, "http - redfish - create account %s [request %s]", body.UserName, requestID(c))
+			GeneralError(c)
+
+			return
+		}
+
+		account = account.linkedUnder(linkBase(c))
+
+		SetRedfishHeaders(c)
+		c.Header("Location", account.ODataID)
+		c.JSON(http.StatusCreated, account)
+	}
+}
+
+// configuredAccounts returns the accounts defined by the auth configuration.
+// Only the user name and role are exposed; credentials never leave the config.
+func configuredAccounts(cfg *config.Config) []ManagerAccount {
+	if cfg == nil || cfg.Auth.AdminUsername == "" {
+		return nil
+	}
+
+	return []ManagerAccount{newManagerAccount(cfg.Auth.AdminUsername, roleAdministrator, !cfg.Auth.Disabled)}
+}
+
+func newManagerAccount(username, role string, enabled bool) ManagerAccount {
+	return ManagerAccount{
+		ODataID:   accountsPath + "/" + username,
+		ODataType: "#ManagerAccount.v1_4_0.ManagerAccount",
+		ID:        username,
+		Name:      "User Account",
+		UserName:  username,
+		RoleID:    role,
+		Enabled:   enabled,
+	}
+}
+
+// strongPassword reports whether a password satisfies the length limits and
+// contains upper case, lower case, digit and special characters
+func strongPassword(password string) bool {
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return false
+	}
+
+	var upper, lower, digit, special bool
+
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			special = true
+		}
+	}
+
+	return upper && lower && digit && special
+}
@@ -0,0 +1,424 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+)
+
+var errAccountRepo = errors.New("account repository unavailable")
+
+// memoryAccountRepo is an AccountRepository that outlives the stores built over it
+type memoryAccountRepo struct {
+	accounts  []entity.RedfishAccount
+	getErr    error
+	insertErr error
+}
+
+func (r *memoryAccountRepo) Get(_ context.Context) ([]entity.RedfishAccount, error) {
+	return r.accounts, r.getErr
+}
+
+func (r *memoryAccountRepo) Insert(_ context.Context, a *entity.RedfishAccount) error {
+	if r.insertErr != nil {
+		return r.insertErr
+	}
+
+	r.accounts = append(r.accounts, *a)
+
+	return nil
+}
+
+func newTestAccountStore(t *testing.T, cfg *config.Config, repo AccountRepository) *AccountStore {
+	t.Helper()
+
+	store, err := NewAccountStore(context.Background(), cfg, repo)
+	require.NoError(t, err)
+
+	return store
+}
+
+func TestAccountServiceRoutes(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{}
+	cfg.Auth.AdminUsername = "admin"
+	cfg.Auth.AdminPassword = "S3cret!pass"
+
+	tests := []struct {
+		name           string
+		path           string
+		expectedStatus int
+		expectedBody   []string
+	}{
+		{
+			name:           "AccountService",
+			path:           "/redfish/v1/AccountService",
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{`"#AccountService.v1_5_0.AccountService"`, `"Accounts":{"@odata.id":"/redfish/v1/AccountService/Accounts"}`},
+		},
+		{
+			name:           "Accounts collection",
+			path:           "/redfish/v1/AccountService/Accounts",
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{`"Members@odata.count":1`, `"@odata.id":"/redfish/v1/AccountService/Accounts/admin"`},
+		},
+		{
+			name:           "single account",
+			path:           "/redfish/v1/AccountService/Accounts/admin",
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{`"#ManagerAccount.v1_4_0.ManagerAccount"`, `"UserName":"admin"`, `"RoleId":"Administrator"`, `"Password":null`},
+		},
+		{
+			name:           "unknown account",
+			path:           "/redfish/v1/AccountService/Accounts/operator",
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   []string{BaseResourceNotFoundID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).Times(1)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			NewAccountServiceRoutes(router.Group("/redfish/v1"), newTestAccountStore(t, cfg, nil), cfg, mockLogger)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, tt.path, http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.True(t, json.Valid(w.Body.Bytes()))
+			assert.NotContains(t, w.Body.String(), cfg.Auth.AdminPassword)
+
+			for _, expected := range tt.expectedBody {
+				assert.Contains(t, w.Body.String(), expected)
+			}
+		})
+	}
+}
+
+func TestConfiguredAccounts(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, configuredAccounts(nil))
+	assert.Empty(t, configuredAccounts(&config.Config{}))
+
+	cfg := &config.Config{}
+	cfg.Auth.AdminUsername = "admin"
+	cfg.Auth.Disabled = true
+
+	accounts := configuredAccounts(cfg)
+	require.Len(t, accounts, 1)
+	assert.Equal(t, "admin", accounts[0].UserName)
+	assert.False(t, accounts[0].Enabled)
+	assert.Nil(t, accounts[0].Password)
+}
+
+func TestCreateAccountHandler(t *testing.T) {
+	t.Parallel()
+
+	const jwtKey = "test-secret-key"
+
+	tests := []struct {
+		name           string
+		authHeader     string
+		requestBody    string
+		expectedStatus int
+		expectedMsgID  string
+	}{
+		{
+			name:           "administrator creates account",
+			authHeader:     createRoleJWT(jwtKey, roleAdministrator),
+			requestBody:    `{"UserName":"operator1","Password":"Str0ng!Pass","RoleId":"Operator"}`,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "console login token is the configured administrator",
+			authHeader:     createValidJWT(jwtKey),
+			requestBody:    `{"UserName":"operator1","Password":"Str0ng!Pass","RoleId":"Operator"}`,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "duplicate user name",
+			authHeader:     createRoleJWT(jwtKey, roleAdministrator),
+			requestBody:    `{"UserName":"admin","Password":"Str0ng!Pass","RoleId":"Operator"}`,
+			expectedStatus: http.StatusConflict,
+			expectedMsgID:  BaseResourceAlreadyExistsID,
+		},
+		{
+			name:           "operator lacks privilege",
+			authHeader:     createRoleJWT(jwtKey, roleOperator),
+			requestBody:    `{"UserName":"operator1","Password":"Str0ng!Pass","RoleId":"Operator"}`,
+			expectedStatus: http.StatusForbidden,
+			expectedMsgID:  BaseInsufficientPrivilegeID,
+		},
+		{
+			name:           "read-only lacks privilege",
+			authHeader:     createRoleJWT(jwtKey, roleReadOnly),
+			requestBody:    `{"UserName":"operator1","Password":"Str0ng!Pass","RoleId":"Operator"}`,
+			expectedStatus: http.StatusForbidden,
+			expectedMsgID:  BaseInsufficientPrivilegeID,
+		},
+		{
+			name:           "unknown role",
+			authHeader:     createRoleJWT(jwtKey, roleAdministrator),
+			requestBody:    `{"UserName":"operator1","Password":"Str0ng!Pass","RoleId":"Superuser"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyValueNotInListID,
+		},
+		{
+			name:           "weak password",
+			authHeader:     createRoleJWT(jwtKey, roleAdministrator),
+			requestBody:    `{"UserName":"operator1","Password":"password","RoleId":"Operator"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyValueFormatErrorID,
+		},
+		{
+			name:           "user name with a path separator",
+			authHeader:     createRoleJWT(jwtKey, roleAdministrator),
+			requestBody:    `{"UserName":"ops/admin","Password":"Str0ng!Pass","RoleId":"Operator"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyValueFormatErrorID,
+		},
+		{
+			name:           "user name with URI delimiters",
+			authHeader:     createRoleJWT(jwtKey, roleAdministrator),
+			requestBody:    `{"UserName":"op?x#y","Password":"Str0ng!Pass","RoleId":"Operator"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyValueFormatErrorID,
+		},
+		{
+			name:           "user name with a space",
+			authHeader:     createRoleJWT(jwtKey, roleAdministrator),
+			requestBody:    `{"UserName":"op one","Password":"Str0ng!Pass","RoleId":"Operator"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyValueFormatErrorID,
+		},
+		{
+			name:           "dot segment user name",
+			authHeader:     createRoleJWT(jwtKey, roleAdministrator),
+			requestBody:    `{"UserName":"..","Password":"Str0ng!Pass","RoleId":"Operator"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyValueFormatErrorID,
+		},
+		{
+			name:           "missing password",
+			authHeader:     createRoleJWT(jwtKey, roleAdministrator),
+			requestBody:    `{"UserName":"operator1","RoleId":"Operator"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyMissingID,
+		},
+		{
+			name:           "malformed JSON",
+			authHeader:     createRoleJWT(jwtKey, roleAdministrator),
+			requestBody:    `{"UserName":`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BaseMalformedJSONID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			cfg := &config.Config{}
+			cfg.Auth.AdminUsername = "admin"
+			cfg.Auth.JWTKey = jwtKey
+
+			store := newTestAccountStore(t, cfg, nil)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.Use(RedfishJWTAuthMiddleware(cfg, nil))
+			router.POST(accountsPath, RequireRole(roleAdministrator), createAccountHandler(store, mocks.NewMockLogger(ctrl)))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, accountsPath, strings.NewReader(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", tt.authHeader)
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.NotContains(t, w.Body.String(), "Str0ng!Pass")
+
+			if tt.expectedMsgID != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedMsgID)
+				assert.Len(t, store.List(), 1)
+
+				return
+			}
+
+			assert.Equal(t, accountsPath+"/operator1", w.Header().Get("Location"))
+
+			account, ok := store.Get("operator1")
+			require.True(t, ok)
+			assert.Equal(t, roleOperator, account.RoleID)
+			assert.True(t, account.Enabled)
+		})
+	}
+}
+
+func TestAccountStoreHashesPasswords(t *testing.T) {
+	t.Parallel()
+
+	store := newTestAccountStore(t, nil, nil)
+
+	_, err := store.Create(context.Background(), "operator1", "Str0ng!Pass", roleOperator)
+	require.NoError(t, err)
+
+	stored := store.accounts["operator1"]
+	assert.NotEqual(t, []byte("Str0ng!Pass"), stored.passwordHash)
+	assert.NoError(t, bcrypt.CompareHashAndPassword(stored.passwordHash, []byte("Str0ng!Pass")))
+
+	_, err = store.Create(context.Background(), "operator1", "Str0ng!Pass", roleOperator)
+	assert.ErrorIs(t, err, ErrAccountExists)
+}
+
+func TestAccountStorePersistsAccounts(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{}
+	cfg.Auth.AdminUsername = "admin"
+
+	repo := &memoryAccountRepo{}
+	store := newTestAccountStore(t, cfg, repo)
+
+	_, err := store.Create(context.Background(), "operator1", "Str0ng!Pass", roleOperator)
+	require.NoError(t, err)
+	require.Len(t, repo.accounts, 1)
+	assert.Equal(t, "operator1", repo.accounts[0].UserName)
+	assert.NotContains(t, repo.accounts[0].PasswordHash, "Str0ng!Pass")
+
+	// a store built over the same repository, as after a restart, still knows the account
+	restarted := newTestAccountStore(t, cfg, repo)
+
+	account, ok := restarted.Get("operator1")
+	require.True(t, ok)
+	assert.Equal(t, roleOperator, account.RoleID)
+	assert.Equal(t, accountsPath+"/operator1", account.ODataID)
+	assert.Len(t, restarted.List(), 2)
+
+	_, ok = restarted.Authenticate("operator1", "Str0ng!Pass")
+	assert.True(t, ok)
+}
+
+func TestAccountStoreRepositoryErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewAccountStore(context.Background(), nil, &memoryAccountRepo{getErr: errAccountRepo})
+	require.ErrorIs(t, err, errAccountRepo)
+
+	duplicate := newTestAccountStore(t, nil, &memoryAccountRepo{insertErr: sqldb.ErrRedfishAccountNotUnique.Wrap("user_name")})
+	_, err = duplicate.Create(context.Background(), "operator1", "Str0ng!Pass", roleOperator)
+	require.ErrorIs(t, err, ErrAccountExists)
+
+	failing := newTestAccountStore(t, nil, &memoryAccountRepo{insertErr: errAccountRepo})
+	_, err = failing.Create(context.Background(), "operator1", "Str0ng!Pass", roleOperator)
+	require.ErrorIs(t, err, errAccountRepo)
+
+	_, ok := failing.Get("operator1")
+	assert.False(t, ok, "an account the repository rejected must not be served")
+}
+
+func TestAccountStoreAuthenticate(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{}
+	cfg.Auth.AdminUsername = "admin"
+	cfg.Auth.AdminPassword = "Adm1n!Pass"
+
+	store := newTestAccountStore(t, cfg, nil)
+
+	_, err := store.Create(context.Background(), "operator1", "Str0ng!Pass", roleOperator)
+	require.NoError(t, err)
+
+	authDisabled := *cfg
+	authDisabled.Disabled = true
+
+	tests := []struct {
+		name     string
+		store    *AccountStore
+		username string
+		password string
+		ok       bool
+	}{
+		{name: "configured account", store: store, username: "admin", password: "Adm1n!Pass", ok: true},
+		{name: "configured account with wrong password", store: store, username: "admin", password: "Str0ng!Pass"},
+		{name: "created account", store: store, username: "operator1", password: "Str0ng!Pass", ok: true},
+		{name: "created account with wrong password", store: store, username: "operator1", password: "Adm1n!Pass"},
+		{name: "unknown account", store: store, username: "nobody", password: "Str0ng!Pass"},
+		{name: "disabled account", store: newTestAccountStore(t, &authDisabled, nil), username: "admin", password: "Adm1n!Pass"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			account, ok := tt.store.Authenticate(tt.username, tt.password)
+			assert.Equal(t, tt.ok, ok)
+
+			if tt.ok {
+				assert.Equal(t, tt.username, account.UserName)
+			}
+		})
+	}
+}
+
+func TestStrongPassword(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		password string
+		expected bool
+	}{
+		{password: "Str0ng!Pass", expected: true},
+		{password: "S0!a", expected: false},
+		{password: "nouppercase1!", expected: false},
+		{password: "NOLOWERCASE1!", expected: false},
+		{password: "NoDigits!!", expected: false},
+		{password: "NoSpecial12", expected: false},
+		{password: "Aa1!" + strings.Repeat("x", maxPasswordLength), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, strongPassword(tt.password))
+		})
+	}
+}
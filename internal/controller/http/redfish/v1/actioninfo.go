@@ -0,0 +1,71 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements Redfish API v1 ActionInfo resources.
+package v1
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ActionInfo constants
+const (
+	resetActionInfoID        = "ResetActionInfo"
+	actionInfoDataTypeString = "String"
+)
+
+// resetTypeAllowableValues lists the ResetType values ComputerSystem.Reset accepts, in sorted order
+var resetTypeAllowableValues = slices.Sorted(maps.Keys(resetTypeToAction))
+
+// ActionInfo represents a Redfish ActionInfo resource describing the parameters of an action
+type ActionInfo struct {
+	ODataID    string                `json:"@odata.id"`
+	ODataType  string                `json:"@odata.type"`
+	ID         string                `json:"Id"`
+	Name       string                `json:"Name"`
+	Parameters []ActionInfoParameter `json:"Parameters"`
+}
+
+// ActionInfoParameter describes one parameter of an action
+type ActionInfoParameter struct {
+	Name            string   `json:"Name"`
+	Required        bool     `json:"Required"`
+	DataType        string   `json:"DataType"`
+	AllowableValues []string `json:"AllowableValues,omitempty"`
+}
+
+func resetActionInfoPath(base, systemID string) string {
+	return systemPath(base, systemID) + "/" + resetActionInfoID
+}
+
+// getResetActionInfoHandler describes the parameters of ComputerSystem.Reset. Like the action
+// itself, it only checks that the id can name a system and does not contact the device.
+func getResetActionInfoHandler(c *gin.Context) {
+	systemID := c.Param("id")
+
+	if !validateSystemID(c, systemID) {
+		return
+	}
+
+	SetRedfishHeaders(c)
+	c.JSON(http.StatusOK, ActionInfo{
+		ODataID:   resetActionInfoPath(linkBase(c), systemID),
+		ODataType: "#ActionInfo.v1_1_0.ActionInfo",
+		ID:        resetActionInfoID,
+		Name:      "Reset Action Info",
+		Parameters: []ActionInfoParameter{
+			{
+				Name:            propertyResetType,
+				Required:        true,
+				DataType:        actionInfoDataTypeString,
+				AllowableValues: resetTypeAllowableValues,
+			},
+		},
+	})
+}
@@ -0,0 +1,82 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetResetActionInfoHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		systemID       string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "describes the ResetType parameter",
+			systemID:       testSystemGUID,
+			expectedStatus: http.StatusOK,
+			expectedBody: `{
+				"@odata.id": "/redfish/v1/Systems/` + testSystemGUID + `/ResetActionInfo",
+				"@odata.type": "#ActionInfo.v1_1_0.ActionInfo",
+				"Id": "ResetActionInfo",
+				"Name": "Reset Action Info",
+				"Parameters": [{
+					"Name": "ResetType",
+					"Required": true,
+					"DataType": "String",
+					"AllowableValues": ["ForceOff", "ForceRestart", "On", "PowerCycle"]
+				}]
+			}`,
+		},
+		{
+			name:           "invalid system id",
+			systemID:       "not-a-guid",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/redfish/v1/Systems/:id/ResetActionInfo", getResetActionInfoHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet,
+				"/redfish/v1/Systems/"+tt.systemID+"/ResetActionInfo", http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, w.Body.String())
+				assert.Equal(t, "4.0", w.Header().Get("OData-Version"))
+
+				return
+			}
+
+			var body RedfishError
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, BaseResourceNotFoundID, body.Error.Code)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements authentication and authorization for the Redfish API v1.
+package v1
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/device-management-toolkit/console/config"
+)
+
+// Authentication and authorization context
+const (
+	// roleContextKey holds the caller's role once the JWT middleware has verified the token
+	roleContextKey = "redfishRole"
+	roleClaim      = "role"
+)
+
+// authTokenHeader is the Redfish session token header
+const authTokenHeader = "X-Auth-Token"
+
+// basicAuthChallenge is the WWW-Authenticate value sent with 401 responses when Basic auth is enabled
+const basicAuthChallenge = `Basic realm="Redfish"`
+
+// requestToken returns the token of the request, taken from X-Auth-Token when present and from
+// the Authorization bearer header otherwise
+func requestToken(c *gin.Context) string {
+	if token := c.GetHeader(authTokenHeader); token != "" {
+		return token
+	}
+
+	return strings.Replace(c.GetHeader("Authorization"), "Bearer ", "", 1)
+}
+
+// RedfishJWTAuthMiddleware provides Redfish-compliant authentication error responses. The token is
+// read from the X-Auth-Token header used by Redfish sessions or from an Authorization bearer header;
+// both are validated the same way. When redfish.basicAuth is enabled, requests without an
+// X-Auth-Token may instead send Authorization: Basic credentials, checked against accounts, and
+// 401 responses carry a WWW-Authenticate: Basic challenge.
+func RedfishJWTAuthMiddleware(cfg *config.Config, accounts *AccountStore) gin.HandlerFunc {
+	basicAuth := cfg.Redfish.BasicAuth && accounts != nil
+
+	unauthorized := func(c *gin.Context) {
+		if basicAuth {
+			c.Header("WWW-Authenticate", basicAuthChallenge)
+		}
+
+		NoValidSessionError(c)
+		c.Abort()
+	}
+
+	return func(c *gin.Context) {
+		if basicAuth && c.GetHeader(authTokenHeader) == "" {
+			if username, password, ok := c.Request.BasicAuth(); ok {
+				account, ok := accounts.Authenticate(username, password)
+				if !ok {
+					unauthorized(c)
+
+					return
+				}
+
+				c.Set(roleContextKey, account.RoleID)
+				c.Next()
+
+				return
+			}
+		}
+
+		tokenString := requestToken(c)
+
+		if tokenString == "" {
+			unauthorized(c)
+
+			return
+		}
+
+		// if clientID is set, use the oidc verifier (this would need the verifier passed in)
+		if cfg.ClientID != "" {
+			// For OAuth/OIDC, we'd need to pass the verifier or handle differently
+			// For now, return a general authentication error
+			unauthorized(c)
+
+			return
+		}
+
+		claims := &jwt.MapClaims{}
+
+		token, err := jwt.ParseWithClaims(tokenString, claims, func(_ *jwt.Token) (interface{}, error) {
+			return []byte(cfg.JWTKey), nil
+		})
+
+		if err != nil || !token.Valid {
+			unauthorized(c)
+
+			return
+		}
+
+		c.Set(roleContextKey, claimedRole(*claims))
+		c.Next()
+	}
+}
+
+// claimedRole returns the role claim of a verified token. Tokens issued by the console
+// login carry no role claim; they are only issued to the configured administrator.
+func claimedRole(claims jwt.MapClaims) string {
+	if role, ok := claims[roleClaim].(string); ok && role != "" {
+		return role
+	}
+
+	return roleAdministrator
+}
+
+// roleRank orders the Redfish standard roles; a role satisfies every role ranked at or below it
+var roleRank = map[string]int{roleReadOnly: 1, roleOperator: 2, roleAdministrator: 3}
+
+// callerHasRole reports whether the authenticated caller holds the given role or a more privileged one.
+// Without a role in the context the routes are unauthenticated (auth disabled) and every caller is allowed.
+func callerHasRole(c *gin.Context, role string) bool {
+	callerRole, ok := c.Get(roleContextKey)
+	if !ok {
+		return true
+	}
+
+	name, _ := callerRole.(string)
+
+	return roleRank[name] > 0 && roleRank[name] >= roleRank[role]
+}
+
+// RequireRole rejects callers whose token does not grant the given role with InsufficientPrivilege (403).
+// It must run after RedfishJWTAuthMiddleware.
+func RequireRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !callerHasRole(c, role) {
+			InsufficientPrivilegeError(c)
+			c.Abort()
+
+			return
+		}
+
+		c.Next()
+	}
+}
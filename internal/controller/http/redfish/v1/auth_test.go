@@ -0,0 +1,403 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/config"
+)
+
+func TestRedfishJWTAuthMiddleware(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		authHeader     string
+		xAuthToken     string
+		config         *config.Config
+		expectedStatus int
+		checkResponse  func(t *testing.T, body string, headers http.Header)
+	}{
+		{
+			name:       "missing authorization header",
+			authHeader: "",
+			config: &config.Config{
+				Auth: config.Auth{
+					Disabled: false,
+					JWTKey:   "test-secret-key",
+				},
+			},
+			expectedStatus: http.StatusUnauthorized,
+			checkResponse: func(t *testing.T, body string, headers http.Header) {
+				t.Helper()
+				assert.Contains(t, body, `"Base.1.11.0.NoValidSession"`)
+				assert.Contains(t, body, "no valid session established")
+				assert.Equal(t, "application/json; charset=utf-8", headers.Get("Content-Type"))
+				assert.Equal(t, "4.0", headers.Get("OData-Version"))
+			},
+		},
+		{
+			name:       "invalid JWT token",
+			authHeader: "Bearer invalid.jwt.token",
+			config: &config.Config{
+				Auth: config.Auth{
+					Disabled: false,
+					JWTKey:   "test-secret-key",
+				},
+			},
+			expectedStatus: http.StatusUnauthorized,
+			checkResponse: func(t *testing.T, body string, _ http.Header) {
+				t.Helper()
+				assert.Contains(t, body, `"Base.1.11.0.NoValidSession"`)
+				assert.Contains(t, body, "no valid session established")
+			},
+		},
+		{
+			name:       "expired JWT token",
+			authHeader: createExpiredJWT("test-secret-key"),
+			config: &config.Config{
+				Auth: config.Auth{
+					Disabled: false,
+					JWTKey:   "test-secret-key",
+				},
+			},
+			expectedStatus: http.StatusUnauthorized,
+			checkResponse: func(t *testing.T, body string, _ http.Header) {
+				t.Helper()
+				assert.Contains(t, body, `"Base.1.11.0.NoValidSession"`)
+			},
+		},
+		{
+			name:       "valid JWT token",
+			authHeader: createValidJWT("test-secret-key"),
+			config: &config.Config{
+				Auth: config.Auth{
+					Disabled: false,
+					JWTKey:   "test-secret-key",
+				},
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, body string, _ http.Header) {
+				t.Helper()
+				assert.Equal(t, "success", body)
+			},
+		},
+		{
+			name:       "valid X-Auth-Token",
+			xAuthToken: strings.TrimPrefix(createValidJWT("test-secret-key"), "Bearer "),
+			config: &config.Config{
+				Auth: config.Auth{
+					Disabled: false,
+					JWTKey:   "test-secret-key",
+				},
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, body string, _ http.Header) {
+				t.Helper()
+				assert.Equal(t, "success", body)
+			},
+		},
+		{
+			name:       "invalid X-Auth-Token",
+			xAuthToken: "invalid.jwt.token",
+			config: &config.Config{
+				Auth: config.Auth{
+					Disabled: false,
+					JWTKey:   "test-secret-key",
+				},
+			},
+			expectedStatus: http.StatusUnauthorized,
+			checkResponse: func(t *testing.T, body string, _ http.Header) {
+				t.Helper()
+				assert.Contains(t, body, `"Base.1.11.0.NoValidSession"`)
+			},
+		},
+		{
+			name:       "X-Auth-Token preferred over bearer",
+			authHeader: "Bearer invalid.jwt.token",
+			xAuthToken: strings.TrimPrefix(createValidJWT("test-secret-key"), "Bearer "),
+			config: &config.Config{
+				Auth: config.Auth{
+					Disabled: false,
+					JWTKey:   "test-secret-key",
+				},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid X-Auth-Token is not rescued by a valid bearer",
+			authHeader: createValidJWT("test-secret-key"),
+			xAuthToken: "invalid.jwt.token",
+			config: &config.Config{
+				Auth: config.Auth{
+					Disabled: false,
+					JWTKey:   "test-secret-key",
+				},
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "OAuth/OIDC config - not implemented",
+			authHeader: "Bearer some.oauth.token",
+			config: &config.Config{
+				Auth: config.Auth{
+					Disabled: false,
+					ClientID: "oauth-client-id",
+					JWTKey:   "test-secret-key",
+				},
+			},
+			expectedStatus: http.StatusUnauthorized,
+			checkResponse: func(t *testing.T, body string, _ http.Header) {
+				t.Helper()
+				assert.Contains(t, body, `"Base.1.11.0.NoValidSession"`)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup Gin
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+
+			// Add the middleware
+			router.Use(RedfishJWTAuthMiddleware(tt.config, nil))
+
+			// Add a test endpoint
+			router.GET("/test", func(c *gin.Context) {
+				c.String(http.StatusOK, "success")
+			})
+
+			// Create request
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), "GET", "/test", http.NoBody)
+
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+
+			if tt.xAuthToken != "" {
+				req.Header.Set(authTokenHeader, tt.xAuthToken)
+			}
+
+			// Execute request
+			router.ServeHTTP(w, req)
+
+			// Assertions
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.checkResponse != nil {
+				tt.checkResponse(t, w.Body.String(), w.Header())
+			}
+		})
+	}
+}
+
+func TestClaimedRole(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, roleOperator, claimedRole(jwt.MapClaims{"role": roleOperator}))
+	assert.Equal(t, roleAdministrator, claimedRole(jwt.MapClaims{}))
+	assert.Equal(t, roleAdministrator, claimedRole(jwt.MapClaims{"role": ""}))
+}
+
+func TestCallerHasRole(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	assert.True(t, callerHasRole(c, roleAdministrator), "unauthenticated routes allow every caller")
+
+	c.Set(roleContextKey, roleReadOnly)
+	assert.False(t, callerHasRole(c, roleAdministrator))
+	assert.True(t, callerHasRole(c, roleReadOnly))
+
+	c.Set(roleContextKey, roleAdministrator)
+	assert.True(t, callerHasRole(c, roleOperator), "administrators hold every lesser role")
+
+	c.Set(roleContextKey, "Custom")
+	assert.False(t, callerHasRole(c, roleReadOnly), "unknown roles grant nothing")
+}
+
+func TestRequireRole(t *testing.T) {
+	t.Parallel()
+
+	const jwtKey = "test-secret-key"
+
+	tests := []struct {
+		name           string
+		requiredRole   string
+		authHeader     string
+		expectedStatus int
+	}{
+		{name: "administrator on operator route", requiredRole: roleOperator, authHeader: createRoleJWT(jwtKey, roleAdministrator), expectedStatus: http.StatusOK},
+		{name: "operator on operator route", requiredRole: roleOperator, authHeader: createRoleJWT(jwtKey, roleOperator), expectedStatus: http.StatusOK},
+		{name: "read-only on operator route", requiredRole: roleOperator, authHeader: createRoleJWT(jwtKey, roleReadOnly), expectedStatus: http.StatusForbidden},
+		{name: "administrator on administrator route", requiredRole: roleAdministrator, authHeader: createRoleJWT(jwtKey, roleAdministrator), expectedStatus: http.StatusOK},
+		{name: "operator on administrator route", requiredRole: roleAdministrator, authHeader: createRoleJWT(jwtKey, roleOperator), expectedStatus: http.StatusForbidden},
+		{name: "read-only on administrator route", requiredRole: roleAdministrator, authHeader: createRoleJWT(jwtKey, roleReadOnly), expectedStatus: http.StatusForbidden},
+		{name: "missing token", requiredRole: roleOperator, authHeader: "", expectedStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &config.Config{}
+			cfg.Auth.JWTKey = jwtKey
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.Use(RedfishJWTAuthMiddleware(cfg, nil))
+			router.POST("/protected", RequireRole(tt.requiredRole), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/protected", http.NoBody)
+			req.Header.Set("Authorization", tt.authHeader)
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusForbidden {
+				assert.Contains(t, w.Body.String(), BaseInsufficientPrivilegeID)
+			}
+		})
+	}
+}
+
+func TestRedfishJWTAuthMiddlewareBasic(t *testing.T) {
+	t.Parallel()
+
+	const jwtKey = "test-secret-key"
+
+	cfg := &config.Config{}
+	cfg.Auth.AdminUsername = "admin"
+	cfg.Auth.AdminPassword = "Adm1n!Pass"
+	cfg.Auth.JWTKey = jwtKey
+	cfg.Redfish.BasicAuth = true
+
+	accounts := newTestAccountStore(t, cfg, nil)
+
+	_, err := accounts.Create(context.Background(), "operator1", "Str0ng!Pass", roleOperator)
+	require.NoError(t, err)
+
+	disabled := *cfg
+	disabled.Redfish.BasicAuth = false
+
+	tests := []struct {
+		name              string
+		cfg               *config.Config
+		username          string
+		password          string
+		xAuthToken        string
+		expectedStatus    int
+		expectedRole      string
+		expectedChallenge string
+	}{
+		{name: "configured administrator", cfg: cfg, username: "admin", password: "Adm1n!Pass", expectedStatus: http.StatusOK, expectedRole: roleAdministrator},
+		{name: "created account", cfg: cfg, username: "operator1", password: "Str0ng!Pass", expectedStatus: http.StatusOK, expectedRole: roleOperator},
+		{name: "wrong password", cfg: cfg, username: "operator1", password: "wrong", expectedStatus: http.StatusUnauthorized, expectedChallenge: basicAuthChallenge},
+		{name: "unknown user", cfg: cfg, username: "nobody", password: "Str0ng!Pass", expectedStatus: http.StatusUnauthorized, expectedChallenge: basicAuthChallenge},
+		{name: "no credentials", cfg: cfg, expectedStatus: http.StatusUnauthorized, expectedChallenge: basicAuthChallenge},
+		{
+			name: "X-Auth-Token takes precedence", cfg: cfg, username: "operator1", password: "wrong",
+			xAuthToken: strings.TrimPrefix(createRoleJWT(jwtKey, roleReadOnly), "Bearer "), expectedStatus: http.StatusOK, expectedRole: roleReadOnly,
+		},
+		{name: "Basic disabled", cfg: &disabled, username: "admin", password: "Adm1n!Pass", expectedStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var role any
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.Use(RedfishJWTAuthMiddleware(tt.cfg, accounts))
+			router.GET("/protected", func(c *gin.Context) {
+				role, _ = c.Get(roleContextKey)
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/protected", http.NoBody)
+
+			if tt.username != "" {
+				req.SetBasicAuth(tt.username, tt.password)
+			}
+
+			if tt.xAuthToken != "" {
+				req.Header.Set(authTokenHeader, tt.xAuthToken)
+			}
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedChallenge, w.Header().Get("WWW-Authenticate"))
+
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tt.expectedRole, role)
+			} else {
+				assert.Contains(t, w.Body.String(), BaseNoValidSessionID)
+			}
+		})
+	}
+}
+
+// Helper functions for JWT token creation
+
+func createValidJWT(secretKey string) string {
+	claims := jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenString, _ := token.SignedString([]byte(secretKey))
+
+	return "Bearer " + tokenString
+}
+
+func createRoleJWT(secretKey, role string) string {
+	claims := jwt.MapClaims{
+		"exp":  jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		"role": role,
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenString, _ := token.SignedString([]byte(secretKey))
+
+	return "Bearer " + tokenString
+}
+
+func createExpiredJWT(secretKey string) string {
+	claims := jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Hour)), // Expired 1 hour ago
+		IssuedAt:  jwt.NewNumericDate(time.Now().Add(-2 * time.Hour)),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenString, _ := token.SignedString([]byte(secretKey))
+
+	return "Bearer " + tokenString
+}
@@ -0,0 +1,63 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements the configurable link prefix of the Redfish API v1.
+package v1
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/config"
+)
+
+// DefaultRedfishBasePath is the prefix of every Redfish link when none is configured
+const DefaultRedfishBasePath = "/redfish/v1"
+
+// basePathContextKey is the gin context key holding the link prefix of the request
+const basePathContextKey = "redfishBasePath"
+
+// redfishBasePath returns the configured link prefix without a trailing slash, falling back to
+// DefaultRedfishBasePath
+func redfishBasePath(cfg *config.Config) string {
+	if cfg == nil || cfg.Redfish.BasePath == "" {
+		return DefaultRedfishBasePath
+	}
+
+	return strings.TrimRight(cfg.Redfish.BasePath, "/")
+}
+
+// RedfishBasePathMiddleware records the configured base path for the handlers, which build every
+// link of their responses under it, for deployments served under another prefix behind a reverse
+// proxy. Routing is unchanged: the proxy is expected to strip its prefix.
+func RedfishBasePathMiddleware(cfg *config.Config) gin.HandlerFunc {
+	basePath := redfishBasePath(cfg)
+
+	return func(c *gin.Context) {
+		c.Set(basePathContextKey, basePath)
+		c.Next()
+	}
+}
+
+// linkBase returns the link prefix recorded by RedfishBasePathMiddleware, or DefaultRedfishBasePath
+// when the middleware did not run
+func linkBase(c *gin.Context) string {
+	if base := c.GetString(basePathContextKey); base != "" {
+		return base
+	}
+
+	return DefaultRedfishBasePath
+}
+
+// rebaseLink moves link, a path under DefaultRedfishBasePath such as the path constants of the
+// resources, under base. Other links are returned unchanged.
+func rebaseLink(base, link string) string {
+	if link == DefaultRedfishBasePath || strings.HasPrefix(link, DefaultRedfishBasePath+"/") {
+		return base + strings.TrimPrefix(link, DefaultRedfishBasePath)
+	}
+
+	return link
+}
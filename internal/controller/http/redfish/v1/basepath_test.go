@@ -0,0 +1,195 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+
+	"github.com/device-management-toolkit/console/config"
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const customBasePath = "/console/redfish/v1"
+
+func basePathConfig(basePath string) *config.Config {
+	cfg := &config.Config{}
+	cfg.Redfish.BasePath = basePath
+
+	return cfg
+}
+
+func TestRedfishBasePath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		cfg      *config.Config
+		expected string
+	}{
+		{name: "nil config", cfg: nil, expected: DefaultRedfishBasePath},
+		{name: "not configured", cfg: basePathConfig(""), expected: DefaultRedfishBasePath},
+		{name: "custom prefix", cfg: basePathConfig(customBasePath), expected: customBasePath},
+		{name: "trailing slash", cfg: basePathConfig(customBasePath + "/"), expected: customBasePath},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, redfishBasePath(tt.cfg))
+		})
+	}
+}
+
+func TestRebaseLink(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		link     string
+		expected string
+	}{
+		{name: "service root", link: DefaultRedfishBasePath, expected: customBasePath},
+		{name: "resource", link: taskServicePath + "/Tasks/1", expected: customBasePath + "/TaskService/Tasks/1"},
+		{name: "longer version segment", link: "/redfish/v10", expected: "/redfish/v10"},
+		{name: "external URL", link: "https://listener.example/redfish/v1/events", expected: "https://listener.example/redfish/v1/events"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, rebaseLink(customBasePath, tt.link))
+		})
+	}
+}
+
+func TestLinkBase(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		middleware bool
+		basePath   string
+		expected   string
+	}{
+		{name: "custom prefix", middleware: true, basePath: customBasePath, expected: customBasePath},
+		{name: "not configured", middleware: true, expected: DefaultRedfishBasePath},
+		{name: "without the middleware", expected: DefaultRedfishBasePath},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+
+			if tt.middleware {
+				router.Use(RedfishBasePathMiddleware(basePathConfig(tt.basePath)))
+			}
+
+			router.GET("/resource", func(c *gin.Context) {
+				c.String(http.StatusOK, linkBase(c))
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/resource", http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Body.String())
+		})
+	}
+}
+
+func TestBasePathSystemLinks(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().GetPowerState(gomock.Any(), testSystemGUID).Return(dto.PowerState{PowerState: cimPowerOn}, nil).AnyTimes()
+	mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(dto.HardwareInfo{}, nil).AnyTimes()
+	mockFeature.EXPECT().SendPowerAction(gomock.Any(), testSystemGUID, actionPowerCycle).Return(power.PowerActionResponse{ReturnValue: 0}, nil)
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	cfg := basePathConfig(customBasePath)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	group := router.Group("/redfish/v1", RedfishBasePathMiddleware(cfg))
+	NewSystemsRoutes(group, mockFeature, cfg, mockLogger)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, systemsInstanceURL, http.NoBody)
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"@odata.id":"`+customBasePath+"/Systems/"+testSystemGUID+`"`)
+	assert.Contains(t, w.Body.String(), `"target":"`+customBasePath+"/Systems/"+testSystemGUID+"/Actions/ComputerSystem.Reset"+`"`)
+	assert.NotContains(t, w.Body.String(), `"/redfish/v1`)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), http.MethodPost, systemsInstanceURL+"/Actions/ComputerSystem.Reset",
+		strings.NewReader(`{"ResetType":"PowerCycle"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	location := w.Header().Get("Location")
+	assert.True(t, strings.HasPrefix(location, customBasePath+"/TaskService/Tasks/"), location)
+	assert.Contains(t, w.Body.String(), `"@odata.id":"`+location+`"`)
+}
+
+func TestBasePathRoutes(t *testing.T) {
+	t.Parallel()
+
+	cfg := basePathConfig(customBasePath)
+	cfg.Disabled = true
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	NewRedfishProtocolRoutes(router.Group("/redfish"), cfg, logger.New("test"))
+	NewServiceRootRoutes(router.Group("/redfish/v1"), nil, cfg, testServiceCapabilities, logger.New("test"))
+
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{path: "/redfish", expected: `"v1":"` + customBasePath + `/"`},
+		{path: "/redfish/v1/", expected: `"@odata.id":"` + customBasePath + `/Systems"`},
+		{path: "/redfish/v1/SessionService", expected: `"@odata.id":"` + customBasePath + `/SessionService/Sessions"`},
+	}
+
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, tt.path, http.NoBody)
+
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code, tt.path)
+		assert.Contains(t, w.Body.String(), tt.expected, tt.path)
+		assert.NotContains(t, w.Body.String(), `"/redfish/v1`, tt.path)
+	}
+}
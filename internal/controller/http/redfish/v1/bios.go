@@ -0,0 +1,112 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements Redfish API v1 Bios resources.
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/config"
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// biosElementAttributes lists the CIM_BIOSElement properties exposed unchanged as Bios attributes
+var biosElementAttributes = []string{"PrimaryBIOS", "SoftwareElementState", "TargetOperatingSystem"}
+
+// Bios represents a Redfish Bios resource. The settings object it points to is not writable yet.
+type Bios struct {
+	ODataID    string         `json:"@odata.id"`
+	ODataType  string         `json:"@odata.type"`
+	ID         string         `json:"Id"`
+	Name       string         `json:"Name"`
+	Attributes map[string]any `json:"Attributes"`
+	Settings   map[string]any `json:"@Redfish.Settings"`
+}
+
+// NewBiosRoutes registers the Redfish Bios route for Systems
+// It exposes:
+// - GET /redfish/v1/Systems/:id/Bios
+func NewBiosRoutes(systems *gin.RouterGroup, d devices.Feature, cfg *config.Config, l logger.Interface) {
+	systems.GET(":id/Bios", getBiosHandler(d, cfg, l))
+
+	l.Info("Registered Redfish Bios routes under %s", systems.BasePath())
+}
+
+func biosPath(base, systemID string) string {
+	return systemPath(base, systemID) + "/Bios"
+}
+
+func getBiosHandler(d devices.Feature, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+
+	return func(c *gin.Context) {
+		systemID := c.Param("id")
+
+		if !validateSystemID(c, systemID) {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		hwInfo, err := d.GetHardwareInfo(ctx, systemID)
+		if err != nil {
+			var nfErr sqldb.NotFoundError
+			if errors.As(err, &nfErr) {
+				ResourceNotFoundError(c, "ComputerSystem", systemID)
+
+				return
+			}
+
+			l.Error(err, "http - redfish - Bios for %s [request %s]", systemID, requestID(c))
+			deviceCallError(c, err)
+
+			return
+		}
+
+		SetRedfishHeaders(c)
+		c.JSON(http.StatusOK, buildBios(linkBase(c), systemID, hwInfo))
+	}
+}
+
+// buildBios builds the Bios resource. Version, manufacturer and release date fall back to the
+// parseBIOSInfo defaults when the BIOS element is missing.
+func buildBios(base, systemID string, hwInfo dto.HardwareInfo) Bios {
+	version, _, manufacturer, releaseDate := parseBIOSInfo(hwInfo)
+
+	attributes := map[string]any{
+		"BiosVersion":      version,
+		"BiosManufacturer": manufacturer,
+		"BiosReleaseDate":  releaseDate,
+	}
+
+	if items := cimItems(hwInfo.CIMBIOSElement); len(items) > 0 {
+		for _, name := range biosElementAttributes {
+			if value, ok := items[0][name]; ok {
+				attributes[name] = value
+			}
+		}
+	}
+
+	return Bios{
+		ODataID:    biosPath(base, systemID),
+		ODataType:  "#Bios.v1_1_0.Bios",
+		ID:         "Bios",
+		Name:       "BIOS Configuration Current Settings",
+		Attributes: attributes,
+		Settings: map[string]any{
+			"@odata.type":    "#Settings.v1_3_0.Settings",
+			"SettingsObject": map[string]any{"@odata.id": biosPath(base, systemID) + "/Settings"},
+		},
+	}
+}
@@ -0,0 +1,136 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+func TestGetBiosHandler(t *testing.T) {
+	t.Parallel()
+
+	populated := biosHardwareInfo(map[string]any{
+		"Version":              "ADLSFWI1.R00.3212",
+		"Manufacturer":         "Intel Corp.",
+		"ReleaseDate":          map[string]any{"DateTime": "2023-05-09T00:00:00Z"},
+		"PrimaryBIOS":          true,
+		"SoftwareElementState": 2,
+	})
+
+	tests := []struct {
+		name               string
+		setupMocks         func(*mocks.MockDeviceManagementFeature, *mocks.MockLogger)
+		expectedStatus     int
+		expectedAttributes map[string]any
+		absentAttributes   []string
+		expectedBody       string
+	}{
+		{
+			name: "populated BIOS element",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemID).Return(populated, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedAttributes: map[string]any{
+				"BiosVersion":          "ADLSFWI1.R00.3212",
+				"BiosManufacturer":     "Intel Corp.",
+				"BiosReleaseDate":      "2023-05-09",
+				"PrimaryBIOS":          true,
+				"SoftwareElementState": float64(2),
+			},
+			absentAttributes: []string{"TargetOperatingSystem"},
+		},
+		{
+			name: "empty BIOS element returns defaults",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemID).Return(dto.HardwareInfo{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedAttributes: map[string]any{
+				"BiosVersion":      unknownValue,
+				"BiosManufacturer": systemManufacturer,
+			},
+			absentAttributes: biosElementAttributes,
+		},
+		{
+			name: "unknown system",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemID).Return(dto.HardwareInfo{}, devices.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   BaseResourceNotFoundID,
+		},
+		{
+			name: "device failure",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemID).Return(dto.HardwareInfo{}, fmt.Errorf("wsman failure"))
+				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   BaseErrorMessageID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockLogger := mocks.NewMockLogger(ctrl)
+
+			tt.setupMocks(mockFeature, mockLogger)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/redfish/v1/Systems/:id/Bios", getBiosHandler(mockFeature, nil, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, biosPath(DefaultRedfishBasePath, testSystemID), http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedBody)
+
+				return
+			}
+
+			var bios Bios
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &bios))
+			assert.Equal(t, "#Bios.v1_1_0.Bios", bios.ODataType)
+			assert.Equal(t, biosPath(DefaultRedfishBasePath, testSystemID), bios.ODataID)
+			assert.Equal(t, map[string]any{"@odata.id": biosPath(DefaultRedfishBasePath, testSystemID) + "/Settings"}, bios.Settings["SettingsObject"])
+
+			for name, expected := range tt.expectedAttributes {
+				assert.Equal(t, expected, bios.Attributes[name], name)
+			}
+
+			for _, name := range tt.absentAttributes {
+				assert.NotContains(t, bios.Attributes, name)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements the Redfish API v1 OEM bulk reset action.
+package v1
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// DefaultBulkResetWorkers bounds the concurrent device calls of one bulk reset when none is configured
+const DefaultBulkResetWorkers = 8
+
+// DefaultBulkResetMaxTargets bounds the Targets of one bulk reset when no limit is configured
+const DefaultBulkResetMaxTargets = 100
+
+// bulkResetAction is the OEM action resetting several systems in one request
+const bulkResetAction = "Oem.BulkReset"
+
+// Bulk reset target failures that are not device errors; device errors are reported with the
+// upstream error classification of deviceCallError
+const (
+	bulkResetErrorInvalidTarget = "invalid_target"
+	bulkResetErrorBusy          = "busy"
+)
+
+// bulkResetRequest is the body of a Systems Oem.BulkReset action. Targets are system ids or
+// Systems resource URIs.
+type bulkResetRequest struct {
+	ResetType string   `json:"ResetType"`
+	Targets   []string `json:"Targets"`
+}
+
+// bulkResetResult is the outcome of the reset of one target. Task links the Task recording a reset
+// that reached the device.
+type bulkResetResult struct {
+	Target    string            `json:"Target"`
+	Succeeded bool              `json:"Succeeded"`
+	Error     string            `json:"Error,omitempty"`
+	Task      map[string]string `json:"Task,omitempty"`
+}
+
+// bulkResetResponse lists the results in the order of the request's Targets
+type bulkResetResponse struct {
+	ResetType string            `json:"ResetType"`
+	Results   []bulkResetResult `json:"Results"`
+}
+
+// bulkResetWorkers returns the configured bulk reset concurrency, falling back to DefaultBulkResetWorkers
+func bulkResetWorkers(cfg *config.Config) int {
+	if cfg == nil || cfg.Redfish.BulkResetWorkers <= 0 {
+		return DefaultBulkResetWorkers
+	}
+
+	return cfg.Redfish.BulkResetWorkers
+}
+
+// bulkResetMaxTargets returns the configured bulk reset target limit, falling back to DefaultBulkResetMaxTargets
+func bulkResetMaxTargets(cfg *config.Config) int {
+	if cfg == nil || cfg.Redfish.BulkResetMaxTargets <= 0 {
+		return DefaultBulkResetMaxTargets
+	}
+
+	return cfg.Redfish.BulkResetMaxTargets
+}
+
+// postBulkResetHandler applies one ResetType to every target with at most bulkResetWorkers
+// concurrent device calls. The ResetType is validated once; a target that is not a system id, whose
+// system is busy with another reset or whose device call fails is reported in its result and does
+// not fail the others, so the response is 200 whenever the request itself is valid. Each reset that
+// reaches the device is recorded as a Task in store, like ComputerSystem.Reset. The request passes
+// the action rate limit once, so more Targets than bulkResetMaxTargets are rejected with a 400.
+func postBulkResetHandler(d devices.Feature, events *EventPublisher, store *TaskStore, locks *deviceLocks, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+	lockTimeout := deviceLockTimeout(cfg)
+	workers := bulkResetWorkers(cfg)
+	maxTargets := bulkResetMaxTargets(cfg)
+
+	return func(c *gin.Context) {
+		var body bulkResetRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			MalformedJSONError(c, err)
+
+			return
+		}
+
+		if body.ResetType == "" {
+			PropertyMissingError(c, propertyResetType)
+
+			return
+		}
+
+		reset, ok := newSystemReset(body.ResetType)
+		if !ok {
+			PropertyValueNotInListError(c, body.ResetType, propertyResetType)
+
+			return
+		}
+
+		if len(body.Targets) == 0 {
+			PropertyMissingError(c, propertyTargets)
+
+			return
+		}
+
+		if len(body.Targets) > maxTargets {
+			ArraySizeTooLongError(c, propertyTargets, maxTargets)
+
+			return
+		}
+
+		ctx := c.Request.Context()
+		base := linkBase(c)
+		classifier := errorClassifier(c)
+		reqID := requestID(c)
+		results := make([]bulkResetResult, len(body.Targets))
+		slots := make(chan struct{}, workers)
+
+		var wg sync.WaitGroup
+
+		for i, target := range body.Targets {
+			results[i].Target = target
+
+			id, ok := bulkResetSystemID(target, base)
+			if !ok {
+				results[i].Error = bulkResetErrorInvalidTarget
+
+				continue
+			}
+
+			wg.Add(1)
+
+			slots <- struct{}{}
+
+			go func() {
+				defer wg.Done()
+				defer func() { <-slots }()
+
+				release, ok := locks.acquire(ctx, id, lockTimeout)
+				if !ok {
+					l.Warn("redfish - %s: %s is busy with another reset [request %s]", bulkResetAction, id, reqID)
+
+					results[i].Error = bulkResetErrorBusy
+
+					return
+				}
+				defer release()
+
+				task := store.Start("Reset system " + id)
+				results[i].Task = map[string]string{"@odata.id": rebaseLink(base, task.ODataID)}
+
+				callCtx, cancel := context.WithTimeout(ctx, timeout)
+				defer cancel()
+
+				if _, err := reset.send(callCtx, d, events, store, base, task.ID, id); err != nil {
+					l.Error(err, "http - redfish - %s failed for %s [request %s]", bulkResetAction, id, reqID)
+
+					results[i].Error = deviceErrorClass(classifier, err)
+
+					return
+				}
+
+				results[i].Succeeded = true
+			}()
+		}
+
+		wg.Wait()
+
+		SetRedfishHeaders(c)
+		c.JSON(http.StatusOK, bulkResetResponse{ResetType: body.ResetType, Results: results})
+	}
+}
+
+// bulkResetSystemID returns the system id named by a bulk reset target, either a bare system id or
+// a Systems resource URI
+func bulkResetSystemID(target, basePath string) (string, bool) {
+	id, ok := systemIDFromTarget(target, basePath)
+	if !ok {
+		id = target
+	}
+
+	return id, isSystemID(id)
+}
@@ -0,0 +1,295 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+const bulkResetURL = systemsBasePath + "/Actions/" + bulkResetAction
+
+func postBulkReset(t *testing.T, d *mocks.MockDeviceManagementFeature, store *TaskStore, locks *deviceLocks, cfg *config.Config, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST(bulkResetURL, postBulkResetHandler(d, nil, store, locks, cfg, logger.New("test")))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, bulkResetURL, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestPostBulkResetHandler(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().SendPowerAction(gomock.Any(), testSystemGUID, actionReset).
+		Return(power.PowerActionResponse{ReturnValue: 0}, nil)
+	mockFeature.EXPECT().SendPowerAction(gomock.Any(), otherSystemGUID, actionReset).
+		Return(power.PowerActionResponse{}, errors.New("connection refused"))
+
+	store := NewTaskStore()
+
+	w := postBulkReset(t, mockFeature, store, newDeviceLocks(), nil,
+		`{"ResetType":"ForceRestart","Targets":["`+systemsBasePath+"/"+testSystemGUID+`","`+otherSystemGUID+`","not-a-guid"]}`)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "4.0", w.Header().Get("OData-Version"))
+
+	var body bulkResetResponse
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, resetTypeForceRestart, body.ResetType)
+	require.Len(t, body.Results, 3)
+
+	succeeded, failed, invalid := body.Results[0], body.Results[1], body.Results[2]
+
+	assert.Equal(t, systemsBasePath+"/"+testSystemGUID, succeeded.Target, "results keep the request's targets and order")
+	assert.True(t, succeeded.Succeeded)
+	assert.Empty(t, succeeded.Error)
+
+	assert.Equal(t, otherSystemGUID, failed.Target)
+	assert.False(t, failed.Succeeded)
+	assert.Equal(t, upstreamErrorUnreachable, failed.Error)
+
+	assert.False(t, invalid.Succeeded)
+	assert.Equal(t, bulkResetErrorInvalidTarget, invalid.Error)
+	assert.Nil(t, invalid.Task, "an invalid target never reaches the device")
+
+	for _, tc := range []struct {
+		result bulkResetResult
+		state  string
+	}{
+		{result: succeeded, state: TaskStateCompleted},
+		{result: failed, state: TaskStateException},
+	} {
+		task, ok := store.Get(path.Base(tc.result.Task["@odata.id"]))
+		require.True(t, ok, tc.result.Target)
+		assert.Equal(t, tc.state, task.TaskState, tc.result.Target)
+	}
+}
+
+func TestPostBulkResetValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		body          string
+		expectedMsgID string
+		expectedArgs  []string
+		// expectedDetail, when set, must appear in the resolution
+		expectedDetail string
+	}{
+		{
+			name:          "invalid reset type",
+			body:          `{"ResetType":"Sideways","Targets":["` + testSystemGUID + `"]}`,
+			expectedMsgID: BasePropertyValueNotInListID,
+			expectedArgs:  []string{"Sideways", propertyResetType},
+		},
+		{
+			name:          "missing reset type",
+			body:          `{"Targets":["` + testSystemGUID + `"]}`,
+			expectedMsgID: BasePropertyMissingID,
+			expectedArgs:  []string{propertyResetType},
+		},
+		{
+			name:          "missing targets",
+			body:          `{"ResetType":"On"}`,
+			expectedMsgID: BasePropertyMissingID,
+			expectedArgs:  []string{propertyTargets},
+		},
+		{
+			name:           "malformed body",
+			body:           `{"ResetType":`,
+			expectedMsgID:  BaseMalformedJSONID,
+			expectedDetail: "The request body ends unexpectedly.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			// No device is contacted for an invalid request
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			store := NewTaskStore()
+
+			w := postBulkReset(t, mockFeature, store, newDeviceLocks(), nil, tt.body)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+
+			var body RedfishError
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			require.Len(t, body.Error.ExtendedInfo, 1)
+			assert.Equal(t, tt.expectedMsgID, body.Error.ExtendedInfo[0].MessageID)
+			assert.Equal(t, tt.expectedArgs, body.Error.ExtendedInfo[0].MessageArgs)
+			assert.Contains(t, body.Error.ExtendedInfo[0].Resolution, tt.expectedDetail)
+			assert.Empty(t, store.List())
+		})
+	}
+}
+
+func TestPostBulkResetBusyTarget(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().SendPowerAction(gomock.Any(), otherSystemGUID, actionPowerUp).
+		Return(power.PowerActionResponse{}, nil)
+
+	locks := newDeviceLocks()
+
+	release, ok := locks.acquire(context.Background(), testSystemGUID, time.Second)
+	require.True(t, ok)
+	t.Cleanup(release)
+
+	cfg := &config.Config{}
+	cfg.Redfish.DeviceLockTimeout = 10 * time.Millisecond
+
+	w := postBulkReset(t, mockFeature, NewTaskStore(), locks, cfg,
+		`{"ResetType":"On","Targets":["`+testSystemGUID+`","`+otherSystemGUID+`"]}`)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var body bulkResetResponse
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Len(t, body.Results, 2)
+	assert.Equal(t, bulkResetResult{Target: testSystemGUID, Error: bulkResetErrorBusy}, body.Results[0])
+	assert.True(t, body.Results[1].Succeeded, "a busy system does not hold up the others")
+}
+
+func TestPostBulkResetBoundsParallelism(t *testing.T) {
+	t.Parallel()
+
+	const workers = 2
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	var active, maxActive atomic.Int32
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().SendPowerAction(gomock.Any(), gomock.Any(), actionPowerCycle).
+		DoAndReturn(func(context.Context, string, int) (power.PowerActionResponse, error) {
+			n := active.Add(1)
+			defer active.Add(-1)
+
+			for {
+				seen := maxActive.Load()
+				if n <= seen || maxActive.CompareAndSwap(seen, n) {
+					break
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+
+			return power.PowerActionResponse{}, nil
+		}).Times(5)
+
+	cfg := &config.Config{}
+	cfg.Redfish.BulkResetWorkers = workers
+
+	targets := []string{
+		"00000000-0000-4000-8000-000000000001",
+		"00000000-0000-4000-8000-000000000002",
+		"00000000-0000-4000-8000-000000000003",
+		"00000000-0000-4000-8000-000000000004",
+		"00000000-0000-4000-8000-000000000005",
+	}
+
+	w := postBulkReset(t, mockFeature, NewTaskStore(), newDeviceLocks(), cfg,
+		`{"ResetType":"PowerCycle","Targets":["`+strings.Join(targets, `","`)+`"]}`)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.LessOrEqual(t, maxActive.Load(), int32(workers))
+	assert.Equal(t, int32(workers), maxActive.Load(), "targets are reset concurrently")
+}
+
+func TestPostBulkResetTooManyTargets(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	// No device is contacted when the request is rejected
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	store := NewTaskStore()
+
+	cfg := &config.Config{}
+	cfg.Redfish.BulkResetMaxTargets = 2
+
+	w := postBulkReset(t, mockFeature, store, newDeviceLocks(), cfg,
+		`{"ResetType":"On","Targets":["`+testSystemGUID+`","`+otherSystemGUID+`","00000000-0000-4000-8000-000000000003"]}`)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	var body RedfishError
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Len(t, body.Error.ExtendedInfo, 1)
+	assert.Equal(t, BaseArraySizeTooLongID, body.Error.ExtendedInfo[0].MessageID)
+	assert.Equal(t, []string{propertyTargets, "2"}, body.Error.ExtendedInfo[0].MessageArgs)
+	assert.Empty(t, store.List())
+}
+
+func TestBulkResetMaxTargets(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, DefaultBulkResetMaxTargets, bulkResetMaxTargets(nil))
+	assert.Equal(t, DefaultBulkResetMaxTargets, bulkResetMaxTargets(&config.Config{}))
+
+	cfg := &config.Config{}
+	cfg.Redfish.BulkResetMaxTargets = 3
+
+	assert.Equal(t, 3, bulkResetMaxTargets(cfg))
+}
+
+func TestBulkResetWorkers(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, DefaultBulkResetWorkers, bulkResetWorkers(nil))
+	assert.Equal(t, DefaultBulkResetWorkers, bulkResetWorkers(&config.Config{}))
+
+	cfg := &config.Config{}
+	cfg.Redfish.BulkResetWorkers = 3
+
+	assert.Equal(t, 3, bulkResetWorkers(cfg))
+}
@@ -0,0 +1,207 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements the per-device circuit breaker for the Redfish API v1.
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+
+	"github.com/device-management-toolkit/console/config"
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+// Circuit breaker defaults used when the configuration leaves them unset
+const (
+	DefaultCircuitBreakerThreshold = 5
+	DefaultCircuitBreakerCooldown  = 30 * time.Second
+)
+
+// circuitState is the state of one device's breaker
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// circuitOpenError is returned instead of calling a device whose breaker is open
+type circuitOpenError struct {
+	guid       string
+	retryAfter time.Duration
+}
+
+func (e *circuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker open for device %s, retry in %s", e.guid, e.retryAfter)
+}
+
+// isCircuitOpenError reports whether err is a short-circuited device call and returns it
+func isCircuitOpenError(err error) (*circuitOpenError, bool) {
+	var openErr *circuitOpenError
+	if errors.As(err, &openErr) {
+		return openErr, true
+	}
+
+	return nil, false
+}
+
+// deviceCircuit tracks the consecutive failures of one device
+type deviceCircuit struct {
+	state    circuitState
+	failures int
+	openedAt time.Time
+}
+
+// circuitBreaker wraps a devices.Feature so that GetPowerState, SendPowerAction and
+// GetHardwareInfo stop calling a device after threshold consecutive communication failures.
+// While the breaker is open those calls fail fast with a circuitOpenError. After the cool-down
+// one trial call is let through: success closes the breaker, failure opens it again.
+// Errors other than timeouts and unreachable devices, as matched by the configured error
+// patterns, do not count as failures.
+type circuitBreaker struct {
+	devices.Feature
+
+	threshold  int
+	cooldown   time.Duration
+	classifier deviceErrorClassifier
+	now        func() time.Time
+
+	mu       sync.Mutex
+	circuits map[string]*deviceCircuit
+}
+
+func newCircuitBreaker(d devices.Feature, cfg *config.Config) *circuitBreaker {
+	threshold, cooldown := circuitBreakerLimits(cfg)
+
+	return &circuitBreaker{
+		Feature:    d,
+		threshold:  threshold,
+		cooldown:   cooldown,
+		classifier: newDeviceErrorClassifier(cfg),
+		now:        time.Now,
+		circuits:   map[string]*deviceCircuit{},
+	}
+}
+
+// GetPowerState calls the device unless its breaker is open
+func (b *circuitBreaker) GetPowerState(ctx context.Context, guid string) (dto.PowerState, error) {
+	if err := b.before(guid); err != nil {
+		return dto.PowerState{}, err
+	}
+
+	ps, err := b.Feature.GetPowerState(ctx, guid)
+	b.after(guid, err)
+
+	return ps, err
+}
+
+// SendPowerAction calls the device unless its breaker is open
+func (b *circuitBreaker) SendPowerAction(ctx context.Context, guid string, action int) (power.PowerActionResponse, error) {
+	if err := b.before(guid); err != nil {
+		return power.PowerActionResponse{}, err
+	}
+
+	res, err := b.Feature.SendPowerAction(ctx, guid, action)
+	b.after(guid, err)
+
+	return res, err
+}
+
+// GetHardwareInfo calls the device unless its breaker is open
+func (b *circuitBreaker) GetHardwareInfo(ctx context.Context, guid string) (dto.HardwareInfo, error) {
+	if err := b.before(guid); err != nil {
+		return dto.HardwareInfo{}, err
+	}
+
+	info, err := b.Feature.GetHardwareInfo(ctx, guid)
+	b.after(guid, err)
+
+	return info, err
+}
+
+// before returns a circuitOpenError when the call must not reach the device. Once the cool-down
+// has passed it moves the breaker to half-open and lets the calling request through as the trial.
+func (b *circuitBreaker) before(guid string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	circuit, ok := b.circuits[guid]
+	if !ok {
+		return nil
+	}
+
+	switch circuit.state {
+	case circuitOpen:
+		if remaining := circuit.openedAt.Add(b.cooldown).Sub(b.now()); remaining > 0 {
+			return &circuitOpenError{guid: guid, retryAfter: remaining}
+		}
+
+		circuit.state = circuitHalfOpen
+
+		return nil
+	case circuitHalfOpen:
+		// A trial call is in flight; keep failing fast until it settles
+		return &circuitOpenError{guid: guid, retryAfter: time.Second}
+	default:
+		return nil
+	}
+}
+
+// after records the outcome of a device call
+func (b *circuitBreaker) after(guid string, err error) {
+	failed := b.classifier.isTimeout(err) || b.classifier.isUpstreamCommunication(err)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	circuit, ok := b.circuits[guid]
+
+	if !failed {
+		if ok {
+			delete(b.circuits, guid)
+		}
+
+		return
+	}
+
+	if !ok {
+		circuit = &deviceCircuit{}
+		b.circuits[guid] = circuit
+	}
+
+	circuit.failures++
+
+	if circuit.state == circuitHalfOpen || circuit.failures >= b.threshold {
+		circuit.state = circuitOpen
+		circuit.openedAt = b.now()
+	}
+}
+
+// circuitBreakerLimits returns the configured failure threshold and cool-down, falling back to the defaults
+func circuitBreakerLimits(cfg *config.Config) (threshold int, cooldown time.Duration) {
+	threshold, cooldown = DefaultCircuitBreakerThreshold, DefaultCircuitBreakerCooldown
+
+	if cfg == nil {
+		return threshold, cooldown
+	}
+
+	if cfg.Redfish.CircuitBreakerThreshold > 0 {
+		threshold = cfg.Redfish.CircuitBreakerThreshold
+	}
+
+	if cfg.Redfish.CircuitBreakerCooldown > 0 {
+		cooldown = cfg.Redfish.CircuitBreakerCooldown
+	}
+
+	return threshold, cooldown
+}
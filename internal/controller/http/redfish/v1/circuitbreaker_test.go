@@ -0,0 +1,266 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+
+	"github.com/device-management-toolkit/console/config"
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+)
+
+var errDeviceRefused = errors.New("dial tcp 10.0.0.5:16993: connection refused")
+
+func newTestCircuitBreaker(t *testing.T, threshold int) (*circuitBreaker, *mocks.MockDeviceManagementFeature, *time.Time) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	cfg := &config.Config{}
+	cfg.Redfish.CircuitBreakerThreshold = threshold
+	cfg.Redfish.CircuitBreakerCooldown = 30 * time.Second
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	breaker := newCircuitBreaker(mockFeature, cfg)
+	breaker.now = func() time.Time { return now }
+
+	return breaker, mockFeature, &now
+}
+
+func TestCircuitBreakerOpensAndFailsFast(t *testing.T) {
+	t.Parallel()
+
+	breaker, mockFeature, _ := newTestCircuitBreaker(t, 3)
+
+	mockFeature.EXPECT().GetPowerState(gomock.Any(), testSystemGUID).Return(dto.PowerState{}, errDeviceRefused).Times(2)
+	mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(dto.HardwareInfo{}, context.DeadlineExceeded).Times(1)
+
+	for range 2 {
+		_, err := breaker.GetPowerState(context.Background(), testSystemGUID)
+		require.ErrorIs(t, err, errDeviceRefused)
+	}
+
+	_, err := breaker.GetHardwareInfo(context.Background(), testSystemGUID)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// The device is no longer called for any guarded method
+	_, err = breaker.GetPowerState(context.Background(), testSystemGUID)
+	openErr, ok := isCircuitOpenError(err)
+	require.True(t, ok, "expected a circuit open error, got %v", err)
+	assert.Equal(t, 30*time.Second, openErr.retryAfter)
+
+	_, err = breaker.SendPowerAction(context.Background(), testSystemGUID, actionPowerUp)
+	_, ok = isCircuitOpenError(err)
+	assert.True(t, ok)
+
+	_, err = breaker.GetHardwareInfo(context.Background(), testSystemGUID)
+	_, ok = isCircuitOpenError(err)
+	assert.True(t, ok)
+}
+
+func TestCircuitBreakerRecovers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		trialErr   error
+		expectOpen bool
+	}{
+		{
+			name:       "successful trial closes the breaker",
+			expectOpen: false,
+		},
+		{
+			name:       "failed trial opens the breaker again",
+			trialErr:   errDeviceRefused,
+			expectOpen: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			breaker, mockFeature, now := newTestCircuitBreaker(t, 1)
+
+			gomock.InOrder(
+				mockFeature.EXPECT().SendPowerAction(gomock.Any(), testSystemGUID, actionPowerUp).
+					Return(power.PowerActionResponse{}, errDeviceRefused),
+				mockFeature.EXPECT().SendPowerAction(gomock.Any(), testSystemGUID, actionPowerUp).
+					Return(power.PowerActionResponse{}, tt.trialErr),
+			)
+
+			_, err := breaker.SendPowerAction(context.Background(), testSystemGUID, actionPowerUp)
+			require.ErrorIs(t, err, errDeviceRefused)
+
+			*now = now.Add(10 * time.Second)
+
+			_, err = breaker.SendPowerAction(context.Background(), testSystemGUID, actionPowerUp)
+			openErr, ok := isCircuitOpenError(err)
+			require.True(t, ok)
+			assert.Equal(t, 20*time.Second, openErr.retryAfter)
+
+			*now = now.Add(20 * time.Second)
+
+			_, err = breaker.SendPowerAction(context.Background(), testSystemGUID, actionPowerUp)
+			assert.Equal(t, tt.trialErr, err)
+
+			_, open := isCircuitOpenError(breaker.before(testSystemGUID))
+			assert.Equal(t, tt.expectOpen, open)
+		})
+	}
+}
+
+func TestCircuitBreakerHalfOpenAllowsOneTrial(t *testing.T) {
+	t.Parallel()
+
+	breaker, mockFeature, now := newTestCircuitBreaker(t, 1)
+
+	mockFeature.EXPECT().GetPowerState(gomock.Any(), testSystemGUID).Return(dto.PowerState{}, errDeviceRefused)
+
+	_, err := breaker.GetPowerState(context.Background(), testSystemGUID)
+	require.ErrorIs(t, err, errDeviceRefused)
+
+	*now = now.Add(time.Minute)
+
+	require.NoError(t, breaker.before(testSystemGUID), "the first call after the cool-down is the trial")
+
+	_, ok := isCircuitOpenError(breaker.before(testSystemGUID))
+	assert.True(t, ok, "other calls fail fast while the trial is in flight")
+}
+
+func TestCircuitBreakerIgnoresOtherErrors(t *testing.T) {
+	t.Parallel()
+
+	breaker, mockFeature, _ := newTestCircuitBreaker(t, 2)
+
+	mockFeature.EXPECT().GetPowerState(gomock.Any(), testSystemGUID).Return(dto.PowerState{}, sqldb.NotFoundError{}).Times(3)
+	mockFeature.EXPECT().GetPowerState(gomock.Any(), "other").Return(dto.PowerState{}, errDeviceRefused).Times(1)
+	mockFeature.EXPECT().GetPowerState(gomock.Any(), "other").Return(dto.PowerState{PowerState: cimPowerOn}, nil).Times(1)
+	mockFeature.EXPECT().GetPowerState(gomock.Any(), "other").Return(dto.PowerState{}, errDeviceRefused).Times(1)
+
+	for range 3 {
+		_, err := breaker.GetPowerState(context.Background(), testSystemGUID)
+		require.ErrorAs(t, err, &sqldb.NotFoundError{})
+	}
+
+	// A success in between resets the consecutive failure count
+	for range 3 {
+		_, err := breaker.GetPowerState(context.Background(), "other")
+		_, open := isCircuitOpenError(err)
+		require.False(t, open)
+	}
+
+	assert.NoError(t, breaker.before("other"))
+}
+
+func TestCircuitBreakerConfiguredPatterns(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	errTunnelClosed := errors.New("mps: tunnel closed")
+
+	cfg := &config.Config{}
+	cfg.Redfish.CircuitBreakerThreshold = 1
+	cfg.Redfish.UpstreamErrorPatterns = []string{"tunnel closed"}
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().GetPowerState(gomock.Any(), testSystemGUID).Return(dto.PowerState{}, errTunnelClosed).Times(1)
+	mockFeature.EXPECT().GetPowerState(gomock.Any(), "other").Return(dto.PowerState{}, errTunnelClosed).Times(2)
+
+	breaker := newCircuitBreaker(mockFeature, cfg)
+
+	_, err := breaker.GetPowerState(context.Background(), testSystemGUID)
+	require.ErrorIs(t, err, errTunnelClosed)
+
+	_, open := isCircuitOpenError(breaker.before(testSystemGUID))
+	assert.True(t, open, "a configured upstream pattern counts as a failure")
+
+	// Without the pattern the same error is not a communication failure
+	breaker = newCircuitBreaker(mockFeature, &config.Config{Redfish: config.Redfish{CircuitBreakerThreshold: 1}})
+
+	for range 2 {
+		_, err = breaker.GetPowerState(context.Background(), "other")
+		require.ErrorIs(t, err, errTunnelClosed)
+	}
+}
+
+func TestCircuitBreakerReturns503(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	cfg := &config.Config{}
+	cfg.Redfish.CircuitBreakerThreshold = 2
+	cfg.Redfish.CircuitBreakerCooldown = 45 * time.Second
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().SendPowerAction(gomock.Any(), testSystemGUID, actionPowerUp).
+		Return(power.PowerActionResponse{}, errDeviceRefused).Times(2)
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	NewSystemsRoutes(router.Group("/redfish/v1"), mockFeature, cfg, mockLogger)
+
+	codes := make([]int, 0, 3)
+
+	for range 3 {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, resetActionURL, strings.NewReader(`{"ResetType":"On"}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		codes = append(codes, w.Code)
+
+		if w.Code == http.StatusServiceUnavailable {
+			assert.Equal(t, "45", w.Header().Get("Retry-After"))
+		}
+	}
+
+	assert.Equal(t, []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusServiceUnavailable}, codes)
+}
+
+func TestCircuitBreakerLimits(t *testing.T) {
+	t.Parallel()
+
+	threshold, cooldown := circuitBreakerLimits(nil)
+	assert.Equal(t, DefaultCircuitBreakerThreshold, threshold)
+	assert.Equal(t, DefaultCircuitBreakerCooldown, cooldown)
+
+	cfg := &config.Config{}
+	cfg.Redfish.CircuitBreakerThreshold = 3
+	cfg.Redfish.CircuitBreakerCooldown = time.Minute
+
+	threshold, cooldown = circuitBreakerLimits(cfg)
+	assert.Equal(t, 3, threshold)
+	assert.Equal(t, time.Minute, cooldown)
+}
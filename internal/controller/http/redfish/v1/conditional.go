@@ -0,0 +1,189 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements Redfish API v1 conditional request handling.
+package v1
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ifNoneMatch reports whether the request's If-None-Match header matches etag using weak comparison.
+// On a match it writes a 304 Not Modified response carrying the ETag, and the caller must not write a body.
+func ifNoneMatch(c *gin.Context, etag string) bool {
+	header := c.GetHeader("If-None-Match")
+	if header == "" || etag == "" {
+		return false
+	}
+
+	matched := false
+
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || compareETag(candidate, etag) {
+			matched = true
+
+			break
+		}
+	}
+
+	if !matched {
+		return false
+	}
+
+	c.Header("ETag", etag)
+	c.Status(http.StatusNotModified)
+
+	return true
+}
+
+// ifMatch reports whether a write may proceed under the request's If-Match header. If-Match uses
+// strong comparison (RFC 9110 13.1.1), unlike If-None-Match: a weak tag on either side never
+// matches, so writable resources must emit strong ETags (formatStrongETag). currentETag is called at most once, and only when a strong candidate has to be compared. When the
+// precondition fails ifMatch writes a 412 response and the caller must stop.
+func ifMatch(c *gin.Context, currentETag func() string) bool {
+	header := c.GetHeader("If-Match")
+	if header == "" {
+		return true
+	}
+
+	current := ""
+	resolved := false
+
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+
+		if isWeakETag(candidate) {
+			continue
+		}
+
+		if !resolved {
+			current, resolved = currentETag(), true
+		}
+
+		if compareETagStrong(candidate, current) {
+			return true
+		}
+	}
+
+	PreconditionFailedError(c)
+
+	return false
+}
+
+// formatETag returns the weak ETag of content. Read-only Redfish resources derive their ETag here
+// so that the header, @odata.etag and If-None-Match all use the same W/"<sha256>" form.
+func formatETag(content string) string {
+	hash := sha256.Sum256([]byte(content))
+
+	return fmt.Sprintf(`W/"%x"`, hash)
+}
+
+// formatStrongETag returns the strong ETag of content, "<sha256>". Writable resources use it so
+// that a client can send the ETag it read back in If-Match.
+func formatStrongETag(content string) string {
+	hash := sha256.Sum256([]byte(content))
+
+	return fmt.Sprintf(`"%x"`, hash)
+}
+
+// compareETag reports whether two entity tags match using weak comparison (RFC 9110 8.8.3.2):
+// the W/ prefix and surrounding whitespace are ignored, so W/"x" and "x" compare equal.
+func compareETag(a, b string) bool {
+	a = strings.TrimPrefix(strings.TrimSpace(a), "W/")
+	b = strings.TrimPrefix(strings.TrimSpace(b), "W/")
+
+	return a != "" && a == b
+}
+
+// compareETagStrong reports whether two entity tags match using strong comparison (RFC 9110 8.8.3.2):
+// both must be strong and identical.
+func compareETagStrong(a, b string) bool {
+	a = strings.TrimSpace(a)
+	b = strings.TrimSpace(b)
+
+	return a != "" && !isWeakETag(a) && !isWeakETag(b) && a == b
+}
+
+// isWeakETag reports whether an entity tag carries the W/ weak prefix
+func isWeakETag(etag string) bool {
+	return strings.HasPrefix(strings.TrimSpace(etag), "W/")
+}
+
+// maxTrackedResources bounds the last-modified tracker; when exceeded the tracker starts over and
+// every resource reports as modified at its next read
+const maxTrackedResources = 10000
+
+// lastModifiedTracker remembers when the ETag of each resource last changed, giving resources
+// that carry no modification time of their own a stable Last-Modified value
+type lastModifiedTracker struct {
+	mu      sync.Mutex
+	entries map[string]lastModifiedEntry
+	now     func() time.Time
+}
+
+type lastModifiedEntry struct {
+	etag     string
+	modified time.Time
+}
+
+func newLastModifiedTracker() *lastModifiedTracker {
+	return &lastModifiedTracker{
+		entries: make(map[string]lastModifiedEntry),
+		now:     time.Now,
+	}
+}
+
+// touch returns when the resource identified by key last changed, recording the current time
+// when etag differs from the one seen before. Times are truncated to whole seconds, the
+// precision of HTTP dates.
+func (t *lastModifiedTracker) touch(key, etag string) time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if entry, ok := t.entries[key]; ok && entry.etag == etag {
+		return entry.modified
+	}
+
+	if len(t.entries) >= maxTrackedResources {
+		t.entries = make(map[string]lastModifiedEntry)
+	}
+
+	modified := t.now().UTC().Truncate(time.Second)
+	t.entries[key] = lastModifiedEntry{etag: etag, modified: modified}
+
+	return modified
+}
+
+// ifModifiedSince sets the Last-Modified header and reports whether the request's If-Modified-Since
+// header shows the resource unchanged. On a match it writes a 304 Not Modified response, and the
+// caller must not write a body. The header is ignored when If-None-Match is present (RFC 9110 13.1.3).
+func ifModifiedSince(c *gin.Context, lastModified time.Time) bool {
+	c.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+
+	header := c.GetHeader("If-Modified-Since")
+	if header == "" || c.GetHeader("If-None-Match") != "" {
+		return false
+	}
+
+	since, err := http.ParseTime(header)
+	if err != nil || lastModified.After(since) {
+		return false
+	}
+
+	c.Status(http.StatusNotModified)
+
+	return true
+}
@@ -0,0 +1,341 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	dtov2 "github.com/device-management-toolkit/console/internal/entity/dto/v2"
+	"github.com/device-management-toolkit/console/internal/mocks"
+)
+
+func TestFormatETag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name:     "empty content",
+			content:  "",
+			expected: `W/"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"`,
+		},
+		{
+			name:     "simple content",
+			content:  "test",
+			expected: `W/"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`,
+		},
+		{
+			name:     "firmware inventory content",
+			content:  "FirmwareInventory-system-123-5",
+			expected: `W/"f10d99bfef532179d77a3e1229f4a1a98ae4b9bfbed03d6839442365a8d759fd"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := formatETag(tt.content)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestCompareETag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{name: "identical weak tags", a: `W/"abc"`, b: `W/"abc"`, expected: true},
+		{name: "weak and strong forms", a: `"abc"`, b: `W/"abc"`, expected: true},
+		{name: "surrounding whitespace", a: ` W/"abc" `, b: `"abc"`, expected: true},
+		{name: "different tags", a: `W/"abc"`, b: `W/"abd"`, expected: false},
+		{name: "quoted and bare differ", a: `"abc"`, b: `abc`, expected: false},
+		{name: "empty tags never match", a: "", b: "", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, compareETag(tt.a, tt.b))
+			assert.Equal(t, tt.expected, compareETag(tt.b, tt.a))
+		})
+	}
+}
+
+func TestETagRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	const systemID = "c0ffee00-1234-4abc-9def-0123456789ab"
+
+	tests := []struct {
+		name   string
+		path   string
+		strong bool
+	}{
+		{name: "computer system", path: "/redfish/v1/Systems/" + systemID, strong: true},
+		{name: "firmware collection", path: "/redfish/v1/Systems/" + systemID + "/FirmwareInventory"},
+		{name: "firmware instance", path: "/redfish/v1/Systems/" + systemID + "/FirmwareInventory/BIOS"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().GetPowerState(gomock.Any(), systemID).Return(dto.PowerState{PowerState: cimPowerOn}, nil).AnyTimes()
+			mockFeature.EXPECT().GetVersion(gomock.Any(), systemID).Return(dto.Version{}, dtov2.Version{AMT: "16.1.25"}, nil).AnyTimes()
+			mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), systemID).Return(dto.HardwareInfo{}, nil).AnyTimes()
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			NewSystemsRoutes(router.Group("/redfish/v1"), mockFeature, nil, mockLogger)
+
+			get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+				w := httptest.NewRecorder()
+				req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, tt.path, http.NoBody)
+
+				if ifNoneMatch != "" {
+					req.Header.Set("If-None-Match", ifNoneMatch)
+				}
+
+				router.ServeHTTP(w, req)
+
+				return w
+			}
+
+			first := get("")
+			require.Equal(t, http.StatusOK, first.Code)
+
+			etag := first.Header().Get("ETag")
+			require.Equal(t, !tt.strong, isWeakETag(etag), etag)
+
+			var body map[string]any
+
+			require.NoError(t, json.Unmarshal(first.Body.Bytes(), &body))
+			assert.Equal(t, etag, body["@odata.etag"])
+
+			opaque := strings.TrimPrefix(etag, "W/")
+
+			for _, candidate := range []string{opaque, "W/" + opaque} {
+				w := get(candidate)
+				assert.Equal(t, http.StatusNotModified, w.Code, candidate)
+				assert.Equal(t, etag, w.Header().Get("ETag"))
+				assert.Empty(t, w.Body.String())
+			}
+
+			assert.Equal(t, http.StatusOK, get(formatETag("stale")).Code)
+		})
+	}
+}
+
+func TestIfNoneMatch(t *testing.T) {
+	t.Parallel()
+
+	const etag = `W/"abc"`
+
+	tests := []struct {
+		name        string
+		header      string
+		etag        string
+		expectMatch bool
+	}{
+		{name: "no header", header: "", etag: etag, expectMatch: false},
+		{name: "exact match", header: `W/"abc"`, etag: etag, expectMatch: true},
+		{name: "strong form matches weakly", header: `"abc"`, etag: etag, expectMatch: true},
+		{name: "match within a list", header: `"other", W/"abc"`, etag: etag, expectMatch: true},
+		{name: "wildcard", header: "*", etag: etag, expectMatch: true},
+		{name: "different tag", header: `W/"xyz"`, etag: etag, expectMatch: false},
+		{name: "empty etag never matches", header: "*", etag: "", expectMatch: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequestWithContext(context.Background(), http.MethodGet, "/test", http.NoBody)
+
+			if tt.header != "" {
+				c.Request.Header.Set("If-None-Match", tt.header)
+			}
+
+			assert.Equal(t, tt.expectMatch, ifNoneMatch(c, tt.etag))
+
+			if tt.expectMatch {
+				c.Writer.WriteHeaderNow()
+				assert.Equal(t, http.StatusNotModified, w.Code)
+				assert.Equal(t, tt.etag, w.Header().Get("ETag"))
+				assert.Empty(t, w.Body.String())
+			} else {
+				assert.Empty(t, w.Header().Get("ETag"))
+			}
+		})
+	}
+}
+
+func TestIfMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		header        string
+		current       string
+		expectProceed bool
+		expectLookup  bool
+	}{
+		{name: "no header", current: `W/"abc"`, expectProceed: true},
+		{name: "wildcard", header: "*", current: `W/"abc"`, expectProceed: true},
+		{name: "weak tag is rejected without a lookup", header: `W/"abc"`, current: `W/"abc"`},
+		{name: "strong tag never matches a weak current tag", header: `"abc"`, current: `W/"abc"`, expectLookup: true},
+		{name: "strong tag matches a strong current tag", header: `"abc"`, current: `"abc"`, expectProceed: true, expectLookup: true},
+		{name: "strong tag within a list", header: `W/"abc", "abc"`, current: `"abc"`, expectProceed: true, expectLookup: true},
+		{name: "different strong tag", header: `"xyz"`, current: `"abc"`, expectLookup: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequestWithContext(context.Background(), http.MethodPatch, "/test", http.NoBody)
+
+			if tt.header != "" {
+				c.Request.Header.Set("If-Match", tt.header)
+			}
+
+			lookups := 0
+			proceed := ifMatch(c, func() string {
+				lookups++
+
+				return tt.current
+			})
+
+			assert.Equal(t, tt.expectProceed, proceed)
+			assert.Equal(t, tt.expectLookup, lookups == 1)
+			assert.LessOrEqual(t, lookups, 1)
+
+			if !tt.expectProceed {
+				assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+				assert.Contains(t, w.Body.String(), BasePreconditionFailedID)
+			}
+		})
+	}
+}
+
+func TestCompareETagStrong(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, compareETagStrong(`"abc"`, ` "abc" `))
+	assert.False(t, compareETagStrong(`W/"abc"`, `W/"abc"`))
+	assert.False(t, compareETagStrong(`"abc"`, `W/"abc"`))
+	assert.False(t, compareETagStrong(`"abc"`, `"xyz"`))
+	assert.False(t, compareETagStrong("", ""))
+
+	assert.True(t, isWeakETag(formatETag("x")))
+	assert.False(t, isWeakETag(formatStrongETag("x")))
+	assert.True(t, compareETagStrong(formatStrongETag("x"), formatStrongETag("x")))
+	assert.True(t, compareETag(formatStrongETag("x"), formatETag("x")), "both forms hash the same content")
+}
+
+func TestLastModifiedTracker(t *testing.T) {
+	t.Parallel()
+
+	first := time.Date(2025, time.May, 1, 10, 0, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+	current := first.Add(time.Millisecond)
+
+	tracker := newLastModifiedTracker()
+	tracker.now = func() time.Time { return current }
+
+	assert.Equal(t, first, tracker.touch("system-1", `W/"a"`))
+
+	current = second
+	assert.Equal(t, first, tracker.touch("system-1", `W/"a"`), "unchanged ETag keeps the time")
+	assert.Equal(t, second, tracker.touch("system-1", `W/"b"`), "changed ETag records the current time")
+	assert.Equal(t, second, tracker.touch("system-2", `W/"a"`), "resources are tracked independently")
+}
+
+func TestIfModifiedSince(t *testing.T) {
+	t.Parallel()
+
+	lastModified := time.Date(2025, time.May, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		header      string
+		ifNoneMatch string
+		expectMatch bool
+	}{
+		{name: "no header", expectMatch: false},
+		{name: "same time", header: lastModified.Format(http.TimeFormat), expectMatch: true},
+		{name: "later time", header: lastModified.Add(time.Hour).Format(http.TimeFormat), expectMatch: true},
+		{name: "earlier time", header: lastModified.Add(-time.Hour).Format(http.TimeFormat), expectMatch: false},
+		{name: "invalid date", header: "not a date", expectMatch: false},
+		{name: "ignored with If-None-Match", header: lastModified.Format(http.TimeFormat), ifNoneMatch: `W/"x"`, expectMatch: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequestWithContext(context.Background(), http.MethodGet, "/test", http.NoBody)
+
+			if tt.header != "" {
+				c.Request.Header.Set("If-Modified-Since", tt.header)
+			}
+
+			if tt.ifNoneMatch != "" {
+				c.Request.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+
+			assert.Equal(t, tt.expectMatch, ifModifiedSince(c, lastModified))
+			assert.Equal(t, "Thu, 01 May 2025 10:00:00 GMT", w.Header().Get("Last-Modified"))
+
+			if tt.expectMatch {
+				c.Writer.WriteHeaderNow()
+				assert.Equal(t, http.StatusNotModified, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements CORS for the Redfish API v1.
+package v1
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/config"
+)
+
+// corsPreflightMaxAge is how long, in seconds, browsers may cache a preflight response
+const corsPreflightMaxAge = 600
+
+var (
+	// corsAllowedMethods are the methods served by the Redfish routes
+	corsAllowedMethods = []string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPatch, http.MethodDelete, http.MethodOptions}
+
+	// corsAllowedHeaders are the request headers Redfish clients send
+	corsAllowedHeaders = []string{
+		"Authorization", "Content-Type", "If-Match", "If-None-Match", "If-Modified-Since",
+		"OData-Version", authTokenHeader, requestIDHeader, dryRunHeader,
+	}
+
+	// corsExposedHeaders are the response headers a browser client may read
+	corsExposedHeaders = []string{"ETag", "Last-Modified", "Location", "OData-Version", "Retry-After", requestIDHeader}
+)
+
+// RedfishCORSMiddleware adds CORS headers for the origins in cfg.Redfish.CORSAllowedOrigins, or in
+// cfg.HTTP.AllowedOrigins when the Redfish list is empty. A "*" entry allows every origin.
+// Preflight requests from an allowed origin are answered with 204; requests from other origins
+// pass through without CORS headers, so the browser blocks them.
+//
+// It must run on the engine rather than a route group: gin only runs group middleware for
+// requests that match a route, and a preflight carries no token for the group's auth middleware.
+func RedfishCORSMiddleware(cfg *config.Config) gin.HandlerFunc {
+	origins := corsAllowedOrigins(cfg)
+	anyOrigin := slices.Contains(origins, "*")
+	allowMethods := strings.Join(corsAllowedMethods, ", ")
+	allowHeaders := strings.Join(corsAllowedHeaders, ", ")
+	exposeHeaders := strings.Join(corsExposedHeaders, ", ")
+
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		if origin == "" || (!anyOrigin && !slices.Contains(origins, origin)) {
+			c.Next()
+
+			return
+		}
+
+		if anyOrigin {
+			c.Header("Access-Control-Allow-Origin", "*")
+		} else {
+			c.Header("Access-Control-Allow-Origin", origin)
+			c.Header("Vary", "Origin")
+		}
+
+		c.Header("Access-Control-Expose-Headers", exposeHeaders)
+
+		if c.Request.Method == http.MethodOptions && c.GetHeader("Access-Control-Request-Method") != "" {
+			c.Header("Access-Control-Allow-Methods", allowMethods)
+			c.Header("Access-Control-Allow-Headers", allowHeaders)
+			c.Header("Access-Control-Max-Age", strconv.Itoa(corsPreflightMaxAge))
+			c.AbortWithStatus(http.StatusNoContent)
+
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// corsAllowedOrigins returns the configured Redfish origins, falling back to the console origins
+func corsAllowedOrigins(cfg *config.Config) []string {
+	if cfg == nil {
+		return nil
+	}
+
+	if len(cfg.Redfish.CORSAllowedOrigins) > 0 {
+		return cfg.Redfish.CORSAllowedOrigins
+	}
+
+	return cfg.HTTP.AllowedOrigins
+}
@@ -0,0 +1,149 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/device-management-toolkit/console/config"
+)
+
+func TestRedfishCORSMiddleware(t *testing.T) {
+	t.Parallel()
+
+	const allowed = "https://console.example.com"
+
+	tests := []struct {
+		name            string
+		redfishOrigins  []string
+		consoleOrigins  []string
+		method          string
+		origin          string
+		preflight       bool
+		expectedStatus  int
+		expectedOrigin  string
+		expectPreflight bool
+	}{
+		{
+			name:           "allowed origin",
+			redfishOrigins: []string{allowed},
+			method:         http.MethodGet,
+			origin:         allowed,
+			expectedStatus: http.StatusOK,
+			expectedOrigin: allowed,
+		},
+		{
+			name:           "disallowed origin gets no CORS headers",
+			redfishOrigins: []string{allowed},
+			method:         http.MethodGet,
+			origin:         "https://evil.example.com",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "same origin request without Origin header",
+			redfishOrigins: []string{allowed},
+			method:         http.MethodGet,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:            "preflight from allowed origin",
+			redfishOrigins:  []string{allowed},
+			method:          http.MethodOptions,
+			origin:          allowed,
+			preflight:       true,
+			expectedStatus:  http.StatusNoContent,
+			expectedOrigin:  allowed,
+			expectPreflight: true,
+		},
+		{
+			name:           "preflight from disallowed origin",
+			redfishOrigins: []string{allowed},
+			method:         http.MethodOptions,
+			origin:         "https://evil.example.com",
+			preflight:      true,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "wildcard allows any origin",
+			redfishOrigins: []string{"*"},
+			method:         http.MethodGet,
+			origin:         "https://anywhere.example.com",
+			expectedStatus: http.StatusOK,
+			expectedOrigin: "*",
+		},
+		{
+			name:           "falls back to the console origins",
+			consoleOrigins: []string{allowed},
+			method:         http.MethodGet,
+			origin:         allowed,
+			expectedStatus: http.StatusOK,
+			expectedOrigin: allowed,
+		},
+		{
+			name:           "no origins configured",
+			method:         http.MethodGet,
+			origin:         allowed,
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &config.Config{}
+			cfg.Redfish.CORSAllowedOrigins = tt.redfishOrigins
+			cfg.HTTP.AllowedOrigins = tt.consoleOrigins
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.Use(RedfishCORSMiddleware(cfg))
+			router.GET("/redfish/v1/Systems", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), tt.method, "/redfish/v1/Systems", http.NoBody)
+
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+
+			if tt.preflight {
+				req.Header.Set("Access-Control-Request-Method", http.MethodPatch)
+				req.Header.Set("Access-Control-Request-Headers", "X-Auth-Token, If-Match")
+			}
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedOrigin, w.Header().Get("Access-Control-Allow-Origin"))
+
+			if tt.expectedOrigin == "" {
+				assert.Empty(t, w.Header().Get("Access-Control-Expose-Headers"))
+			} else {
+				assert.Contains(t, w.Header().Get("Access-Control-Expose-Headers"), "ETag")
+			}
+
+			if !tt.expectPreflight {
+				assert.Empty(t, w.Header().Get("Access-Control-Allow-Methods"))
+
+				return
+			}
+
+			assert.Contains(t, w.Header().Get("Access-Control-Allow-Methods"), http.MethodPatch)
+			assert.Equal(t, "600", w.Header().Get("Access-Control-Max-Age"))
+
+			for _, header := range []string{"X-Auth-Token", "If-Match", "If-None-Match", "Authorization"} {
+				assert.Contains(t, w.Header().Get("Access-Control-Allow-Headers"), header)
+			}
+		})
+	}
+}
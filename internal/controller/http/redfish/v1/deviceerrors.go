@@ -0,0 +1,205 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements device error classification for the Redfish API v1.
+package v1
+
+import (
+	"context"
+	"errors"
+	"net"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+)
+
+// errorClassifierContextKey holds the device error classifier built from the configured patterns
+const errorClassifierContextKey = "redfishErrorClassifier"
+
+// timeoutErrorMarkers are error message fragments that indicate the device did not answer in time
+var timeoutErrorMarkers = []string{"i/o timeout", "context deadline exceeded", "connection timeout", "connection timed out"}
+
+// upstreamErrorMarkers are error message fragments that indicate the device could not be reached at all
+var upstreamErrorMarkers = []string{"connection refused", "unreachable", "no route to host", "connection reset"}
+
+// deviceAuthErrorMarkers are error message fragments that indicate the device rejected our credentials
+var deviceAuthErrorMarkers = []string{"unauthorized", "authentication failed"}
+
+// httpStatus401 matches a bare 401 status code in an error message
+var httpStatus401 = regexp.MustCompile(`\b401\b`)
+
+// deviceErrorClassifier sorts device call errors into timeouts, credential rejections,
+// unreachable devices and overload. Errors wrapping a consoleerrors sentinel are classified by
+// the sentinel; other errors by matching their messages against lower-cased substring patterns.
+type deviceErrorClassifier struct {
+	timeoutMarkers  []string
+	upstreamMarkers []string
+	authMarkers     []string
+}
+
+// defaultDeviceErrorClassifier matches only the built-in patterns
+var defaultDeviceErrorClassifier = deviceErrorClassifier{
+	timeoutMarkers:  timeoutErrorMarkers,
+	upstreamMarkers: upstreamErrorMarkers,
+	authMarkers:     deviceAuthErrorMarkers,
+}
+
+// newDeviceErrorClassifier returns a classifier matching the built-in patterns plus the ones
+// configured in cfg.Redfish, so that backend-specific error text can be classified without a rebuild
+func newDeviceErrorClassifier(cfg *config.Config) deviceErrorClassifier {
+	if cfg == nil {
+		return defaultDeviceErrorClassifier
+	}
+
+	return deviceErrorClassifier{
+		timeoutMarkers:  appendErrorMarkers(timeoutErrorMarkers, cfg.Redfish.TimeoutErrorPatterns),
+		upstreamMarkers: appendErrorMarkers(upstreamErrorMarkers, cfg.Redfish.UpstreamErrorPatterns),
+		authMarkers:     appendErrorMarkers(deviceAuthErrorMarkers, cfg.Redfish.DeviceAuthErrorPatterns),
+	}
+}
+
+// appendErrorMarkers returns defaults followed by the non-blank patterns, lower-cased to match containsAny
+func appendErrorMarkers(defaults, patterns []string) []string {
+	markers := slices.Clone(defaults)
+
+	for _, pattern := range patterns {
+		if pattern = strings.ToLower(strings.TrimSpace(pattern)); pattern != "" && !slices.Contains(markers, pattern) {
+			markers = append(markers, pattern)
+		}
+	}
+
+	return markers
+}
+
+// typedDeviceErrors are the sentinels a device call may wrap; they take precedence over the patterns
+var typedDeviceErrors = []error{
+	consoleerrors.ErrUpstreamTimeout,
+	consoleerrors.ErrUpstreamUnavailable,
+	consoleerrors.ErrDeviceAuthentication,
+	consoleerrors.ErrServiceOverloaded,
+}
+
+// typedDeviceError returns the sentinel err wraps, if any
+func typedDeviceError(err error) (error, bool) {
+	for _, sentinel := range typedDeviceErrors {
+		if errors.Is(err, sentinel) {
+			return sentinel, true
+		}
+	}
+
+	return nil, false
+}
+
+// isTimeout reports whether err means the upstream device did not respond in time (504)
+func (k deviceErrorClassifier) isTimeout(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if sentinel, ok := typedDeviceError(err); ok {
+		return sentinel == consoleerrors.ErrUpstreamTimeout
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return containsAny(err.Error(), k.timeoutMarkers)
+}
+
+// isDeviceAuth reports whether err means the device rejected the stored credentials.
+// Timeouts are excluded; they are classified by isTimeout.
+func (k deviceErrorClassifier) isDeviceAuth(err error) bool {
+	if sentinel, ok := typedDeviceError(err); ok {
+		return sentinel == consoleerrors.ErrDeviceAuthentication
+	}
+
+	if err == nil || k.isTimeout(err) {
+		return false
+	}
+
+	return containsAny(err.Error(), k.authMarkers) || httpStatus401.MatchString(err.Error())
+}
+
+// isUpstreamCommunication reports whether err means the upstream device was unreachable (502).
+// Timeouts and authentication failures are excluded; they are classified by isTimeout and isDeviceAuth.
+func (k deviceErrorClassifier) isUpstreamCommunication(err error) bool {
+	if sentinel, ok := typedDeviceError(err); ok {
+		return sentinel == consoleerrors.ErrUpstreamUnavailable
+	}
+
+	if err == nil || k.isTimeout(err) || k.isDeviceAuth(err) {
+		return false
+	}
+
+	return containsAny(err.Error(), k.upstreamMarkers)
+}
+
+// isServiceOverloaded reports whether err means the call was refused because the service or
+// device is busy (503). Only the typed error is recognised; there is no reliable message text.
+func (k deviceErrorClassifier) isServiceOverloaded(err error) bool {
+	return errors.Is(err, consoleerrors.ErrServiceOverloaded)
+}
+
+// RedfishErrorClassifierMiddleware stores the device error classifier built from cfg, which
+// deviceCallError uses to choose between 504, 502 and 500
+func RedfishErrorClassifierMiddleware(cfg *config.Config) gin.HandlerFunc {
+	classifier := newDeviceErrorClassifier(cfg)
+
+	return func(c *gin.Context) {
+		c.Set(errorClassifierContextKey, classifier)
+		c.Next()
+	}
+}
+
+// errorClassifier returns the classifier stored by RedfishErrorClassifierMiddleware, or the default one
+func errorClassifier(c *gin.Context) deviceErrorClassifier {
+	if classifier, ok := c.Value(errorClassifierContextKey).(deviceErrorClassifier); ok {
+		return classifier
+	}
+
+	return defaultDeviceErrorClassifier
+}
+
+// isTimeoutError reports whether err means the upstream device did not respond in time (504),
+// using the built-in patterns
+func isTimeoutError(err error) bool {
+	return defaultDeviceErrorClassifier.isTimeout(err)
+}
+
+// isDeviceAuthError reports whether err means the device rejected the stored credentials,
+// using the built-in patterns
+func isDeviceAuthError(err error) bool {
+	return defaultDeviceErrorClassifier.isDeviceAuth(err)
+}
+
+// isUpstreamCommunicationError reports whether err means the upstream device was unreachable (502),
+// using the built-in patterns
+func isUpstreamCommunicationError(err error) bool {
+	return defaultDeviceErrorClassifier.isUpstreamCommunication(err)
+}
+
+// containsAny reports whether the lower-cased message contains any of the markers
+func containsAny(message string, markers []string) bool {
+	message = strings.ToLower(message)
+
+	for _, marker := range markers {
+		if strings.Contains(message, marker) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,275 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+)
+
+func TestDeviceErrorClassification(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		err            error
+		expectTimeout  bool
+		expectUpstream bool
+		expectAuth     bool
+		expectedStatus int
+	}{
+		{name: "nil error", err: nil, expectedStatus: http.StatusInternalServerError},
+		{name: "context deadline", err: context.DeadlineExceeded, expectTimeout: true, expectedStatus: http.StatusGatewayTimeout},
+		{name: "wrapped context deadline", err: fmt.Errorf("get power state: %w", context.DeadlineExceeded), expectTimeout: true, expectedStatus: http.StatusGatewayTimeout},
+		{name: "i/o timeout message", err: errors.New("dial tcp 10.0.0.1:16993: i/o timeout"), expectTimeout: true, expectedStatus: http.StatusGatewayTimeout},
+		{name: "connection timeout message", err: errors.New("Connection Timeout while waiting for device"), expectTimeout: true, expectedStatus: http.StatusGatewayTimeout},
+		{name: "net.Error timeout", err: timeoutNetError{}, expectTimeout: true, expectedStatus: http.StatusGatewayTimeout},
+		{name: "connection refused", err: errors.New("dial tcp 10.0.0.1:16993: connect: connection refused"), expectUpstream: true, expectedStatus: http.StatusBadGateway},
+		{name: "network unreachable", err: errors.New("connect: network is unreachable"), expectUpstream: true, expectedStatus: http.StatusBadGateway},
+		{name: "no route to host", err: errors.New("connect: no route to host"), expectUpstream: true, expectedStatus: http.StatusBadGateway},
+		{name: "timeout wins over unreachable", err: errors.New("host unreachable: i/o timeout"), expectTimeout: true, expectedStatus: http.StatusGatewayTimeout},
+		{name: "unauthorized", err: errors.New("wsman: 401 Unauthorized"), expectAuth: true, expectedStatus: http.StatusBadGateway},
+		{name: "authentication failed", err: errors.New("digest Authentication Failed for device"), expectAuth: true, expectedStatus: http.StatusBadGateway},
+		{name: "bare 401 status", err: fmt.Errorf("unexpected status %d", http.StatusUnauthorized), expectAuth: true, expectedStatus: http.StatusBadGateway},
+		{name: "401 inside a port is not auth", err: errors.New("dial tcp 10.0.0.1:4010: connect: connection refused"), expectUpstream: true, expectedStatus: http.StatusBadGateway},
+		{name: "timeout wins over unauthorized", err: errors.New("unauthorized: i/o timeout"), expectTimeout: true, expectedStatus: http.StatusGatewayTimeout},
+		{name: "context canceled", err: context.Canceled, expectedStatus: http.StatusInternalServerError},
+		{name: "unrelated error", err: errors.New("invalid credentials"), expectedStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expectTimeout, isTimeoutError(tt.err))
+			assert.Equal(t, tt.expectUpstream, isUpstreamCommunicationError(tt.err))
+			assert.Equal(t, tt.expectAuth, isDeviceAuthError(tt.err))
+
+			gin.SetMode(gin.TestMode)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			deviceCallError(c, tt.err)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// The two 502 classes tell the operator different things
+			switch {
+			case tt.expectAuth:
+				assert.Contains(t, w.Body.String(), "rejected the stored credentials")
+			case tt.expectUpstream:
+				assert.Contains(t, w.Body.String(), "unavailable or unreachable")
+			}
+		})
+	}
+}
+
+func TestConfiguredDeviceErrorPatterns(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{}
+	cfg.Redfish.TimeoutErrorPatterns = []string{"  AMT Did Not Answer  ", ""}
+	cfg.Redfish.UpstreamErrorPatterns = []string{"mps tunnel closed", "Connection Refused"}
+	cfg.Redfish.DeviceAuthErrorPatterns = []string{"digest realm mismatch"}
+
+	tests := []struct {
+		name              string
+		err               error
+		defaultStatus     int
+		configuredStatus  int
+		configuredMessage string
+	}{
+		{
+			name:              "configured timeout pattern",
+			err:               errors.New("wsman: amt did not answer"),
+			defaultStatus:     http.StatusInternalServerError,
+			configuredStatus:  http.StatusGatewayTimeout,
+			configuredMessage: "did not respond in time",
+		},
+		{
+			name:              "configured upstream pattern",
+			err:               errors.New("device 1234: MPS tunnel closed"),
+			defaultStatus:     http.StatusInternalServerError,
+			configuredStatus:  http.StatusBadGateway,
+			configuredMessage: "unavailable or unreachable",
+		},
+		{
+			name:              "configured auth pattern",
+			err:               errors.New("wsman: Digest realm mismatch"),
+			defaultStatus:     http.StatusInternalServerError,
+			configuredStatus:  http.StatusBadGateway,
+			configuredMessage: "rejected the stored credentials",
+		},
+		{
+			name:              "built-in patterns still apply",
+			err:               errors.New("connect: no route to host"),
+			defaultStatus:     http.StatusBadGateway,
+			configuredStatus:  http.StatusBadGateway,
+			configuredMessage: "unavailable or unreachable",
+		},
+		{
+			name:             "unmatched error",
+			err:              errors.New("invalid credentials"),
+			defaultStatus:    http.StatusInternalServerError,
+			configuredStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+
+			serve := func(middleware gin.HandlerFunc) *httptest.ResponseRecorder {
+				router := gin.New()
+				router.GET("/device", middleware, func(c *gin.Context) { deviceCallError(c, tt.err) })
+
+				w := httptest.NewRecorder()
+				req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/device", http.NoBody)
+
+				router.ServeHTTP(w, req)
+
+				return w
+			}
+
+			assert.Equal(t, tt.defaultStatus, serve(RedfishErrorClassifierMiddleware(nil)).Code)
+
+			w := serve(RedfishErrorClassifierMiddleware(cfg))
+			assert.Equal(t, tt.configuredStatus, w.Code)
+
+			if tt.configuredMessage != "" {
+				assert.Contains(t, w.Body.String(), tt.configuredMessage)
+			}
+		})
+	}
+}
+
+func TestTypedDeviceErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		err            error
+		expectTimeout  bool
+		expectUpstream bool
+		expectAuth     bool
+		expectedStatus int
+		expectedRetry  string
+	}{
+		{
+			name:           "typed timeout",
+			err:            fmt.Errorf("get power state: %w", consoleerrors.ErrUpstreamTimeout),
+			expectTimeout:  true,
+			expectedStatus: http.StatusGatewayTimeout,
+		},
+		{
+			name:           "typed unavailable",
+			err:            fmt.Errorf("%w: wsman session closed", consoleerrors.ErrUpstreamUnavailable),
+			expectUpstream: true,
+			expectedStatus: http.StatusBadGateway,
+			expectedRetry:  "30",
+		},
+		{
+			name:           "typed authentication failure",
+			err:            fmt.Errorf("%w: digest challenge", consoleerrors.ErrDeviceAuthentication),
+			expectAuth:     true,
+			expectedStatus: http.StatusBadGateway,
+		},
+		{
+			name:           "typed overload",
+			err:            fmt.Errorf("%w: too many sessions", consoleerrors.ErrServiceOverloaded),
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedRetry:  "30",
+		},
+		{
+			name:           "sentinel wins over a conflicting message",
+			err:            fmt.Errorf("%w: dial tcp: connection refused", consoleerrors.ErrUpstreamTimeout),
+			expectTimeout:  true,
+			expectedStatus: http.StatusGatewayTimeout,
+		},
+		{
+			name:           "sentinel wins over a wrapped deadline",
+			err:            fmt.Errorf("%w: %w", consoleerrors.ErrUpstreamUnavailable, context.DeadlineExceeded),
+			expectUpstream: true,
+			expectedStatus: http.StatusBadGateway,
+			expectedRetry:  "30",
+		},
+		{
+			name:           "untyped error falls back to the patterns",
+			err:            errors.New("dial tcp: connection refused"),
+			expectUpstream: true,
+			expectedStatus: http.StatusBadGateway,
+			expectedRetry:  "30",
+		},
+		{
+			name:           "overload text alone is not classified",
+			err:            errors.New("service overloaded"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expectTimeout, isTimeoutError(tt.err))
+			assert.Equal(t, tt.expectUpstream, isUpstreamCommunicationError(tt.err))
+			assert.Equal(t, tt.expectAuth, isDeviceAuthError(tt.err))
+
+			gin.SetMode(gin.TestMode)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			deviceCallError(c, tt.err)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedRetry, w.Header().Get("Retry-After"))
+		})
+	}
+}
+
+func TestNewDeviceErrorClassifier(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, defaultDeviceErrorClassifier, newDeviceErrorClassifier(nil))
+	assert.Equal(t, defaultDeviceErrorClassifier, newDeviceErrorClassifier(&config.Config{}))
+
+	cfg := &config.Config{}
+	cfg.Redfish.UpstreamErrorPatterns = []string{" Tunnel Closed ", "connection refused", "   "}
+
+	classifier := newDeviceErrorClassifier(cfg)
+
+	assert.Equal(t, append(slices.Clone(upstreamErrorMarkers), "tunnel closed"), classifier.upstreamMarkers)
+	assert.Equal(t, timeoutErrorMarkers, classifier.timeoutMarkers)
+	assert.True(t, classifier.isUpstreamCommunication(errors.New("mps: TUNNEL CLOSED")))
+	assert.False(t, isUpstreamCommunicationError(errors.New("mps: TUNNEL CLOSED")), "the package default is unchanged")
+	assert.Len(t, upstreamErrorMarkers, 4, "configured patterns do not leak into the defaults")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	assert.Equal(t, defaultDeviceErrorClassifier, errorClassifier(c), "no middleware falls back to the defaults")
+}
+
+// timeoutNetError is a net.Error that reports a timeout without a timeout-like message
+type timeoutNetError struct{}
+
+func (timeoutNetError) Error() string   { return "read failed" }
+func (timeoutNetError) Timeout() bool   { return true }
+func (timeoutNetError) Temporary() bool { return false }
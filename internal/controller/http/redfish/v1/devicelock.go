@@ -0,0 +1,116 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements per-device operation locks for the Redfish API v1.
+package v1
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/device-management-toolkit/console/config"
+)
+
+// DefaultDeviceLockTimeout bounds how long a device operation waits for a conflicting one when none is configured
+const DefaultDeviceLockTimeout = 5 * time.Second
+
+// deviceLockShards spreads system ids over several maps so that unrelated devices rarely share a map lock
+const deviceLockShards = 32
+
+// deviceLock is the lock of one system id. The one-slot channel is the mutex, which lets a waiter
+// give up; refs counts holders and waiters so the lock can be dropped once nobody needs it.
+type deviceLock struct {
+	slot chan struct{}
+	refs int
+}
+
+type deviceLockShard struct {
+	mu    sync.Mutex
+	locks map[string]*deviceLock
+}
+
+// deviceLocks serializes conflicting operations on the same system while operations on different
+// systems proceed in parallel
+type deviceLocks struct {
+	shards [deviceLockShards]deviceLockShard
+}
+
+func newDeviceLocks() *deviceLocks {
+	locks := &deviceLocks{}
+	for i := range locks.shards {
+		locks.shards[i].locks = map[string]*deviceLock{}
+	}
+
+	return locks
+}
+
+// acquire waits up to timeout, or until ctx ends, for the lock of id. On success it returns the
+// function releasing the lock; otherwise ok is false and nothing is held.
+func (dl *deviceLocks) acquire(ctx context.Context, id string, timeout time.Duration) (release func(), ok bool) {
+	shard := dl.shard(id)
+	lock := shard.ref(id)
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case lock.slot <- struct{}{}:
+		return func() {
+			<-lock.slot
+			shard.unref(id, lock)
+		}, true
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	shard.unref(id, lock)
+
+	return nil, false
+}
+
+func (dl *deviceLocks) shard(id string) *deviceLockShard {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(id))
+
+	return &dl.shards[h.Sum32()%deviceLockShards]
+}
+
+// ref returns the lock of id, creating it when needed, and counts the caller as a user
+func (s *deviceLockShard) ref(id string) *deviceLock {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lock, ok := s.locks[id]
+	if !ok {
+		lock = &deviceLock{slot: make(chan struct{}, 1)}
+		s.locks[id] = lock
+	}
+
+	lock.refs++
+
+	return lock
+}
+
+// unref drops the caller as a user of lock, removing it once unused
+func (s *deviceLockShard) unref(id string, lock *deviceLock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lock.refs--
+	if lock.refs == 0 {
+		delete(s.locks, id)
+	}
+}
+
+// deviceLockTimeout returns the configured device lock wait, falling back to DefaultDeviceLockTimeout
+func deviceLockTimeout(cfg *config.Config) time.Duration {
+	if cfg == nil || cfg.Redfish.DeviceLockTimeout <= 0 {
+		return DefaultDeviceLockTimeout
+	}
+
+	return cfg.Redfish.DeviceLockTimeout
+}
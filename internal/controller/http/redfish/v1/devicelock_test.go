@@ -0,0 +1,241 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/mocks"
+)
+
+const otherSystemGUID = "4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a99"
+
+func newResetRouter(t *testing.T, mockFeature *mocks.MockDeviceManagementFeature, cfg *config.Config) *gin.Engine {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/redfish/v1/Systems/:id/Actions/ComputerSystem.Reset", postSystemResetHandler(mockFeature, nil, NewTaskStore(), newDeviceLocks(), cfg, mockLogger))
+
+	return router
+}
+
+func postReset(router *gin.Engine, id string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost,
+		"/redfish/v1/Systems/"+id+"/Actions/ComputerSystem.Reset", strings.NewReader(`{"ResetType":"ForceRestart"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestConcurrentResetsSerialize(t *testing.T) {
+	t.Parallel()
+
+	const resets = 5
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	var active, maxActive atomic.Int32
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().SendPowerAction(gomock.Any(), testSystemGUID, actionReset).
+		DoAndReturn(func(context.Context, string, int) (power.PowerActionResponse, error) {
+			n := active.Add(1)
+			defer active.Add(-1)
+
+			for {
+				seen := maxActive.Load()
+				if n <= seen || maxActive.CompareAndSwap(seen, n) {
+					break
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+
+			return power.PowerActionResponse{ReturnValue: 0}, nil
+		}).Times(resets)
+
+	router := newResetRouter(t, mockFeature, nil)
+
+	var wg sync.WaitGroup
+
+	codes := make([]int, resets)
+
+	for i := range resets {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			codes[i] = postReset(router, testSystemGUID).Code
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(1), maxActive.Load(), "resets of one system must not overlap")
+
+	for _, code := range codes {
+		assert.Equal(t, http.StatusOK, code)
+	}
+}
+
+func TestResetsOfDifferentSystemsRunInParallel(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	var arrived sync.WaitGroup
+
+	arrived.Add(2)
+
+	bothInFlight := make(chan struct{})
+
+	go func() {
+		arrived.Wait()
+		close(bothInFlight)
+	}()
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().SendPowerAction(gomock.Any(), gomock.Any(), actionReset).
+		DoAndReturn(func(context.Context, string, int) (power.PowerActionResponse, error) {
+			arrived.Done()
+
+			select {
+			case <-bothInFlight:
+				return power.PowerActionResponse{}, nil
+			case <-time.After(time.Second):
+				return power.PowerActionResponse{}, context.DeadlineExceeded
+			}
+		}).Times(2)
+
+	router := newResetRouter(t, mockFeature, nil)
+
+	var wg sync.WaitGroup
+
+	codes := make([]int, 2)
+
+	for i, id := range []string{testSystemGUID, otherSystemGUID} {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			codes[i] = postReset(router, id).Code
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, []int{http.StatusOK, http.StatusOK}, codes)
+}
+
+func TestResetLockTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().SendPowerAction(gomock.Any(), testSystemGUID, actionReset).
+		DoAndReturn(func(context.Context, string, int) (power.PowerActionResponse, error) {
+			close(started)
+			<-release
+
+			return power.PowerActionResponse{}, nil
+		}).Times(1)
+
+	cfg := &config.Config{}
+	cfg.Redfish.DeviceLockTimeout = 20 * time.Millisecond
+
+	router := newResetRouter(t, mockFeature, cfg)
+
+	first := make(chan int)
+
+	go func() { first <- postReset(router, testSystemGUID).Code }()
+
+	<-started
+
+	w := postReset(router, testSystemGUID)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+
+	close(release)
+	assert.Equal(t, http.StatusOK, <-first)
+}
+
+func TestDeviceLocks(t *testing.T) {
+	t.Parallel()
+
+	locks := newDeviceLocks()
+
+	release, ok := locks.acquire(context.Background(), testSystemGUID, time.Second)
+	require.True(t, ok)
+
+	_, ok = locks.acquire(context.Background(), testSystemGUID, 10*time.Millisecond)
+	assert.False(t, ok, "a held lock times out")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, ok = locks.acquire(ctx, testSystemGUID, time.Second)
+	assert.False(t, ok, "an ended context stops waiting")
+
+	otherRelease, ok := locks.acquire(context.Background(), otherSystemGUID, 10*time.Millisecond)
+	require.True(t, ok, "other systems are not blocked")
+	otherRelease()
+
+	release()
+
+	release, ok = locks.acquire(context.Background(), testSystemGUID, 10*time.Millisecond)
+	require.True(t, ok, "a released lock can be taken again")
+	release()
+
+	for i := range locks.shards {
+		assert.Empty(t, locks.shards[i].locks, "unused locks are dropped")
+	}
+}
+
+func TestDeviceLockTimeout(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, DefaultDeviceLockTimeout, deviceLockTimeout(nil))
+	assert.Equal(t, DefaultDeviceLockTimeout, deviceLockTimeout(&config.Config{}))
+
+	cfg := &config.Config{}
+	cfg.Redfish.DeviceLockTimeout = time.Second
+
+	assert.Equal(t, time.Second, deviceLockTimeout(cfg))
+}
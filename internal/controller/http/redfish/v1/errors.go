@@ -7,50 +7,76 @@
 package v1
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
-	"strings"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v5"
-
-	"github.com/device-management-toolkit/console/config"
 )
 
 // Redfish Base Message Registry v1.11.0 Message IDs
 const (
-	BaseSuccessMessageID         = "Base.1.11.0.Success"
-	BaseErrorMessageID           = "Base.1.11.0.GeneralError"
-	BaseMalformedJSONID          = "Base.1.11.0.MalformedJSON"
-	BasePropertyMissingID        = "Base.1.11.0.PropertyMissing"
-	BasePropertyValueNotInListID = "Base.1.11.0.PropertyValueNotInList"
-	BaseResourceNotFoundID       = "Base.1.11.0.ResourceNotFound"
-	BaseOperationNotAllowedID    = "Base.1.11.0.OperationNotAllowed"
-	BaseActionNotSupportedID     = "Base.1.11.0.ActionNotSupported"
-	BaseNoValidSessionID         = "Base.1.11.0.NoValidSession"
-	BaseInsufficientPrivilegeID  = "Base.1.11.0.InsufficientPrivilege"
-	BaseNotAcceptableID          = "Base.1.11.0.NotAcceptable"
+	BaseSuccessMessageID            = "Base.1.11.0.Success"
+	BaseErrorMessageID              = "Base.1.11.0.GeneralError"
+	BaseMalformedJSONID             = "Base.1.11.0.MalformedJSON"
+	BasePropertyMissingID           = "Base.1.11.0.PropertyMissing"
+	BasePropertyValueNotInListID    = "Base.1.11.0.PropertyValueNotInList"
+	BasePropertyNotWritableID       = "Base.1.11.0.PropertyNotWritable"
+	BasePropertyValueFormatErrorID  = "Base.1.11.0.PropertyValueFormatError"
+	BaseArraySizeTooLongID          = "Base.1.11.0.ArraySizeTooLong"
+	BaseResourceAlreadyExistsID     = "Base.1.11.0.ResourceAlreadyExists"
+	BaseResourceNotFoundID          = "Base.1.11.0.ResourceNotFound"
+	BaseOperationNotAllowedID       = "Base.1.11.0.OperationNotAllowed"
+	BaseActionNotSupportedID        = "Base.1.11.0.ActionNotSupported"
+	BaseNoValidSessionID            = "Base.1.11.0.NoValidSession"
+	BaseInsufficientPrivilegeID     = "Base.1.11.0.InsufficientPrivilege"
+	BaseNotAcceptableID             = "Base.1.11.0.NotAcceptable"
+	BaseUnsupportedMediaTypeID      = "Base.1.11.0.UnsupportedMediaType"
+	BaseQueryParameterOutOfRangeID  = "Base.1.11.0.QueryParameterOutOfRange"
+	BaseQueryParameterUnsupportedID = "Base.1.11.0.QueryParameterUnsupported"
+	BaseRequestTooLargeID           = "Base.1.11.0.RequestTooLarge"
+	BasePreconditionFailedID        = "Base.1.11.0.PreconditionFailed"
+	BaseHeaderInvalidID             = "Base.1.11.0.HeaderInvalid"
 )
 
-// redfishError creates a standard Redfish error response structure
-func redfishError(messageID, message, severity, resolution string, messageArgs []string) map[string]any {
-	extendedInfo := map[string]any{
-		"MessageId":  messageID,
-		"Message":    message,
-		"Severity":   severity,
-		"Resolution": resolution,
-	}
+// RedfishError is the body of every Redfish error response
+type RedfishError struct {
+	Error RedfishErrorBody `json:"error"`
+}
 
-	// Only add MessageArgs if provided and not empty
-	if len(messageArgs) > 0 {
-		extendedInfo["MessageArgs"] = messageArgs
-	}
+// RedfishErrorBody is the error object of a RedfishError. Fields are declared in the order of their
+// JSON names so the encoding matches the map-based responses clients already parse.
+type RedfishErrorBody struct {
+	ExtendedInfo []ExtendedInfo `json:"@Message.ExtendedInfo"`
+	Code         string         `json:"code"`
+	Message      string         `json:"message"`
+}
+
+// ExtendedInfo is a Redfish Message carried in @Message.ExtendedInfo, with fields in JSON name order
+type ExtendedInfo struct {
+	Message     string   `json:"Message"`
+	MessageArgs []string `json:"MessageArgs,omitempty"`
+	MessageID   string   `json:"MessageId"`
+	Resolution  string   `json:"Resolution"`
+	Severity    string   `json:"Severity"`
+}
 
-	return map[string]any{
-		"error": map[string]any{
-			"@Message.ExtendedInfo": []map[string]any{extendedInfo},
-			"code":                  messageID,
-			"message":               message,
+// redfishError creates a standard Redfish error response structure. MessageArgs is omitted when empty.
+func redfishError(messageID, message, severity, resolution string, messageArgs []string) RedfishError {
+	return RedfishError{
+		Error: RedfishErrorBody{
+			ExtendedInfo: []ExtendedInfo{{
+				Message:     message,
+				MessageArgs: messageArgs,
+				MessageID:   messageID,
+				Resolution:  resolution,
+				Severity:    severity,
+			}},
+			Code:    messageID,
+			Message: message,
 		},
 	}
 }
@@ -58,7 +84,7 @@ func redfishError(messageID, message, severity, resolution string, messageArgs [
 // SetRedfishHeaders sets standard Redfish-compliant HTTP headers
 func SetRedfishHeaders(c *gin.Context) {
 	c.Header("Content-Type", "application/json; charset=utf-8")
-	c.Header("OData-Version", "4.0")
+	c.Header(odataVersionHeader, odataVersion)
 	c.Header("Cache-Control", "no-cache")
 	c.Header("X-Frame-Options", "DENY")
 	c.Header("Content-Security-Policy", "default-src 'self'")
@@ -70,16 +96,46 @@ func redfishErrorResponse(c *gin.Context, statusCode int, messageID, message, se
 	c.JSON(statusCode, redfishError(messageID, message, severity, resolution, messageArgs))
 }
 
-// MalformedJSONError returns a Redfish-compliant error for malformed JSON requests
-func MalformedJSONError(c *gin.Context) {
+// MalformedJSONError returns a Redfish-compliant error for malformed JSON requests. The Base
+// message takes no arguments, so a summary of the parse error, if any, leads the resolution.
+func MalformedJSONError(c *gin.Context, err error) {
+	resolution := "Ensure that the request body is valid JSON and resubmit the request."
+	if err != nil {
+		resolution = malformedJSONDetail(err) + " " + resolution
+	}
+
 	redfishErrorResponse(c, http.StatusBadRequest,
 		BaseMalformedJSONID,
 		"The request body submitted was malformed JSON and could not be parsed by the receiving service.",
 		"Critical",
-		"Ensure that the request body is valid JSON and resubmit the request.",
+		resolution,
 		nil)
 }
 
+// malformedJSONDetail describes a request body parse error from what the client sent, without the
+// decoder's own wording or Go type names
+func malformedJSONDetail(err error) string {
+	var (
+		syntaxErr *json.SyntaxError
+		typeErr   *json.UnmarshalTypeError
+	)
+
+	switch {
+	case errors.Is(err, io.EOF):
+		return "The request body is empty."
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return "The request body ends unexpectedly."
+	case errors.As(err, &syntaxErr):
+		return fmt.Sprintf("Invalid JSON syntax at offset %d.", syntaxErr.Offset)
+	case errors.As(err, &typeErr) && typeErr.Field != "":
+		return fmt.Sprintf("Unexpected %s value for the property %s.", typeErr.Value, typeErr.Field)
+	case errors.As(err, &typeErr):
+		return fmt.Sprintf("Unexpected %s value for the request body.", typeErr.Value)
+	default:
+		return "The request body could not be read."
+	}
+}
+
 // PropertyMissingError returns a Redfish-compliant error for missing required properties
 func PropertyMissingError(c *gin.Context, propertyName string) {
 	redfishErrorResponse(c, http.StatusBadRequest,
@@ -100,6 +156,71 @@ func PropertyValueNotInListError(c *gin.Context, value, propertyName string) {
 		[]string{value, propertyName})
 }
 
+// PropertyNotWritableError returns a Redfish-compliant error for attempts to modify read-only properties
+func PropertyNotWritableError(c *gin.Context, propertyName string) {
+	redfishErrorResponse(c, http.StatusBadRequest,
+		BasePropertyNotWritableID,
+		fmt.Sprintf("The property %s is a read-only property and cannot be assigned a value.", propertyName),
+		"Warning",
+		"Remove the property from the request body and resubmit the request if the operation failed.",
+		[]string{propertyName})
+}
+
+// PropertyValueFormatError returns a Redfish-compliant error for property values of the wrong format
+func PropertyValueFormatError(c *gin.Context, value, propertyName string) {
+	redfishErrorResponse(c, http.StatusBadRequest,
+		BasePropertyValueFormatErrorID,
+		fmt.Sprintf("The value '%s' for the property %s is of a different format than the property can accept.", value, propertyName),
+		"Warning",
+		"Correct the value for the property in the request body and resubmit the request if the operation failed.",
+		[]string{value, propertyName})
+}
+
+// ArraySizeTooLongError returns a Redfish-compliant error for array properties with more than maxSize elements
+func ArraySizeTooLongError(c *gin.Context, propertyName string, maxSize int) {
+	redfishErrorResponse(c, http.StatusBadRequest,
+		BaseArraySizeTooLongID,
+		fmt.Sprintf("The array provided for property %s exceeds the size limit %d.", propertyName, maxSize),
+		"Warning",
+		"Resubmit the request with an appropriate array size.",
+		[]string{propertyName, strconv.Itoa(maxSize)})
+}
+
+// redactedValue stands in for a rejected value that must not be echoed back, such as a password
+const redactedValue = "******"
+
+// PasswordPolicyError returns a Redfish-compliant PropertyValueFormatError for passwords that do not
+// meet the password policy. The rejected value is never echoed back; the policy is described in the
+// resolution.
+func PasswordPolicyError(c *gin.Context, minLength, maxLength int) {
+	redfishErrorResponse(c, http.StatusBadRequest,
+		BasePropertyValueFormatErrorID,
+		fmt.Sprintf("The value '%s' for the property Password is of a different format than the property can accept.", redactedValue),
+		"Warning",
+		fmt.Sprintf("Provide a password of %d to %d characters containing upper case, lower case, digit and special characters and resubmit the request.", minLength, maxLength),
+		[]string{redactedValue, "Password"})
+}
+
+// QueryParameterValueError returns a Redfish-compliant error for query parameter values the service cannot honor
+func QueryParameterValueError(c *gin.Context, paramName, value string) {
+	redfishErrorResponse(c, http.StatusBadRequest,
+		BaseQueryParameterOutOfRangeID,
+		fmt.Sprintf("The value '%s' for the query parameter %s is out of range.", value, paramName),
+		"Warning",
+		"Reduce the value for the query parameter to a value that is within range, such as a start or count value that is within bounds of the number of resources in a collection or a page that is within the range of valid pages.",
+		[]string{value, paramName})
+}
+
+// QueryNotSupportedError returns a Redfish-compliant error for query parameters or expressions the service does not implement
+func QueryNotSupportedError(c *gin.Context, param string) {
+	redfishErrorResponse(c, http.StatusBadRequest,
+		BaseQueryParameterUnsupportedID,
+		fmt.Sprintf("Query parameter '%s' is not supported.", param),
+		"Warning",
+		"Correct or remove the query parameter and resubmit the request.",
+		[]string{param})
+}
+
 // ResourceNotFoundError returns a Redfish-compliant error for missing resources
 func ResourceNotFoundError(c *gin.Context, resourceType, resourceID string) {
 	redfishErrorResponse(c, http.StatusNotFound,
@@ -110,6 +231,16 @@ func ResourceNotFoundError(c *gin.Context, resourceType, resourceID string) {
 		[]string{resourceType, resourceID})
 }
 
+// ResourceAlreadyExistsError returns a Redfish-compliant error for creating a resource that already exists (409)
+func ResourceAlreadyExistsError(c *gin.Context, resourceType, resourceID string) {
+	redfishErrorResponse(c, http.StatusConflict,
+		BaseResourceAlreadyExistsID,
+		fmt.Sprintf("The requested resource of type %s named '%s' already exists.", resourceType, resourceID),
+		"Critical",
+		"Do not repeat the create operation as the resource has already been created.",
+		[]string{resourceType, resourceID})
+}
+
 // OperationNotAllowedError returns a Redfish-compliant error for operations not allowed due to resource state
 func OperationNotAllowedError(c *gin.Context) {
 	redfishErrorResponse(c, http.StatusConflict,
@@ -120,6 +251,26 @@ func OperationNotAllowedError(c *gin.Context) {
 		nil)
 }
 
+// ActionNotSupportedError returns a Redfish-compliant error for actions the service cannot perform
+func ActionNotSupportedError(c *gin.Context, action string) {
+	redfishErrorResponse(c, http.StatusBadRequest,
+		BaseActionNotSupportedID,
+		fmt.Sprintf("The action %s is not supported by the resource.", action),
+		"Critical",
+		"The action supplied cannot be resubmitted to the implementation. Perhaps the action was invalid, the wrong resource was the target or the implementation documentation may be of assistance.",
+		[]string{action})
+}
+
+// NotImplementedError returns a Redfish-compliant error for features the service advertises but does not implement yet (501)
+func NotImplementedError(c *gin.Context, feature string) {
+	redfishErrorResponse(c, http.StatusNotImplemented,
+		BaseActionNotSupportedID,
+		fmt.Sprintf("The action %s is not supported by the resource.", feature),
+		"Critical",
+		fmt.Sprintf("%s is not implemented by this service. Check the service documentation for supported operations.", feature),
+		[]string{feature})
+}
+
 // MethodNotAllowedError returns a Redfish-compliant error for HTTP method not allowed (405)
 func MethodNotAllowedError(c *gin.Context, action, allowedMethods string) {
 	// Set the required Allow header for 405 responses
@@ -166,6 +317,18 @@ func InsufficientPrivilegeError(c *gin.Context) {
 		nil)
 }
 
+// RequestEntityTooLargeError returns a Redfish-compliant error for a request body over the size limit (413)
+func RequestEntityTooLargeError(c *gin.Context, maxBytes int64) {
+	limit := strconv.FormatInt(maxBytes, 10)
+
+	redfishErrorResponse(c, http.StatusRequestEntityTooLarge,
+		BaseRequestTooLargeID,
+		"The request body exceeds the maximum size of "+limit+" bytes accepted by the service.",
+		"Critical",
+		"Reduce the size of the request body and resubmit the request.",
+		[]string{limit})
+}
+
 // NotAcceptableError returns a Redfish-compliant error for unsupported media type (406)
 func NotAcceptableError(c *gin.Context, requestedType string) {
 	redfishErrorResponse(c, http.StatusNotAcceptable,
@@ -176,58 +339,36 @@ func NotAcceptableError(c *gin.Context, requestedType string) {
 		[]string{requestedType})
 }
 
-// RedfishJWTAuthMiddleware provides Redfish-compliant authentication error responses
-func RedfishJWTAuthMiddleware(cfg *config.Config) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		tokenString := c.GetHeader("Authorization")
-		tokenString = strings.Replace(tokenString, "Bearer ", "", 1)
-
-		if tokenString == "" {
-			NoValidSessionError(c)
-			c.Abort()
-
-			return
-		}
-
-		// if clientID is set, use the oidc verifier (this would need the verifier passed in)
-		if cfg.ClientID != "" {
-			// For OAuth/OIDC, we'd need to pass the verifier or handle differently
-			// For now, return a general authentication error
-			NoValidSessionError(c)
-			c.Abort()
-
-			return
-		}
-
-		claims := &jwt.MapClaims{}
-
-		token, err := jwt.ParseWithClaims(tokenString, claims, func(_ *jwt.Token) (interface{}, error) {
-			return []byte(cfg.JWTKey), nil
-		})
-
-		if err != nil || !token.Valid {
-			NoValidSessionError(c)
-			c.Abort()
-
-			return
-		}
-
-		c.Next()
-	}
+// UnsupportedMediaTypeError returns a Redfish-compliant error for request bodies that are not declared as JSON
+func UnsupportedMediaTypeError(c *gin.Context, contentType string) {
+	redfishErrorResponse(c, http.StatusUnsupportedMediaType,
+		BaseUnsupportedMediaTypeID,
+		fmt.Sprintf("The content type '%s' of the request body is not supported. This service only accepts 'application/json'.", contentType),
+		"Warning",
+		"Resubmit the request with the Content-Type header set to 'application/json'.",
+		[]string{contentType})
 }
 
-// GeneralError returns a Redfish-compliant error for general internal errors
+// GeneralError returns a Redfish-compliant error for general internal errors. The Base message
+// takes no arguments, so the request id, when one was assigned, is given in the resolution so the
+// failure can be found in the service log.
 func GeneralError(c *gin.Context) {
+	resolution := "None."
+	if id := requestID(c); id != noRequestID {
+		resolution = fmt.Sprintf("Report request id %s to the service administrator.", id)
+	}
+
 	redfishErrorResponse(c, http.StatusInternalServerError,
 		BaseErrorMessageID,
 		"A general error has occurred. See ExtendedInfo for more information.",
 		"Critical",
-		"None.",
+		resolution,
 		nil)
 }
 
 // BadGatewayError returns a Redfish-compliant error for upstream service communication failures (502 Bad Gateway)
 func BadGatewayError(c *gin.Context) {
+	c.Header("Retry-After", strconv.Itoa(retryAfter(c)))
 	redfishErrorResponse(c, http.StatusBadGateway,
 		BaseErrorMessageID,
 		"The upstream service or managed device is unavailable or unreachable.",
@@ -236,20 +377,61 @@ func BadGatewayError(c *gin.Context) {
 		nil)
 }
 
-// ServiceUnavailableError returns a Redfish-compliant error for upstream service communication failures (502 Bad Gateway)
-// Deprecated: Use BadGatewayError for 502 errors or ServiceTemporarilyUnavailableError for 503 errors
-func ServiceUnavailableError(c *gin.Context) {
+// PreconditionFailedError returns a Redfish-compliant error for a write whose If-Match header does
+// not match the resource's current ETag (412 Precondition Failed)
+func PreconditionFailedError(c *gin.Context) {
+	redfishErrorResponse(c, http.StatusPreconditionFailed,
+		BasePreconditionFailedID,
+		"The ETag supplied did not match the ETag required to change this resource.",
+		"Critical",
+		"Try the operation again using the appropriate ETag.",
+		nil)
+}
+
+// ODataVersionError returns a Redfish-compliant error for a request whose OData-Version header names
+// a protocol version the service does not speak (412 Precondition Failed, as DSP0266 prescribes)
+func ODataVersionError(c *gin.Context, version string) {
+	header := odataVersionHeader + ": " + version
+
+	redfishErrorResponse(c, http.StatusPreconditionFailed,
+		BaseHeaderInvalidID,
+		fmt.Sprintf("The header '%s' is invalid.", header),
+		"Critical",
+		"Resubmit the request with a valid value for the header.",
+		[]string{header})
+}
+
+// DeviceAuthenticationError returns a Redfish-compliant error for a managed device that rejected the
+// stored credentials (502 Bad Gateway). Retrying does not help until the credentials are fixed, so
+// unlike BadGatewayError it sends no Retry-After.
+func DeviceAuthenticationError(c *gin.Context) {
 	redfishErrorResponse(c, http.StatusBadGateway,
 		BaseErrorMessageID,
-		"The upstream service or managed device is unavailable or unreachable.",
+		"The managed device rejected the stored credentials.",
 		"Critical",
-		"Verify network connectivity to the managed device and ensure the device is powered on and accessible.",
+		"Update the AMT credentials stored for the device, then resubmit the request.",
+		nil)
+}
+
+// GatewayTimeoutError returns a Redfish-compliant error for device calls that exceed their deadline (504 Gateway Timeout)
+func GatewayTimeoutError(c *gin.Context) {
+	redfishErrorResponse(c, http.StatusGatewayTimeout,
+		BaseErrorMessageID,
+		"The upstream device did not respond in time.",
+		"Critical",
+		"Verify the managed device is powered on and responsive, then resubmit the request.",
 		nil)
 }
 
 // ServiceTemporarilyUnavailableError returns a Redfish-compliant error for temporary service unavailability (503 Service Unavailable)
 func ServiceTemporarilyUnavailableError(c *gin.Context) {
-	c.Header("Retry-After", "30") // Suggest retry after 30 seconds
+	ServiceTemporarilyUnavailableRetryError(c, retryAfter(c))
+}
+
+// ServiceTemporarilyUnavailableRetryError returns the 503 error of ServiceTemporarilyUnavailableError
+// with a Retry-After of the given number of seconds
+func ServiceTemporarilyUnavailableRetryError(c *gin.Context, retryAfterSeconds int) {
+	c.Header("Retry-After", strconv.Itoa(retryAfterSeconds))
 	redfishErrorResponse(c, http.StatusServiceUnavailable,
 		BaseErrorMessageID,
 		"The service is temporarily unavailable due to overloading or maintenance. Please retry the request after some time.",
@@ -257,25 +439,3 @@ func ServiceTemporarilyUnavailableError(c *gin.Context) {
 		"Wait for the specified retry period and resubmit the request.",
 		nil)
 }
-
-// RedfishRecoveryMiddleware provides Redfish-compliant error responses for panics (500)
-func RedfishRecoveryMiddleware() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		defer func() {
-			if err := recover(); err != nil {
-				// Log the panic for debugging (using logger instead of fmt.Printf)
-				// Note: Proper logger integration needed for production use
-				_ = err // Acknowledge the error without printing
-
-				// Check if response was already written
-				if !c.Writer.Written() {
-					GeneralError(c)
-				}
-
-				c.Abort()
-			}
-		}()
-
-		c.Next()
-	}
-}
@@ -8,146 +8,19 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
-	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
-
-	"github.com/device-management-toolkit/console/config"
+	"github.com/stretchr/testify/require"
 )
 
-func TestRedfishJWTAuthMiddleware(t *testing.T) {
-	t.Parallel()
-	tests := []struct {
-		name           string
-		authHeader     string
-		config         *config.Config
-		expectedStatus int
-		checkResponse  func(t *testing.T, body string, headers http.Header)
-	}{
-		{
-			name:       "missing authorization header",
-			authHeader: "",
-			config: &config.Config{
-				Auth: config.Auth{
-					Disabled: false,
-					JWTKey:   "test-secret-key",
-				},
-			},
-			expectedStatus: http.StatusUnauthorized,
-			checkResponse: func(t *testing.T, body string, headers http.Header) {
-				t.Helper()
-				assert.Contains(t, body, `"Base.1.11.0.NoValidSession"`)
-				assert.Contains(t, body, "no valid session established")
-				assert.Equal(t, "application/json; charset=utf-8", headers.Get("Content-Type"))
-				assert.Equal(t, "4.0", headers.Get("OData-Version"))
-			},
-		},
-		{
-			name:       "invalid JWT token",
-			authHeader: "Bearer invalid.jwt.token",
-			config: &config.Config{
-				Auth: config.Auth{
-					Disabled: false,
-					JWTKey:   "test-secret-key",
-				},
-			},
-			expectedStatus: http.StatusUnauthorized,
-			checkResponse: func(t *testing.T, body string, _ http.Header) {
-				t.Helper()
-				assert.Contains(t, body, `"Base.1.11.0.NoValidSession"`)
-				assert.Contains(t, body, "no valid session established")
-			},
-		},
-		{
-			name:       "expired JWT token",
-			authHeader: createExpiredJWT("test-secret-key"),
-			config: &config.Config{
-				Auth: config.Auth{
-					Disabled: false,
-					JWTKey:   "test-secret-key",
-				},
-			},
-			expectedStatus: http.StatusUnauthorized,
-			checkResponse: func(t *testing.T, body string, _ http.Header) {
-				t.Helper()
-				assert.Contains(t, body, `"Base.1.11.0.NoValidSession"`)
-			},
-		},
-		{
-			name:       "valid JWT token",
-			authHeader: createValidJWT("test-secret-key"),
-			config: &config.Config{
-				Auth: config.Auth{
-					Disabled: false,
-					JWTKey:   "test-secret-key",
-				},
-			},
-			expectedStatus: http.StatusOK,
-			checkResponse: func(t *testing.T, body string, _ http.Header) {
-				t.Helper()
-				assert.Equal(t, "success", body)
-			},
-		},
-		{
-			name:       "OAuth/OIDC config - not implemented",
-			authHeader: "Bearer some.oauth.token",
-			config: &config.Config{
-				Auth: config.Auth{
-					Disabled: false,
-					ClientID: "oauth-client-id",
-					JWTKey:   "test-secret-key",
-				},
-			},
-			expectedStatus: http.StatusUnauthorized,
-			checkResponse: func(t *testing.T, body string, _ http.Header) {
-				t.Helper()
-				assert.Contains(t, body, `"Base.1.11.0.NoValidSession"`)
-			},
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-
-			// Setup Gin
-			gin.SetMode(gin.TestMode)
-			router := gin.New()
-
-			// Add the middleware
-			router.Use(RedfishJWTAuthMiddleware(tt.config))
-
-			// Add a test endpoint
-			router.GET("/test", func(c *gin.Context) {
-				c.String(http.StatusOK, "success")
-			})
-
-			// Create request
-			w := httptest.NewRecorder()
-			req, _ := http.NewRequestWithContext(context.Background(), "GET", "/test", http.NoBody)
-
-			if tt.authHeader != "" {
-				req.Header.Set("Authorization", tt.authHeader)
-			}
-
-			// Execute request
-			router.ServeHTTP(w, req)
-
-			// Assertions
-			assert.Equal(t, tt.expectedStatus, w.Code)
-
-			if tt.checkResponse != nil {
-				tt.checkResponse(t, w.Body.String(), w.Header())
-			}
-		})
-	}
-}
-
 func TestSetRedfishHeaders(t *testing.T) {
 	t.Parallel()
 
@@ -175,8 +48,10 @@ func TestRedfishErrorResponses(t *testing.T) {
 		expectedMsg    string
 	}{
 		{
-			name:           "MalformedJSONError",
-			errorFunc:      MalformedJSONError,
+			name: "MalformedJSONError",
+			errorFunc: func(c *gin.Context) {
+				MalformedJSONError(c, io.EOF)
+			},
 			expectedStatus: http.StatusBadRequest,
 			expectedMsg:    "Base.1.11.0.MalformedJSON",
 		},
@@ -188,6 +63,22 @@ func TestRedfishErrorResponses(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 			expectedMsg:    "Base.1.11.0.PropertyMissing",
 		},
+		{
+			name: "PasswordPolicyError",
+			errorFunc: func(c *gin.Context) {
+				PasswordPolicyError(c, 8, 72)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedMsg:    "Base.1.11.0.PropertyValueFormatError",
+		},
+		{
+			name: "ArraySizeTooLongError",
+			errorFunc: func(c *gin.Context) {
+				ArraySizeTooLongError(c, "TestProperty", 10)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedMsg:    "Base.1.11.0.ArraySizeTooLong",
+		},
 		{
 			name: "PropertyValueNotInListError",
 			errorFunc: func(c *gin.Context) {
@@ -196,6 +87,14 @@ func TestRedfishErrorResponses(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 			expectedMsg:    "Base.1.11.0.PropertyValueNotInList",
 		},
+		{
+			name: "PropertyNotWritableError",
+			errorFunc: func(c *gin.Context) {
+				PropertyNotWritableError(c, "TestProperty")
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedMsg:    "Base.1.11.0.PropertyNotWritable",
+		},
 		{
 			name: "ResourceNotFoundError",
 			errorFunc: func(c *gin.Context) {
@@ -210,6 +109,14 @@ func TestRedfishErrorResponses(t *testing.T) {
 			expectedStatus: http.StatusConflict,
 			expectedMsg:    "Base.1.11.0.OperationNotAllowed",
 		},
+		{
+			name: "ActionNotSupportedError",
+			errorFunc: func(c *gin.Context) {
+				ActionNotSupportedError(c, "TestAction")
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedMsg:    "Base.1.11.0.ActionNotSupported",
+		},
 		{
 			name: "MethodNotAllowedError",
 			errorFunc: func(c *gin.Context) {
@@ -218,6 +125,22 @@ func TestRedfishErrorResponses(t *testing.T) {
 			expectedStatus: http.StatusMethodNotAllowed,
 			expectedMsg:    "Base.1.11.0.ActionNotSupported",
 		},
+		{
+			name: "QueryParameterValueError",
+			errorFunc: func(c *gin.Context) {
+				QueryParameterValueError(c, "$top", "-1")
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedMsg:    "Base.1.11.0.QueryParameterOutOfRange",
+		},
+		{
+			name: "QueryNotSupportedError",
+			errorFunc: func(c *gin.Context) {
+				QueryNotSupportedError(c, "$filter")
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedMsg:    "Base.1.11.0.QueryParameterUnsupported",
+		},
 		{
 			name:           "NoValidSessionError",
 			errorFunc:      NoValidSessionError,
@@ -230,6 +153,44 @@ func TestRedfishErrorResponses(t *testing.T) {
 			expectedStatus: http.StatusForbidden,
 			expectedMsg:    "Base.1.11.0.InsufficientPrivilege",
 		},
+		{
+			name: "NotImplementedError",
+			errorFunc: func(c *gin.Context) {
+				NotImplementedError(c, "BIOS settings modification")
+			},
+			expectedStatus: http.StatusNotImplemented,
+			expectedMsg:    "Base.1.11.0.ActionNotSupported",
+		},
+		{
+			name: "NotAcceptableError",
+			errorFunc: func(c *gin.Context) {
+				NotAcceptableError(c, "application/xml")
+			},
+			expectedStatus: http.StatusNotAcceptable,
+			expectedMsg:    "Base.1.11.0.NotAcceptable",
+		},
+		{
+			name: "RequestEntityTooLargeError",
+			errorFunc: func(c *gin.Context) {
+				RequestEntityTooLargeError(c, 1024)
+			},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedMsg:    "Base.1.11.0.RequestTooLarge",
+		},
+		{
+			name:           "PreconditionFailedError",
+			errorFunc:      PreconditionFailedError,
+			expectedStatus: http.StatusPreconditionFailed,
+			expectedMsg:    "Base.1.11.0.PreconditionFailed",
+		},
+		{
+			name: "UnsupportedMediaTypeError",
+			errorFunc: func(c *gin.Context) {
+				UnsupportedMediaTypeError(c, "text/plain")
+			},
+			expectedStatus: http.StatusUnsupportedMediaType,
+			expectedMsg:    "Base.1.11.0.UnsupportedMediaType",
+		},
 		{
 			name:           "GeneralError",
 			errorFunc:      GeneralError,
@@ -237,11 +198,23 @@ func TestRedfishErrorResponses(t *testing.T) {
 			expectedMsg:    "Base.1.11.0.GeneralError",
 		},
 		{
-			name:           "ServiceUnavailableError",
-			errorFunc:      ServiceUnavailableError,
+			name:           "BadGatewayError",
+			errorFunc:      BadGatewayError,
+			expectedStatus: http.StatusBadGateway,
+			expectedMsg:    "Base.1.11.0.GeneralError",
+		},
+		{
+			name:           "DeviceAuthenticationError",
+			errorFunc:      DeviceAuthenticationError,
 			expectedStatus: http.StatusBadGateway,
 			expectedMsg:    "Base.1.11.0.GeneralError",
 		},
+		{
+			name:           "GatewayTimeoutError",
+			errorFunc:      GatewayTimeoutError,
+			expectedStatus: http.StatusGatewayTimeout,
+			expectedMsg:    "Base.1.11.0.GeneralError",
+		},
 		{
 			name:           "ServiceTemporarilyUnavailableError",
 			errorFunc:      ServiceTemporarilyUnavailableError,
@@ -282,36 +255,337 @@ func TestRedfishErrorResponses(t *testing.T) {
 				assert.Equal(t, "POST", headers.Get("Allow"))
 			}
 
-			// For ServiceTemporarilyUnavailableError, check Retry-After header
-			if tt.name == "ServiceTemporarilyUnavailableError" {
+			// 502 and 503 responses carry the default Retry-After header, except a credential
+			// rejection that retrying cannot fix
+			if tt.name == "DeviceAuthenticationError" {
+				assert.Empty(t, headers.Get("Retry-After"))
+			} else if tt.expectedStatus == http.StatusBadGateway || tt.expectedStatus == http.StatusServiceUnavailable {
 				assert.Equal(t, "30", headers.Get("Retry-After"))
+			} else {
+				assert.Empty(t, headers.Get("Retry-After"))
+			}
+		})
+	}
+}
+
+func TestQueryErrorMessageArgs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		errorFunc    func(*gin.Context)
+		expectedID   string
+		expectedArgs []string
+	}{
+		{
+			name: "QueryParameterValueError",
+			errorFunc: func(c *gin.Context) {
+				QueryParameterValueError(c, "$skip", "abc")
+			},
+			expectedID:   BaseQueryParameterOutOfRangeID,
+			expectedArgs: []string{"abc", "$skip"},
+		},
+		{
+			name: "QueryNotSupportedError",
+			errorFunc: func(c *gin.Context) {
+				QueryNotSupportedError(c, "$expand")
+			},
+			expectedID:   BaseQueryParameterUnsupportedID,
+			expectedArgs: []string{"$expand"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			tt.errorFunc(c)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			var body RedfishError
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, tt.expectedID, body.Error.Code)
+			require.Len(t, body.Error.ExtendedInfo, 1)
+			assert.Equal(t, tt.expectedID, body.Error.ExtendedInfo[0].MessageID)
+			assert.Equal(t, tt.expectedArgs, body.Error.ExtendedInfo[0].MessageArgs)
+		})
+	}
+}
+
+func TestErrorMessageArgs(t *testing.T) {
+	t.Parallel()
+
+	var syntaxErr error = &json.SyntaxError{Offset: 7}
+
+	tests := []struct {
+		name         string
+		requestID    string
+		errorFunc    func(*gin.Context)
+		expectedArgs []string
+		// expectedResolution is checked when set
+		expectedResolution string
+	}{
+		{
+			name:               "MalformedJSONError with a syntax error",
+			errorFunc:          func(c *gin.Context) { MalformedJSONError(c, syntaxErr) },
+			expectedResolution: "Invalid JSON syntax at offset 7. Ensure that the request body is valid JSON and resubmit the request.",
+		},
+		{
+			name:      "MalformedJSONError without an error",
+			errorFunc: func(c *gin.Context) { MalformedJSONError(c, nil) },
+		},
+		{
+			name:         "PropertyMissingError",
+			errorFunc:    func(c *gin.Context) { PropertyMissingError(c, "ResetType") },
+			expectedArgs: []string{"ResetType"},
+		},
+		{
+			name:         "PropertyValueNotInListError",
+			errorFunc:    func(c *gin.Context) { PropertyValueNotInListError(c, "Sideways", "ResetType") },
+			expectedArgs: []string{"Sideways", "ResetType"},
+		},
+		{
+			name:         "PropertyNotWritableError",
+			errorFunc:    func(c *gin.Context) { PropertyNotWritableError(c, "Id") },
+			expectedArgs: []string{"Id"},
+		},
+		{
+			name:         "PropertyValueFormatError",
+			errorFunc:    func(c *gin.Context) { PropertyValueFormatError(c, "ftp://x", "Destination") },
+			expectedArgs: []string{"ftp://x", "Destination"},
+		},
+		{
+			name:         "ArraySizeTooLongError",
+			errorFunc:    func(c *gin.Context) { ArraySizeTooLongError(c, "Targets", 100) },
+			expectedArgs: []string{"Targets", "100"},
+		},
+		{
+			name:         "PasswordPolicyError",
+			errorFunc:    func(c *gin.Context) { PasswordPolicyError(c, 8, 32) },
+			expectedArgs: []string{redactedValue, "Password"},
+		},
+		{
+			name:               "GeneralError with a request id",
+			requestID:          "req-42",
+			errorFunc:          GeneralError,
+			expectedResolution: "Report request id req-42 to the service administrator.",
+		},
+		{
+			name:               "GeneralError without a request id",
+			errorFunc:          GeneralError,
+			expectedResolution: "None.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			ctx := context.Background()
+			if tt.requestID != "" {
+				ctx = context.WithValue(ctx, requestIDKey{}, tt.requestID)
+			}
+
+			c.Request, _ = http.NewRequestWithContext(ctx, http.MethodPost, "/test", http.NoBody)
+
+			tt.errorFunc(c)
+
+			var body RedfishError
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			require.Len(t, body.Error.ExtendedInfo, 1)
+			assert.Equal(t, tt.expectedArgs, body.Error.ExtendedInfo[0].MessageArgs)
+
+			if tt.expectedArgs == nil {
+				assert.NotContains(t, w.Body.String(), "MessageArgs")
+			}
+
+			if tt.expectedResolution != "" {
+				assert.Equal(t, tt.expectedResolution, body.Error.ExtendedInfo[0].Resolution)
 			}
 		})
 	}
 }
 
-// Helper functions for JWT token creation
+func TestMalformedJSONDetail(t *testing.T) {
+	t.Parallel()
+
+	decode := func(body string) error {
+		var target struct {
+			Boot struct {
+				BootSourceOverrideTarget string `json:"BootSourceOverrideTarget"`
+			} `json:"Boot"`
+		}
+
+		return json.NewDecoder(strings.NewReader(body)).Decode(&target)
+	}
 
-func createValidJWT(secretKey string) string {
-	claims := jwt.RegisteredClaims{
-		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
-		IssuedAt:  jwt.NewNumericDate(time.Now()),
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{name: "empty body", err: decode(""), expected: "The request body is empty."},
+		{name: "truncated body", err: decode(`{"Boot":`), expected: "The request body ends unexpectedly."},
+		{name: "syntax error", err: decode(`{"Boot" 1}`), expected: "Invalid JSON syntax at offset 9."},
+		{
+			name:     "wrong property type",
+			err:      decode(`{"Boot":{"BootSourceOverrideTarget":5}}`),
+			expected: "Unexpected number value for the property Boot.BootSourceOverrideTarget.",
+		},
+		{name: "wrong body type", err: decode(`[]`), expected: "Unexpected array value for the request body."},
+		{name: "read failure", err: errors.New("connection reset by peer"), expected: "The request body could not be read."},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, _ := token.SignedString([]byte(secretKey))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
 
-	return "Bearer " + tokenString
+			assert.Equal(t, tt.expected, malformedJSONDetail(tt.err))
+		})
+	}
 }
 
-func createExpiredJWT(secretKey string) string {
-	claims := jwt.RegisteredClaims{
-		ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Hour)), // Expired 1 hour ago
-		IssuedAt:  jwt.NewNumericDate(time.Now().Add(-2 * time.Hour)),
+func TestRedfishErrorWireFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		messageArgs []string
+		expected    string
+	}{
+		{
+			name: "without message args",
+			expected: `{"error":{"@Message.ExtendedInfo":[{"Message":"m","MessageId":"Base.1.11.0.X",` +
+				`"Resolution":"r","Severity":"Warning"}],"code":"Base.1.11.0.X","message":"m"}}`,
+		},
+		{
+			name:        "with message args",
+			messageArgs: []string{"a", "b"},
+			expected: `{"error":{"@Message.ExtendedInfo":[{"Message":"m","MessageArgs":["a","b"],"MessageId":"Base.1.11.0.X",` +
+				`"Resolution":"r","Severity":"Warning"}],"code":"Base.1.11.0.X","message":"m"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			body, err := json.Marshal(redfishError("Base.1.11.0.X", "m", "Warning", "r", tt.messageArgs))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, string(body), "keys keep the order of the former map encoding")
+		})
+	}
+}
+
+func TestRedfishErrorUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	PropertyValueNotInListError(c, "Sideways", "ResetType")
+
+	var body RedfishError
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, RedfishError{
+		Error: RedfishErrorBody{
+			ExtendedInfo: []ExtendedInfo{{
+				Message:     "The value 'Sideways' for the property ResetType is not in the list of acceptable values.",
+				MessageArgs: []string{"Sideways", "ResetType"},
+				MessageID:   BasePropertyValueNotInListID,
+				Resolution:  "Choose a value from the enumeration list that the implementation can support and resubmit the request if the operation failed.",
+				Severity:    "Warning",
+			}},
+			Code:    BasePropertyValueNotInListID,
+			Message: "The value 'Sideways' for the property ResetType is not in the list of acceptable values.",
+		},
+	}, body)
+}
+
+func TestResourceAlreadyExistsError(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	ResourceAlreadyExistsError(c, "ManagerAccount", "operator1")
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Equal(t, "4.0", w.Header().Get("OData-Version"))
+
+	var body struct {
+		Error struct {
+			Code         string `json:"code"`
+			ExtendedInfo []struct {
+				MessageID   string   `json:"MessageId"`
+				Message     string   `json:"Message"`
+				MessageArgs []string `json:"MessageArgs"`
+				Resolution  string   `json:"Resolution"`
+			} `json:"@Message.ExtendedInfo"`
+		} `json:"error"`
+	}
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, BaseResourceAlreadyExistsID, body.Error.Code)
+	require.Len(t, body.Error.ExtendedInfo, 1)
+
+	info := body.Error.ExtendedInfo[0]
+	assert.Equal(t, BaseResourceAlreadyExistsID, info.MessageID)
+	assert.Equal(t, []string{"ManagerAccount", "operator1"}, info.MessageArgs)
+	assert.Contains(t, info.Message, "operator1")
+	assert.NotEmpty(t, info.Resolution)
+}
+
+func TestNotImplementedError(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	NotImplementedError(c, "Session creation")
+
+	assert.Equal(t, http.StatusNotImplemented, w.Code)
+	assert.Equal(t, "4.0", w.Header().Get("OData-Version"))
+
+	var body struct {
+		Error struct {
+			Code         string `json:"code"`
+			ExtendedInfo []struct {
+				MessageID   string   `json:"MessageId"`
+				Message     string   `json:"Message"`
+				MessageArgs []string `json:"MessageArgs"`
+				Resolution  string   `json:"Resolution"`
+			} `json:"@Message.ExtendedInfo"`
+		} `json:"error"`
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, _ := token.SignedString([]byte(secretKey))
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, BaseActionNotSupportedID, body.Error.Code)
+	require.Len(t, body.Error.ExtendedInfo, 1)
 
-	return "Bearer " + tokenString
+	info := body.Error.ExtendedInfo[0]
+	assert.Equal(t, BaseActionNotSupportedID, info.MessageID)
+	assert.Equal(t, []string{"Session creation"}, info.MessageArgs)
+	assert.Equal(t, "The action Session creation is not supported by the resource.", info.Message)
+	assert.Contains(t, info.Resolution, "not implemented")
 }
@@ -0,0 +1,271 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements Redfish API v1 EthernetInterface resources.
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/netip"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/config"
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// EthernetInterface constants
+const (
+	wiredInterfaceID    = "Wired"
+	wirelessInterfaceID = "Wireless"
+	linkStatusUp        = "LinkUp"
+	linkStatusDown      = "LinkDown"
+	addressOriginDHCP   = "DHCP"
+	addressOriginStatic = "Static"
+	addressOriginDHCPv6 = "DHCPv6"
+	addressOriginLocal  = "LinkLocal"
+)
+
+// EthernetInterface represents a Redfish EthernetInterface for one of the AMT network interfaces.
+// AMT does not report link speed or duplex, so SpeedMbps and FullDuplex stay null.
+type EthernetInterface struct {
+	ODataID            string        `json:"@odata.id"`
+	ODataType          string        `json:"@odata.type"`
+	ID                 string        `json:"Id"`
+	Name               string        `json:"Name"`
+	MACAddress         string        `json:"MACAddress"`
+	LinkStatus         string        `json:"LinkStatus"`
+	SpeedMbps          *int          `json:"SpeedMbps"`
+	FullDuplex         *bool         `json:"FullDuplex"`
+	IPv4Addresses      []IPv4Address `json:"IPv4Addresses"`
+	IPv6Addresses      []IPv6Address `json:"IPv6Addresses"`
+	IPv6DefaultGateway string        `json:"IPv6DefaultGateway,omitempty"`
+	NameServers        []string      `json:"NameServers"`
+	VLAN               VLAN          `json:"VLAN"`
+}
+
+// IPv4Address is an entry of EthernetInterface.IPv4Addresses
+type IPv4Address struct {
+	Address       string `json:"Address"`
+	SubnetMask    string `json:"SubnetMask,omitempty"`
+	Gateway       string `json:"Gateway,omitempty"`
+	AddressOrigin string `json:"AddressOrigin"`
+}
+
+// IPv6Address is an entry of EthernetInterface.IPv6Addresses. AMT reports no prefix length.
+type IPv6Address struct {
+	Address       string `json:"Address"`
+	AddressOrigin string `json:"AddressOrigin"`
+}
+
+// VLAN is the VLAN configuration of an EthernetInterface. AMT reports tag 0 for an untagged
+// interface, which is shown as VLANEnable false without a VLANId.
+type VLAN struct {
+	VLANEnable bool `json:"VLANEnable"`
+	VLANID     int  `json:"VLANId,omitempty"`
+}
+
+// NewEthernetInterfaceRoutes registers Redfish EthernetInterface routes for Systems
+// It exposes:
+// - GET /redfish/v1/Systems/:id/EthernetInterfaces
+// - GET /redfish/v1/Systems/:id/EthernetInterfaces/:nicId
+func NewEthernetInterfaceRoutes(systems *gin.RouterGroup, d devices.Feature, cfg *config.Config, l logger.Interface) {
+	systems.GET(":id/EthernetInterfaces", getEthernetInterfacesHandler(d, cfg, l))
+	systems.GET(":id/EthernetInterfaces/:nicId", getEthernetInterfaceHandler(d, cfg, l))
+
+	l.Info("Registered Redfish EthernetInterface routes under %s", systems.BasePath())
+}
+
+func ethernetInterfacesPath(base, systemID string) string {
+	return systemPath(base, systemID) + "/EthernetInterfaces"
+}
+
+func getEthernetInterfacesHandler(d devices.Feature, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+
+	return func(c *gin.Context) {
+		systemID := c.Param("id")
+
+		if !validateSystemID(c, systemID) {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		nics, err := fetchEthernetInterfaces(ctx, d, linkBase(c), systemID)
+		if err != nil {
+			networkSettingsError(c, l, err, systemID)
+
+			return
+		}
+
+		members := make([]any, 0, len(nics))
+		for i := range nics {
+			members = append(members, map[string]any{"@odata.id": nics[i].ODataID})
+		}
+
+		SetRedfishHeaders(c)
+		c.JSON(http.StatusOK, map[string]any{
+			"@odata.type":         "#EthernetInterfaceCollection.EthernetInterfaceCollection",
+			"@odata.id":           ethernetInterfacesPath(linkBase(c), systemID),
+			"Name":                "Ethernet Interface Collection",
+			"Members@odata.count": len(members),
+			"Members":             members,
+		})
+	}
+}
+
+func getEthernetInterfaceHandler(d devices.Feature, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+
+	return func(c *gin.Context) {
+		systemID := c.Param("id")
+		nicID := c.Param("nicId")
+
+		if !validateSystemID(c, systemID) {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		nics, err := fetchEthernetInterfaces(ctx, d, linkBase(c), systemID)
+		if err != nil {
+			networkSettingsError(c, l, err, systemID)
+
+			return
+		}
+
+		for i := range nics {
+			if nics[i].ID == nicID {
+				SetRedfishHeaders(c)
+				c.JSON(http.StatusOK, nics[i])
+
+				return
+			}
+		}
+
+		ResourceNotFoundError(c, "EthernetInterface", nicID)
+	}
+}
+
+// networkSettingsError writes the Redfish error for a failed network settings read
+func networkSettingsError(c *gin.Context, l logger.Interface, err error, systemID string) {
+	var nfErr sqldb.NotFoundError
+	if errors.As(err, &nfErr) {
+		ResourceNotFoundError(c, "ComputerSystem", systemID)
+
+		return
+	}
+
+	l.Error(err, "http - redfish - EthernetInterfaces for %s [request %s]", systemID, requestID(c))
+	deviceCallError(c, err)
+}
+
+// fetchEthernetInterfaces reads the AMT network settings of a system and converts the wired and
+// wireless interfaces it reports to EthernetInterface resources linked under base
+func fetchEthernetInterfaces(ctx context.Context, d devices.Feature, base, systemID string) ([]EthernetInterface, error) {
+	settings, err := d.GetNetworkSettings(ctx, systemID)
+	if err != nil {
+		return nil, err
+	}
+
+	nics := make([]EthernetInterface, 0, 2)
+
+	if settings.Wired != nil {
+		nics = append(nics, buildEthernetInterface(base, systemID, wiredInterfaceID, "Wired Ethernet Interface", &settings.Wired.NetworkInfo))
+	}
+
+	if settings.Wireless != nil {
+		nics = append(nics, buildEthernetInterface(base, systemID, wirelessInterfaceID, "Wireless Interface", &settings.Wireless.NetworkInfo))
+	}
+
+	return nics, nil
+}
+
+func buildEthernetInterface(base, systemID, nicID, name string, info *dto.NetworkInfo) EthernetInterface {
+	nic := EthernetInterface{
+		ODataID:       ethernetInterfacesPath(base, systemID) + "/" + nicID,
+		ODataType:     "#EthernetInterface.v1_4_0.EthernetInterface",
+		ID:            nicID,
+		Name:          name,
+		MACAddress:    info.MACAddress,
+		LinkStatus:    linkStatusDown,
+		IPv4Addresses: []IPv4Address{},
+		IPv6Addresses: []IPv6Address{},
+		NameServers:   []string{},
+	}
+
+	if info.LinkIsUp {
+		nic.LinkStatus = linkStatusUp
+	}
+
+	if info.VLANTag > 0 {
+		nic.VLAN = VLAN{VLANEnable: true, VLANID: info.VLANTag}
+	}
+
+	// The address and gateway are sorted by family, so that an interface reporting IPv6 data is
+	// not described as IPv4 only
+	gateway := info.DefaultGateway
+	if isIPv6(gateway) {
+		nic.IPv6DefaultGateway = gateway
+		gateway = ""
+	}
+
+	switch {
+	case info.IPAddress == "":
+	case isIPv6(info.IPAddress):
+		nic.IPv6Addresses = append(nic.IPv6Addresses, IPv6Address{
+			Address:       info.IPAddress,
+			AddressOrigin: ipv6AddressOrigin(info.IPAddress, info.DHCPEnabled),
+		})
+	default:
+		origin := addressOriginStatic
+		if info.DHCPEnabled {
+			origin = addressOriginDHCP
+		}
+
+		nic.IPv4Addresses = append(nic.IPv4Addresses, IPv4Address{
+			Address:       info.IPAddress,
+			SubnetMask:    info.SubnetMask,
+			Gateway:       gateway,
+			AddressOrigin: origin,
+		})
+	}
+
+	for _, server := range []string{info.PrimaryDNS, info.SecondaryDNS} {
+		if server != "" {
+			nic.NameServers = append(nic.NameServers, server)
+		}
+	}
+
+	return nic
+}
+
+// isIPv6 reports whether address is an IPv6 address. Other values, including ones that do not
+// parse, are treated as IPv4 as AMT reports them.
+func isIPv6(address string) bool {
+	addr, err := netip.ParseAddr(address)
+
+	return err == nil && !addr.Unmap().Is4()
+}
+
+// ipv6AddressOrigin maps an IPv6 address to its Redfish AddressOrigin
+func ipv6AddressOrigin(address string, dhcp bool) string {
+	switch {
+	case netip.MustParseAddr(address).IsLinkLocalUnicast():
+		return addressOriginLocal
+	case dhcp:
+		return addressOriginDHCPv6
+	default:
+		return addressOriginStatic
+	}
+}
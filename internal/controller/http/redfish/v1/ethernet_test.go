@@ -0,0 +1,278 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+func wiredNetworkInfo() *dto.WiredNetworkInfo {
+	return &dto.WiredNetworkInfo{NetworkInfo: dto.NetworkInfo{
+		MACAddress:     "a4-ae-11-1c-02-4d",
+		VLANTag:        42,
+		LinkIsUp:       true,
+		DHCPEnabled:    true,
+		IPAddress:      "192.168.1.20",
+		SubnetMask:     "255.255.255.0",
+		DefaultGateway: "192.168.1.1",
+		PrimaryDNS:     "192.168.1.1",
+	}}
+}
+
+func wirelessNetworkInfo() *dto.WirelessNetworkInfo {
+	return &dto.WirelessNetworkInfo{NetworkInfo: dto.NetworkInfo{
+		MACAddress: "a4-ae-11-1c-02-4e",
+	}}
+}
+
+func TestEthernetInterfacesCollection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		settings       dto.NetworkSettings
+		settingsErr    error
+		expectedStatus int
+		expectedIDs    []string
+		expectedBody   string
+	}{
+		{
+			name:           "dual NIC",
+			settings:       dto.NetworkSettings{Wired: wiredNetworkInfo(), Wireless: wirelessNetworkInfo()},
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{wiredInterfaceID, wirelessInterfaceID},
+		},
+		{
+			name:           "single NIC",
+			settings:       dto.NetworkSettings{Wired: wiredNetworkInfo()},
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{wiredInterfaceID},
+		},
+		{
+			name:           "no network data",
+			settings:       dto.NetworkSettings{},
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{},
+		},
+		{
+			name:           "unknown system",
+			settingsErr:    devices.ErrNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   BaseResourceNotFoundID,
+		},
+		{
+			name:           "device failure",
+			settingsErr:    fmt.Errorf("dial tcp 10.0.0.5:16993: connection refused"),
+			expectedStatus: http.StatusBadGateway,
+			expectedBody:   BaseErrorMessageID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().GetNetworkSettings(gomock.Any(), testSystemID).Return(tt.settings, tt.settingsErr)
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/redfish/v1/Systems/:id/EthernetInterfaces", getEthernetInterfacesHandler(mockFeature, nil, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/EthernetInterfaces", http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedBody)
+
+				return
+			}
+
+			var body struct {
+				Count   int `json:"Members@odata.count"`
+				Members []struct {
+					ODataID string `json:"@odata.id"`
+				} `json:"Members"`
+			}
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, len(tt.expectedIDs), body.Count)
+			require.Len(t, body.Members, len(tt.expectedIDs))
+
+			for i, id := range tt.expectedIDs {
+				assert.Equal(t, "/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/EthernetInterfaces/"+id, body.Members[i].ODataID)
+			}
+		})
+	}
+}
+
+func TestEthernetInterfaceInstance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		nicID          string
+		expectedStatus int
+		expectedBody   []string
+	}{
+		{
+			name:           "wired interface",
+			nicID:          wiredInterfaceID,
+			expectedStatus: http.StatusOK,
+			expectedBody: []string{
+				`"#EthernetInterface.v1_4_0.EthernetInterface"`,
+				`"MACAddress":"a4-ae-11-1c-02-4d"`,
+				`"LinkStatus":"LinkUp"`,
+				`"SpeedMbps":null`,
+				`"FullDuplex":null`,
+				`"IPv4Addresses":[{"Address":"192.168.1.20","SubnetMask":"255.255.255.0","Gateway":"192.168.1.1","AddressOrigin":"DHCP"}]`,
+				`"NameServers":["192.168.1.1"]`,
+				`"VLAN":{"VLANEnable":true,"VLANId":42}`,
+			},
+		},
+		{
+			name:           "wireless interface without address",
+			nicID:          wirelessInterfaceID,
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{`"LinkStatus":"LinkDown"`, `"IPv4Addresses":[]`, `"IPv6Addresses":[]`, `"NameServers":[]`, `"VLAN":{"VLANEnable":false}`},
+		},
+		{
+			name:           "unknown interface",
+			nicID:          "Bluetooth",
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   []string{BaseResourceNotFoundID, "EthernetInterface"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().GetNetworkSettings(gomock.Any(), testSystemID).
+				Return(dto.NetworkSettings{Wired: wiredNetworkInfo(), Wireless: wirelessNetworkInfo()}, nil)
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).Times(1)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			NewEthernetInterfaceRoutes(router.Group("/redfish/v1/Systems"), mockFeature, nil, mockLogger)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet,
+				"/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/EthernetInterfaces/"+tt.nicID, http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			for _, expected := range tt.expectedBody {
+				assert.Contains(t, w.Body.String(), expected)
+			}
+		})
+	}
+}
+
+func TestEthernetInterfaceAddressFamilies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		info            dto.NetworkInfo
+		expectedIPv4    []IPv4Address
+		expectedIPv6    []IPv6Address
+		expectedGateway string
+		expectedServers []string
+	}{
+		{
+			name: "IPv4 only",
+			info: dto.NetworkInfo{
+				DHCPEnabled: true, IPAddress: "192.168.1.20", SubnetMask: "255.255.255.0", DefaultGateway: "192.168.1.1",
+				PrimaryDNS: "192.168.1.1",
+			},
+			expectedIPv4:    []IPv4Address{{Address: "192.168.1.20", SubnetMask: "255.255.255.0", Gateway: "192.168.1.1", AddressOrigin: addressOriginDHCP}},
+			expectedIPv6:    []IPv6Address{},
+			expectedServers: []string{"192.168.1.1"},
+		},
+		{
+			name: "IPv6 only",
+			info: dto.NetworkInfo{
+				DHCPEnabled: true, IPAddress: "2001:db8::20", DefaultGateway: "2001:db8::1",
+				PrimaryDNS: "2001:db8::53",
+			},
+			expectedIPv4:    []IPv4Address{},
+			expectedIPv6:    []IPv6Address{{Address: "2001:db8::20", AddressOrigin: addressOriginDHCPv6}},
+			expectedGateway: "2001:db8::1",
+			expectedServers: []string{"2001:db8::53"},
+		},
+		{
+			name:            "IPv6 link-local",
+			info:            dto.NetworkInfo{IPAddress: "fe80::a6ae:11ff:fe1c:24d"},
+			expectedIPv4:    []IPv4Address{},
+			expectedIPv6:    []IPv6Address{{Address: "fe80::a6ae:11ff:fe1c:24d", AddressOrigin: addressOriginLocal}},
+			expectedServers: []string{},
+		},
+		{
+			name: "dual stack",
+			info: dto.NetworkInfo{
+				IPAddress: "10.0.0.5", SubnetMask: "255.0.0.0", DefaultGateway: "2001:db8::1",
+				PrimaryDNS: "10.0.0.1", SecondaryDNS: "2001:db8::53",
+			},
+			expectedIPv4:    []IPv4Address{{Address: "10.0.0.5", SubnetMask: "255.0.0.0", AddressOrigin: addressOriginStatic}},
+			expectedIPv6:    []IPv6Address{},
+			expectedGateway: "2001:db8::1",
+			expectedServers: []string{"10.0.0.1", "2001:db8::53"},
+		},
+		{
+			name:            "IPv4-mapped IPv6 address",
+			info:            dto.NetworkInfo{IPAddress: "::ffff:10.0.0.5"},
+			expectedIPv4:    []IPv4Address{{Address: "::ffff:10.0.0.5", AddressOrigin: addressOriginStatic}},
+			expectedIPv6:    []IPv6Address{},
+			expectedServers: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			nic := buildEthernetInterface(DefaultRedfishBasePath, testSystemID, wiredInterfaceID, "Wired Ethernet Interface", &tt.info)
+
+			assert.Equal(t, tt.expectedIPv4, nic.IPv4Addresses)
+			assert.Equal(t, tt.expectedIPv6, nic.IPv6Addresses)
+			assert.Equal(t, tt.expectedGateway, nic.IPv6DefaultGateway)
+			assert.Equal(t, tt.expectedServers, nic.NameServers)
+		})
+	}
+}
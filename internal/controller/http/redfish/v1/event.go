@@ -0,0 +1,562 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements Redfish API v1 EventService resources.
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// EventService constants
+const (
+	eventServicePath    = "/redfish/v1/EventService"
+	subscriptionsPath   = eventServicePath + "/Subscriptions"
+	eventProtocol       = "Redfish"
+	propertyDestination = "Destination"
+	propertyEventTypes  = "EventTypes"
+	propertyProtocol    = "Protocol"
+	propertyEventType   = "EventType"
+	propertyMessage     = "Message"
+	propertySeverity    = "Severity"
+
+	// eventTypeAlert is the EventType of the events published by this service
+	eventTypeAlert = "Alert"
+	// testEventMessageID is the MessageId of a submitted test event that does not carry one
+	testEventMessageID = "Base.1.11.0.Success"
+	// eventDeliveryTimeout bounds each POST to a subscriber destination
+	eventDeliveryTimeout = 10 * time.Second
+)
+
+// supportedEventTypes are the event types a subscription may ask for
+var supportedEventTypes = []string{"StatusChange", "ResourceUpdated", "ResourceAdded", "ResourceRemoved", "Alert"}
+
+// eventSeverities are the Severity values an event may carry
+var eventSeverities = []string{"OK", "Warning", "Critical"}
+
+// EventDestination represents a Redfish event subscription
+type EventDestination struct {
+	ODataID     string   `json:"@odata.id"`
+	ODataType   string   `json:"@odata.type"`
+	ID          string   `json:"Id"`
+	Name        string   `json:"Name"`
+	Destination string   `json:"Destination"`
+	EventTypes  []string `json:"EventTypes"`
+	Protocol    string   `json:"Protocol"`
+	Context     string   `json:"Context,omitempty"`
+}
+
+// linkedUnder returns the subscription with its @odata.id under base
+func (e EventDestination) linkedUnder(base string) EventDestination {
+	e.ODataID = rebaseLink(base, e.ODataID)
+
+	return e
+}
+
+// createSubscriptionRequest is the body of a POST to the Subscriptions collection
+type createSubscriptionRequest struct {
+	Destination string   `json:"Destination"`
+	EventTypes  []string `json:"EventTypes"`
+	Protocol    string   `json:"Protocol"`
+	Context     string   `json:"Context"`
+}
+
+// submitTestEventRequest is the body of the EventService.SubmitTestEvent action
+type submitTestEventRequest struct {
+	EventType string `json:"EventType"`
+	Message   string `json:"Message"`
+	Severity  string `json:"Severity"`
+	MessageID string `json:"MessageId"`
+}
+
+// SubscriptionStore keeps the event subscriptions in memory
+type SubscriptionStore struct {
+	mu            sync.RWMutex
+	subscriptions map[string]EventDestination
+	order         []string
+}
+
+// DefaultSubscriptionStore is the subscription store shared by the Redfish route registrars
+var DefaultSubscriptionStore = NewSubscriptionStore()
+
+// NewSubscriptionStore creates an empty subscription store
+func NewSubscriptionStore() *SubscriptionStore {
+	return &SubscriptionStore{subscriptions: map[string]EventDestination{}}
+}
+
+// Create stores a new subscription and returns it
+func (s *SubscriptionStore) Create(destination string, eventTypes []string, subscriptionContext string) EventDestination {
+	id := uuid.NewString()
+	subscription := EventDestination{
+		ODataID:     subscriptionsPath + "/" + id,
+		ODataType:   "#EventDestination.v1_7_0.EventDestination",
+		ID:          id,
+		Name:        "Event Subscription",
+		Destination: destination,
+		EventTypes:  append([]string{}, eventTypes...),
+		Protocol:    eventProtocol,
+		Context:     subscriptionContext,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.subscriptions[id] = subscription
+	s.order = append(s.order, id)
+
+	return subscription
+}
+
+// List returns the subscriptions in creation order
+func (s *SubscriptionStore) List() []EventDestination {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	subscriptions := make([]EventDestination, 0, len(s.order))
+	for _, id := range s.order {
+		subscriptions = append(subscriptions, s.subscriptions[id])
+	}
+
+	return subscriptions
+}
+
+// Get returns the subscription with the given id
+func (s *SubscriptionStore) Get(id string) (EventDestination, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	subscription, ok := s.subscriptions[id]
+
+	return subscription, ok
+}
+
+// Delete removes a subscription and reports whether it existed
+func (s *SubscriptionStore) Delete(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscriptions[id]; !ok {
+		return false
+	}
+
+	delete(s.subscriptions, id)
+	s.order = slices.DeleteFunc(s.order, func(candidate string) bool { return candidate == id })
+
+	return true
+}
+
+// NewEventServiceRoutes registers the Redfish EventService routes.
+// Creating and deleting subscriptions and submitting test events requires the Operator role.
+// Destinations on loopback, link-local and private addresses are refused unless they lie in
+// cfg.Redfish.EventDestinationNetworks.
+// It exposes:
+// - GET /redfish/v1/EventService
+// - POST /redfish/v1/EventService/Actions/EventService.SubmitTestEvent
+// - GET /redfish/v1/EventService/Subscriptions
+// - POST /redfish/v1/EventService/Subscriptions
+// - GET /redfish/v1/EventService/Subscriptions/:subscriptionId
+// - DELETE /redfish/v1/EventService/Subscriptions/:subscriptionId
+func NewEventServiceRoutes(r *gin.RouterGroup, store *SubscriptionStore, cfg *config.Config, l logger.Interface) {
+	r.GET("/EventService", eventServiceHandler)
+	r.POST("/EventService/Actions/EventService.SubmitTestEvent", RequireRole(roleOperator), submitTestEventHandler(NewEventPublisher(store, cfg, l)))
+	r.GET("/EventService/Subscriptions", subscriptionsCollectionHandler(store))
+	r.POST("/EventService/Subscriptions", RequireRole(roleOperator), createSubscriptionHandler(store, newDestinationPolicy(cfg)))
+	r.GET("/EventService/Subscriptions/:subscriptionId", subscriptionHandler(store))
+	r.DELETE("/EventService/Subscriptions/:subscriptionId", RequireRole(roleOperator), deleteSubscriptionHandler(store))
+
+	l.Info("Registered Redfish EventService routes under %s", r.BasePath()+"/EventService")
+}
+
+func eventServiceHandler(c *gin.Context) {
+	base := linkBase(c)
+
+	SetRedfishHeaders(c)
+	c.JSON(http.StatusOK, map[string]any{
+		"@odata.type":                  "#EventService.v1_5_0.EventService",
+		"@odata.id":                    rebaseLink(base, eventServicePath),
+		"Id":                           "EventService",
+		"Name":                         "Event Service",
+		"ServiceEnabled":               true,
+		"EventTypesForSubscription":    supportedEventTypes,
+		"Subscriptions":                map[string]any{"@odata.id": rebaseLink(base, subscriptionsPath)},
+		"DeliveryRetryAttempts":        0,
+		"DeliveryRetryIntervalSeconds": 0,
+		"Actions": map[string]any{
+			"#EventService.SubmitTestEvent": map[string]any{
+				"target": rebaseLink(base, eventServicePath) + "/Actions/EventService.SubmitTestEvent",
+			},
+		},
+	})
+}
+
+func subscriptionsCollectionHandler(store *SubscriptionStore) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		subscriptions := store.List()
+		base := linkBase(c)
+		members := make([]any, 0, len(subscriptions))
+
+		for i := range subscriptions {
+			members = append(members, map[string]any{"@odata.id": rebaseLink(base, subscriptions[i].ODataID)})
+		}
+
+		SetRedfishHeaders(c)
+		c.JSON(http.StatusOK, map[string]any{
+			"@odata.type":         "#EventDestinationCollection.EventDestinationCollection",
+			"@odata.id":           rebaseLink(base, subscriptionsPath),
+			"Name":                "Event Subscriptions Collection",
+			"Members@odata.count": len(members),
+			"Members":             members,
+		})
+	}
+}
+
+func subscriptionHandler(store *SubscriptionStore) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.Param("subscriptionId")
+
+		subscription, ok := store.Get(id)
+		if !ok {
+			ResourceNotFoundError(c, "EventDestination", id)
+
+			return
+		}
+
+		SetRedfishHeaders(c)
+		c.JSON(http.StatusOK, subscription.linkedUnder(linkBase(c)))
+	}
+}
+
+// createSubscriptionHandler validates and stores a new subscription whose destination policy permits
+func createSubscriptionHandler(store *SubscriptionStore, policy destinationPolicy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body createSubscriptionRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			MalformedJSONError(c, err)
+
+			return
+		}
+
+		if body.Destination == "" {
+			PropertyMissingError(c, propertyDestination)
+
+			return
+		}
+
+		if !policy.validDestination(body.Destination) {
+			PropertyValueFormatError(c, body.Destination, propertyDestination)
+
+			return
+		}
+
+		if body.Protocol != "" && body.Protocol != eventProtocol {
+			PropertyValueNotInListError(c, body.Protocol, propertyProtocol)
+
+			return
+		}
+
+		for _, eventType := range body.EventTypes {
+			if !slices.Contains(supportedEventTypes, eventType) {
+				PropertyValueNotInListError(c, eventType, propertyEventTypes)
+
+				return
+			}
+		}
+
+		subscription := store.Create(body.Destination, body.EventTypes, body.Context).linkedUnder(linkBase(c))
+
+		SetRedfishHeaders(c)
+		c.Header("Location", subscription.ODataID)
+		c.JSON(http.StatusCreated, subscription)
+	}
+}
+
+// submitTestEventHandler validates a test event and sends it to every current subscriber
+func submitTestEventHandler(events *EventPublisher) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body submitTestEventRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			MalformedJSONError(c, err)
+
+			return
+		}
+
+		for _, required := range []struct{ name, value string }{
+			{propertyEventType, body.EventType},
+			{propertyMessage, body.Message},
+			{propertySeverity, body.Severity},
+		} {
+			if required.value == "" {
+				PropertyMissingError(c, required.name)
+
+				return
+			}
+		}
+
+		if !slices.Contains(supportedEventTypes, body.EventType) {
+			PropertyValueNotInListError(c, body.EventType, propertyEventType)
+
+			return
+		}
+
+		if !slices.Contains(eventSeverities, body.Severity) {
+			PropertyValueNotInListError(c, body.Severity, propertySeverity)
+
+			return
+		}
+
+		messageID := body.MessageID
+		if messageID == "" {
+			messageID = testEventMessageID
+		}
+
+		events.SubmitTestEvent(linkBase(c), body.EventType, body.Severity, body.Message, messageID)
+
+		SetRedfishHeaders(c)
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func deleteSubscriptionHandler(store *SubscriptionStore) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.Param("subscriptionId")
+
+		if !store.Delete(id) {
+			ResourceNotFoundError(c, "EventDestination", id)
+
+			return
+		}
+
+		SetRedfishHeaders(c)
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// errDestinationNotPermitted is returned when an event delivery would connect to an internal address
+var errDestinationNotPermitted = errors.New("event destination address is not permitted")
+
+// destinationPolicy decides which addresses events may be sent to, so that subscriptions cannot
+// make the service POST to itself or to other internal services. Public addresses are always
+// permitted; loopback, link-local, private and other non-global addresses only inside networks.
+type destinationPolicy struct {
+	networks []netip.Prefix
+}
+
+// newDestinationPolicy reads the permitted internal networks from cfg. Entries are CIDR prefixes
+// or single addresses; entries that are neither are ignored.
+func newDestinationPolicy(cfg *config.Config) destinationPolicy {
+	var policy destinationPolicy
+
+	if cfg == nil {
+		return policy
+	}
+
+	for _, entry := range cfg.Redfish.EventDestinationNetworks {
+		if prefix, err := netip.ParsePrefix(entry); err == nil {
+			policy.networks = append(policy.networks, prefix.Masked())
+
+			continue
+		}
+
+		if addr, err := netip.ParseAddr(entry); err == nil {
+			policy.networks = append(policy.networks, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+		}
+	}
+
+	return policy
+}
+
+// permits reports whether events may be sent to addr
+func (p destinationPolicy) permits(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	if addr.IsGlobalUnicast() && !addr.IsPrivate() {
+		return true
+	}
+
+	return slices.ContainsFunc(p.networks, func(prefix netip.Prefix) bool { return prefix.Contains(addr) })
+}
+
+// validDestination accepts absolute http and https URLs with a host. A host given as an address,
+// or as localhost, must be permitted; host names are checked when the event is delivered.
+func (p destinationPolicy) validDestination(destination string) bool {
+	u, err := url.Parse(destination)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return false
+	}
+
+	host := u.Hostname()
+	if strings.EqualFold(host, "localhost") {
+		host = "127.0.0.1"
+	}
+
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return p.permits(addr)
+	}
+
+	return host != ""
+}
+
+// control refuses connections to addresses the policy does not permit. It runs after name
+// resolution, for every connection including redirects.
+func (p destinationPolicy) control(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+
+	if !p.permits(addrPort.Addr()) {
+		return errDestinationNotPermitted
+	}
+
+	return nil
+}
+
+// Event is the Redfish Event envelope POSTed to subscribers
+type Event struct {
+	ODataType string        `json:"@odata.type"`
+	ID        string        `json:"Id"`
+	Name      string        `json:"Name"`
+	Context   string        `json:"Context,omitempty"`
+	Events    []EventRecord `json:"Events"`
+}
+
+// EventRecord is a single entry of an Event
+type EventRecord struct {
+	EventType         string            `json:"EventType"`
+	EventID           string            `json:"EventId"`
+	EventTimestamp    string            `json:"EventTimestamp"`
+	Severity          string            `json:"Severity"`
+	Message           string            `json:"Message"`
+	MessageID         string            `json:"MessageId"`
+	MessageArgs       []string          `json:"MessageArgs"`
+	OriginOfCondition map[string]string `json:"OriginOfCondition"`
+}
+
+// EventPublisher delivers events to the subscribers of a SubscriptionStore.
+// Deliveries run in the background; failures are logged and never reported to the caller.
+type EventPublisher struct {
+	store  *SubscriptionStore
+	client *http.Client
+	l      logger.Interface
+	now    func() time.Time
+}
+
+// NewEventPublisher creates a publisher for the subscriptions in store. Deliveries only connect to
+// the addresses the destination policy of cfg permits.
+func NewEventPublisher(store *SubscriptionStore, cfg *config.Config, l logger.Interface) *EventPublisher {
+	dialer := &net.Dialer{Timeout: eventDeliveryTimeout, Control: newDestinationPolicy(cfg).control}
+	client := &http.Client{
+		Timeout:   eventDeliveryTimeout,
+		Transport: &http.Transport{DialContext: dialer.DialContext},
+	}
+
+	return &EventPublisher{
+		store:  store,
+		client: client,
+		l:      l,
+		now:    time.Now,
+	}
+}
+
+// PublishPowerStateChange notifies the subscribers that the power state of a system changed,
+// linking the system under base. ResourceChanged takes no arguments in the ResourceEvent registry,
+// so the system and its power state are only named by the Message and OriginOfCondition.
+func (p *EventPublisher) PublishPowerStateChange(base, systemID, powerState string) {
+	p.publish("Power State Changed", EventRecord{
+		EventType:         eventTypeAlert,
+		Severity:          "OK",
+		Message:           "The power state of system " + systemID + " changed to " + powerState + ".",
+		MessageID:         "ResourceEvent.1.3.0.ResourceChanged",
+		MessageArgs:       []string{},
+		OriginOfCondition: map[string]string{"@odata.id": systemPath(base, systemID)},
+	}, true)
+}
+
+// SubmitTestEvent sends a client supplied event to every subscriber regardless of its EventTypes,
+// linking the EventService under base
+func (p *EventPublisher) SubmitTestEvent(base, eventType, severity, message, messageID string) {
+	p.publish("Test Event", EventRecord{
+		EventType:         eventType,
+		Severity:          severity,
+		Message:           message,
+		MessageID:         messageID,
+		MessageArgs:       []string{},
+		OriginOfCondition: map[string]string{"@odata.id": rebaseLink(base, eventServicePath)},
+	}, false)
+}
+
+// publish stamps the record and delivers it to the subscribers. With matchEventTypes set a
+// subscription that lists EventTypes only receives records of one of those types.
+func (p *EventPublisher) publish(name string, record EventRecord, matchEventTypes bool) {
+	record.EventID = uuid.NewString()
+	record.EventTimestamp = p.now().UTC().Format(time.RFC3339)
+
+	for _, subscription := range p.store.List() {
+		if matchEventTypes && len(subscription.EventTypes) > 0 && !slices.Contains(subscription.EventTypes, record.EventType) {
+			continue
+		}
+
+		go p.deliver(subscription, Event{
+			ODataType: "#Event.v1_7_0.Event",
+			ID:        record.EventID,
+			Name:      name,
+			Context:   subscription.Context,
+			Events:    []EventRecord{record},
+		})
+	}
+}
+
+// deliver POSTs an event to a subscriber. It does not use the request context so the
+// delivery outlives the request that triggered it.
+func (p *EventPublisher) deliver(subscription EventDestination, event Event) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		p.l.Warn("redfish - event %s not delivered to subscription %s: %v", event.ID, subscription.ID, err)
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), eventDeliveryTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Destination, bytes.NewReader(payload))
+	if err != nil {
+		p.l.Warn("redfish - event %s not delivered to subscription %s: %v", event.ID, subscription.ID, err)
+
+		return
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		p.l.Warn("redfish - event %s not delivered to subscription %s: %v", event.ID, subscription.ID, err)
+
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		p.l.Warn("redfish - event %s rejected by subscription %s with status %d", event.ID, subscription.ID, resp.StatusCode)
+	}
+}
@@ -0,0 +1,616 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/mocks"
+)
+
+func newEventServiceTestRouter(t *testing.T, cfg *config.Config, store *SubscriptionStore) *gin.Engine {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	redfish := router.Group("/redfish/v1")
+	if cfg != nil {
+		redfish.Use(RedfishJWTAuthMiddleware(cfg, nil))
+	}
+
+	NewEventServiceRoutes(redfish, store, cfg, mockLogger)
+
+	return router
+}
+
+// loopbackEventConfig permits event deliveries to the loopback httptest subscribers
+func loopbackEventConfig() *config.Config {
+	cfg := &config.Config{}
+	cfg.Redfish.EventDestinationNetworks = []string{"127.0.0.0/8"}
+
+	return cfg
+}
+
+func serveEventRequest(router *gin.Engine, method, path, body, authHeader string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequestWithContext(context.Background(), method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestEventServiceHandler(t *testing.T) {
+	t.Parallel()
+
+	router := newEventServiceTestRouter(t, nil, NewSubscriptionStore())
+
+	w := serveEventRequest(router, http.MethodGet, eventServicePath, "", "")
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "4.0", w.Header().Get("OData-Version"))
+
+	var body map[string]any
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "#EventService.v1_5_0.EventService", body["@odata.type"])
+	assert.Equal(t, true, body["ServiceEnabled"])
+	assert.Equal(t, map[string]any{"@odata.id": subscriptionsPath}, body["Subscriptions"])
+	assert.Len(t, body["EventTypesForSubscription"], len(supportedEventTypes))
+	assert.Contains(t, w.Body.String(), `"target":"/redfish/v1/EventService/Actions/EventService.SubmitTestEvent"`)
+}
+
+func TestSubscriptionLifecycle(t *testing.T) {
+	t.Parallel()
+
+	store := NewSubscriptionStore()
+	router := newEventServiceTestRouter(t, nil, store)
+
+	w := serveEventRequest(router, http.MethodPost, subscriptionsPath,
+		`{"Destination":"https://listener.example.com/events","EventTypes":["Alert"],"Protocol":"Redfish","Context":"ops"}`, "")
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var created EventDestination
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	assert.Equal(t, created.ODataID, w.Header().Get("Location"))
+	assert.Equal(t, subscriptionsPath+"/"+created.ID, created.ODataID)
+	assert.Equal(t, "https://listener.example.com/events", created.Destination)
+	assert.Equal(t, []string{"Alert"}, created.EventTypes)
+	assert.Equal(t, "ops", created.Context)
+
+	w = serveEventRequest(router, http.MethodGet, subscriptionsPath, "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"Members@odata.count":1`)
+	assert.Contains(t, w.Body.String(), created.ODataID)
+
+	w = serveEventRequest(router, http.MethodGet, created.ODataID, "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"Destination":"https://listener.example.com/events"`)
+
+	w = serveEventRequest(router, http.MethodDelete, created.ODataID, "", "")
+	require.Equal(t, http.StatusNoContent, w.Code)
+	assert.Empty(t, store.List())
+
+	w = serveEventRequest(router, http.MethodGet, created.ODataID, "", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), BaseResourceNotFoundID)
+
+	w = serveEventRequest(router, http.MethodDelete, created.ODataID, "", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestCreateSubscriptionHandler(t *testing.T) {
+	t.Parallel()
+
+	const jwtKey = "test-secret-key"
+
+	tests := []struct {
+		name           string
+		authHeader     string
+		requestBody    string
+		expectedStatus int
+		expectedMsgID  string
+	}{
+		{
+			name:           "operator creates subscription",
+			authHeader:     createRoleJWT(jwtKey, roleOperator),
+			requestBody:    `{"Destination":"http://listener.example.com/events"}`,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "read-only lacks privilege",
+			authHeader:     createRoleJWT(jwtKey, roleReadOnly),
+			requestBody:    `{"Destination":"http://listener.example.com/events"}`,
+			expectedStatus: http.StatusForbidden,
+			expectedMsgID:  BaseInsufficientPrivilegeID,
+		},
+		{
+			name:           "malformed JSON",
+			authHeader:     createRoleJWT(jwtKey, roleOperator),
+			requestBody:    `{"Destination":`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BaseMalformedJSONID,
+		},
+		{
+			name:           "missing destination",
+			authHeader:     createRoleJWT(jwtKey, roleOperator),
+			requestBody:    `{"EventTypes":["Alert"]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyMissingID,
+		},
+		{
+			name:           "destination is not a URL",
+			authHeader:     createRoleJWT(jwtKey, roleOperator),
+			requestBody:    `{"Destination":"not a url"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyValueFormatErrorID,
+		},
+		{
+			name:           "destination scheme is not http",
+			authHeader:     createRoleJWT(jwtKey, roleOperator),
+			requestBody:    `{"Destination":"ftp://listener.example.com/events"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyValueFormatErrorID,
+		},
+		{
+			name:           "destination on an internal address",
+			authHeader:     createRoleJWT(jwtKey, roleOperator),
+			requestBody:    `{"Destination":"http://169.254.169.254/latest/meta-data"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyValueFormatErrorID,
+		},
+		{
+			name:           "unsupported protocol",
+			authHeader:     createRoleJWT(jwtKey, roleOperator),
+			requestBody:    `{"Destination":"https://listener.example.com/events","Protocol":"SNMPv2c"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyValueNotInListID,
+		},
+		{
+			name:           "unsupported event type",
+			authHeader:     createRoleJWT(jwtKey, roleOperator),
+			requestBody:    `{"Destination":"https://listener.example.com/events","EventTypes":["Alert","MetricReport"]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyValueNotInListID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &config.Config{}
+			cfg.Auth.AdminUsername = "admin"
+			cfg.Auth.JWTKey = jwtKey
+
+			store := NewSubscriptionStore()
+			router := newEventServiceTestRouter(t, cfg, store)
+
+			w := serveEventRequest(router, http.MethodPost, subscriptionsPath, tt.requestBody, tt.authHeader)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedMsgID != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedMsgID)
+				assert.Empty(t, store.List())
+
+				return
+			}
+
+			require.Len(t, store.List(), 1)
+			assert.Equal(t, eventProtocol, store.List()[0].Protocol)
+		})
+	}
+}
+
+func TestDeleteSubscriptionRequiresOperator(t *testing.T) {
+	t.Parallel()
+
+	const jwtKey = "test-secret-key"
+
+	cfg := &config.Config{}
+	cfg.Auth.AdminUsername = "admin"
+	cfg.Auth.JWTKey = jwtKey
+
+	store := NewSubscriptionStore()
+	subscription := store.Create("https://listener.example.com/events", nil, "")
+	router := newEventServiceTestRouter(t, cfg, store)
+
+	w := serveEventRequest(router, http.MethodDelete, subscription.ODataID, "", createRoleJWT(jwtKey, roleReadOnly))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Len(t, store.List(), 1)
+
+	w = serveEventRequest(router, http.MethodDelete, subscription.ODataID, "", createRoleJWT(jwtKey, roleOperator))
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Empty(t, store.List())
+}
+
+func TestSubscriptionStore(t *testing.T) {
+	t.Parallel()
+
+	store := NewSubscriptionStore()
+	first := store.Create("https://a.example.com", []string{"Alert"}, "first")
+	second := store.Create("https://b.example.com", nil, "")
+
+	assert.NotEqual(t, first.ID, second.ID)
+	assert.Equal(t, []EventDestination{first, second}, store.List())
+
+	got, ok := store.Get(second.ID)
+	require.True(t, ok)
+	assert.Equal(t, second, got)
+
+	assert.True(t, store.Delete(first.ID))
+	assert.False(t, store.Delete(first.ID))
+	assert.Equal(t, []EventDestination{second}, store.List())
+
+	_, ok = store.Get(first.ID)
+	assert.False(t, ok)
+}
+
+func TestValidDestination(t *testing.T) {
+	t.Parallel()
+
+	internal := &config.Config{}
+	internal.Redfish.EventDestinationNetworks = []string{"10.0.0.0/8", "fd00::1", "not a network"}
+
+	tests := []struct {
+		destination string
+		cfg         *config.Config
+		expected    bool
+	}{
+		{destination: "https://listener.example.com/events", expected: true},
+		{destination: "https://93.184.216.34/events", expected: true},
+		{destination: "http://10.0.0.5:8080", expected: false},
+		{destination: "http://10.0.0.5:8080", cfg: internal, expected: true},
+		{destination: "http://192.168.1.20/events", cfg: internal, expected: false},
+		{destination: "http://[fd00::1]/events", cfg: internal, expected: true},
+		{destination: "http://127.0.0.1:8181/api/v1/devices", expected: false},
+		{destination: "http://localhost:8181/events", expected: false},
+		{destination: "http://169.254.169.254/latest/meta-data", expected: false},
+		{destination: "http://[::1]/events", expected: false},
+		{destination: "http://[::ffff:127.0.0.1]/events", expected: false},
+		{destination: "http://0.0.0.0/events", expected: false},
+		{destination: "ftp://listener.example.com", expected: false},
+		{destination: "listener.example.com/events", expected: false},
+		{destination: "https://", expected: false},
+		{destination: "http://[::1", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.destination, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, newDestinationPolicy(tt.cfg).validDestination(tt.destination))
+		})
+	}
+}
+
+func TestEventDeliveryRefusesInternalAddresses(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	// The subscriber listens on loopback, which the default policy does not permit, as happens when
+	// a destination host name resolves to an internal address
+	server, received := eventSubscriber(t, http.StatusNoContent)
+
+	store := NewSubscriptionStore()
+	store.Create(server.URL, nil, "")
+
+	warned := make(chan string, 1)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).Do(func(_ string, args ...interface{}) {
+		warned <- fmt.Sprint(args...)
+	})
+
+	NewEventPublisher(store, nil, mockLogger).PublishPowerStateChange(DefaultRedfishBasePath, testSystemGUID, powerStateOn)
+
+	select {
+	case message := <-warned:
+		assert.Contains(t, message, errDestinationNotPermitted.Error())
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "refused delivery was not logged")
+	}
+
+	select {
+	case <-received:
+		assert.Fail(t, "event was delivered to an internal address")
+	default:
+	}
+}
+
+// eventSubscriber starts an httptest server that forwards every event it receives
+func eventSubscriber(t *testing.T, status int) (*httptest.Server, <-chan Event) {
+	t.Helper()
+
+	received := make(chan Event, 4)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event Event
+
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+
+		received <- event
+
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, received
+}
+
+func waitForEvent(t *testing.T, received <-chan Event) Event {
+	t.Helper()
+
+	select {
+	case event := <-received:
+		return event
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "subscriber did not receive an event")
+
+		return Event{}
+	}
+}
+
+func TestResetPublishesPowerStateEvent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		resetType     string
+		action        int
+		expectedState string
+	}{
+		{name: "power on", resetType: resetTypeOn, action: actionPowerUp, expectedState: powerStateOn},
+		{name: "force off", resetType: resetTypeForceOff, action: actionPowerDown, expectedState: powerStateOff},
+		{name: "power cycle", resetType: resetTypePowerCycle, action: actionPowerCycle, expectedState: powerStateOn},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			server, received := eventSubscriber(t, http.StatusNoContent)
+
+			store := NewSubscriptionStore()
+			store.Create(server.URL, []string{eventTypeAlert}, "ops")
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().SendPowerAction(gomock.Any(), testSystemGUID, tt.action).Return(power.PowerActionResponse{ReturnValue: 0}, nil)
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			publisher := NewEventPublisher(store, loopbackEventConfig(), mockLogger)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/redfish/v1/Systems/:id/Actions/ComputerSystem.Reset", postSystemResetHandler(mockFeature, publisher, NewTaskStore(), newDeviceLocks(), nil, mockLogger))
+
+			w := serveEventRequest(router, http.MethodPost, resetActionURL, `{"ResetType":"`+tt.resetType+`"}`, "")
+			require.Equal(t, http.StatusOK, w.Code)
+
+			event := waitForEvent(t, received)
+			assert.Equal(t, "#Event.v1_7_0.Event", event.ODataType)
+			assert.Equal(t, "ops", event.Context)
+			require.Len(t, event.Events, 1)
+
+			record := event.Events[0]
+			assert.Equal(t, eventTypeAlert, record.EventType)
+			assert.Equal(t, event.ID, record.EventID)
+			assert.Equal(t, "ResourceEvent.1.3.0.ResourceChanged", record.MessageID)
+			assert.Empty(t, record.MessageArgs)
+			assert.Equal(t, "The power state of system "+testSystemGUID+" changed to "+tt.expectedState+".", record.Message)
+			assert.Equal(t, map[string]string{"@odata.id": systemsInstanceURL}, record.OriginOfCondition)
+
+			_, err := time.Parse(time.RFC3339, record.EventTimestamp)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPublishPowerStateChangeSkipsUnmatchedSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	matching, matchingEvents := eventSubscriber(t, http.StatusOK)
+	filtered, filteredEvents := eventSubscriber(t, http.StatusOK)
+
+	store := NewSubscriptionStore()
+	store.Create(filtered.URL, []string{"StatusChange"}, "")
+	store.Create(matching.URL, nil, "all events")
+
+	publisher := NewEventPublisher(store, loopbackEventConfig(), mocks.NewMockLogger(ctrl))
+	publisher.PublishPowerStateChange(DefaultRedfishBasePath, testSystemGUID, powerStateOff)
+
+	event := waitForEvent(t, matchingEvents)
+	assert.Equal(t, "all events", event.Context)
+
+	select {
+	case <-filteredEvents:
+		assert.Fail(t, "subscription without the Alert event type received an event")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestPublishPowerStateChangeLogsDeliveryFailures(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	rejecting, _ := eventSubscriber(t, http.StatusInternalServerError)
+
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	store := NewSubscriptionStore()
+	store.Create(rejecting.URL, nil, "")
+	store.Create(unreachable.URL, nil, "")
+
+	warned := make(chan struct{}, 2)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).Times(2).Do(func(string, ...interface{}) {
+		warned <- struct{}{}
+	})
+
+	NewEventPublisher(store, loopbackEventConfig(), mockLogger).PublishPowerStateChange(DefaultRedfishBasePath, testSystemGUID, powerStateOn)
+
+	for range 2 {
+		select {
+		case <-warned:
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "delivery failure was not logged")
+		}
+	}
+}
+
+func TestSubmitTestEventHandler(t *testing.T) {
+	t.Parallel()
+
+	const (
+		jwtKey     = "test-secret-key"
+		submitPath = eventServicePath + "/Actions/EventService.SubmitTestEvent"
+	)
+
+	tests := []struct {
+		name           string
+		authHeader     string
+		requestBody    string
+		expectedStatus int
+		expectedMsgID  string
+		expectedArg    string
+	}{
+		{
+			name:           "test event reaches every subscriber",
+			authHeader:     createRoleJWT(jwtKey, roleOperator),
+			requestBody:    `{"EventType":"Alert","Message":"wiring check","Severity":"Warning"}`,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "read-only lacks privilege",
+			authHeader:     createRoleJWT(jwtKey, roleReadOnly),
+			requestBody:    `{"EventType":"Alert","Message":"wiring check","Severity":"Warning"}`,
+			expectedStatus: http.StatusForbidden,
+			expectedMsgID:  BaseInsufficientPrivilegeID,
+		},
+		{
+			name:           "malformed JSON",
+			authHeader:     createRoleJWT(jwtKey, roleOperator),
+			requestBody:    `{"EventType":`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BaseMalformedJSONID,
+		},
+		{
+			name:           "missing event type",
+			authHeader:     createRoleJWT(jwtKey, roleOperator),
+			requestBody:    `{"Message":"wiring check","Severity":"Warning"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyMissingID,
+			expectedArg:    propertyEventType,
+		},
+		{
+			name:           "missing message",
+			authHeader:     createRoleJWT(jwtKey, roleOperator),
+			requestBody:    `{"EventType":"Alert","Severity":"Warning"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyMissingID,
+			expectedArg:    propertyMessage,
+		},
+		{
+			name:           "missing severity",
+			authHeader:     createRoleJWT(jwtKey, roleOperator),
+			requestBody:    `{"EventType":"Alert","Message":"wiring check"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyMissingID,
+			expectedArg:    propertySeverity,
+		},
+		{
+			name:           "unsupported event type",
+			authHeader:     createRoleJWT(jwtKey, roleOperator),
+			requestBody:    `{"EventType":"MetricReport","Message":"wiring check","Severity":"Warning"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyValueNotInListID,
+		},
+		{
+			name:           "unsupported severity",
+			authHeader:     createRoleJWT(jwtKey, roleOperator),
+			requestBody:    `{"EventType":"Alert","Message":"wiring check","Severity":"Fatal"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyValueNotInListID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := loopbackEventConfig()
+			cfg.Auth.AdminUsername = "admin"
+			cfg.Auth.JWTKey = jwtKey
+
+			server, received := eventSubscriber(t, http.StatusNoContent)
+
+			store := NewSubscriptionStore()
+			store.Create(server.URL, []string{"StatusChange"}, "wiring")
+			router := newEventServiceTestRouter(t, cfg, store)
+
+			w := serveEventRequest(router, http.MethodPost, submitPath, tt.requestBody, tt.authHeader)
+
+			require.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedMsgID != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedMsgID)
+				assert.Contains(t, w.Body.String(), tt.expectedArg)
+
+				return
+			}
+
+			event := waitForEvent(t, received)
+			assert.Equal(t, "Test Event", event.Name)
+			assert.Equal(t, "wiring", event.Context)
+			require.Len(t, event.Events, 1)
+			assert.Equal(t, "Alert", event.Events[0].EventType)
+			assert.Equal(t, "wiring check", event.Events[0].Message)
+			assert.Equal(t, "Warning", event.Events[0].Severity)
+			assert.Equal(t, testEventMessageID, event.Events[0].MessageID)
+			assert.Equal(t, map[string]string{"@odata.id": eventServicePath}, event.Events[0].OriginOfCondition)
+		})
+	}
+}
@@ -2,16 +2,25 @@
 package v1
 
 import (
-	"crypto/sha256"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"path"
 	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/device-management-toolkit/console/config"
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
@@ -20,8 +29,11 @@ const (
 	// Common string constants
 	unknownValue       = "Unknown"
 	biosID             = "BIOS"
-	sleepDurationMs    = 100
 	systemManufacturer = "System Manufacturer"
+	// DefaultFirmwareCacheSeconds is the Cache-Control max-age used when none is configured
+	DefaultFirmwareCacheSeconds = 300
+	// maxFirmwareCacheSeconds caps the configured max-age at one day
+	maxFirmwareCacheSeconds = 86400
 )
 
 // FirmwareInventoryCollection represents a Redfish FirmwareInventory collection
@@ -58,6 +70,7 @@ type FirmwareInventory struct {
 	ReleaseDate   string                 `json:"ReleaseDate,omitempty"`
 	SoftwareID    string                 `json:"SoftwareId"`
 	Updateable    bool                   `json:"Updateable"`
+	RelatedItem   []map[string]string    `json:"RelatedItem,omitempty"`
 	Status        Status                 `json:"Status"`
 	Oem           map[string]interface{} `json:"Oem,omitempty"`
 }
@@ -70,12 +83,20 @@ type Status struct {
 
 // NewFirmwareRoutes registers Redfish FirmwareInventory routes for Systems
 // It exposes:
-// - GET /redfish/v1/Systems/:id/FirmwareInventory
-// - GET /redfish/v1/Systems/:id/FirmwareInventory/:firmwareId
-func NewFirmwareRoutes(systems *gin.RouterGroup, d devices.Feature, l logger.Interface) {
+// - GET, HEAD and OPTIONS /redfish/v1/Systems/:id/FirmwareInventory
+// - GET, HEAD and OPTIONS /redfish/v1/Systems/:id/FirmwareInventory/:firmwareId
+func NewFirmwareRoutes(systems *gin.RouterGroup, d devices.Feature, cfg *config.Config, l logger.Interface) {
+	opts := newFirmwareOptions(cfg)
+	collection := getFirmwareInventoryCollectionHandler(d, opts, l)
+	instance := getFirmwareInventoryInstanceHandler(d, opts, l)
+
 	// Add firmware inventory routes to existing Systems group
-	systems.GET(":id/FirmwareInventory", getFirmwareInventoryCollectionHandler(d, l))
-	systems.GET(":id/FirmwareInventory/:firmwareId", getFirmwareInventoryInstanceHandler(d, l))
+	systems.GET(":id/FirmwareInventory", collection)
+	systems.HEAD(":id/FirmwareInventory", headHandler(collection))
+	systems.GET(":id/FirmwareInventory/:firmwareId", instance)
+	systems.HEAD(":id/FirmwareInventory/:firmwareId", headHandler(instance))
+	systems.OPTIONS(":id/FirmwareInventory", optionsHandler("GET, HEAD"))
+	systems.OPTIONS(":id/FirmwareInventory/:firmwareId", optionsHandler("GET, HEAD"))
 
 	// Register method-not-allowed handlers for FirmwareInventory collection
 	systems.POST(":id/FirmwareInventory", func(c *gin.Context) {
@@ -108,13 +129,6 @@ func NewFirmwareRoutes(systems *gin.RouterGroup, d devices.Feature, l logger.Int
 	l.Info("Registered Redfish FirmwareInventory routes under %s", systems.BasePath())
 }
 
-// generateETag creates an ETag for caching based on content
-func generateETag(content string) string {
-	hash := sha256.Sum256([]byte(content))
-
-	return fmt.Sprintf(`W/"%x"`, hash)
-}
-
 // parseBIOSInfo extracts BIOS version information from hardware info structure
 func parseBIOSInfo(hwInfo interface{}) (version, versionString, manufacturer, releaseDate string) {
 	return extractBIOSDetails(hwInfo)
@@ -132,9 +146,15 @@ func extractBIOSDetails(hwInfo interface{}) (version, versionString, manufacture
 		return version, versionString, manufacturer, releaseDate
 	}
 
-	// Parse the hardware info map structure
-	if hwInfoMap, ok := hwInfo.(map[string]interface{}); ok {
-		version, versionString, manufacturer, releaseDate = parseFromMap(hwInfoMap)
+	switch info := hwInfo.(type) {
+	case dto.HardwareInfo:
+		// Typed hardware info from the devices feature carries the BIOS element directly
+		if info.CIMBIOSElement.Response != nil {
+			version, versionString, manufacturer, releaseDate = parseResponse(info.CIMBIOSElement.Response, version, versionString, manufacturer, releaseDate)
+		}
+	case map[string]interface{}:
+		// Parse the hardware info map structure
+		version, versionString, manufacturer, releaseDate = parseFromMap(info)
 	}
 
 	return version, versionString, manufacturer, releaseDate
@@ -333,13 +353,15 @@ func extractReleaseDateFromStruct(fieldValue reflect.Value, _, defaultReleaseDat
 	}
 
 	return parsedTime.Format("2006-01-02")
-} // createIntelOemSection creates Intel-specific OEM extensions
-func createIntelOemSection(systemID string) map[string]interface{} {
+}
+
+// createIntelOemSection creates Intel-specific OEM extensions
+func createIntelOemSection(systemID string, lastUpdated time.Time) map[string]interface{} {
 	return map[string]interface{}{
 		"Intel": map[string]interface{}{
 			"@odata.type": "#Intel.v1_0_0.Intel",
 			"SystemGUID":  systemID,
-			"LastUpdated": time.Now().UTC().Format(time.RFC3339),
+			"LastUpdated": lastUpdated.UTC().Format(time.RFC3339),
 			"AMTCapabilities": map[string]interface{}{
 				"SupportsSOL":         true,
 				"SupportsIDER":        true,
@@ -350,85 +372,196 @@ func createIntelOemSection(systemID string) map[string]interface{} {
 	}
 }
 
+// firmwareOptions carries the configuration the firmware handlers depend on
+type firmwareOptions struct {
+	cacheSeconds int
+	// verboseLogging enables debug dumps of the full hardware info
+	verboseLogging bool
+	// components lists the firmware component ids exposed in the inventory; nil exposes all of them
+	components []string
+	// updateable lists the firmware component ids reported as Updateable
+	updateable []string
+}
+
+func newFirmwareOptions(cfg *config.Config) firmwareOptions {
+	opts := firmwareOptions{
+		cacheSeconds:   firmwareCacheSeconds(cfg),
+		verboseLogging: cfg != nil && cfg.Redfish.VerboseLogging,
+	}
+
+	if cfg != nil && len(cfg.Redfish.FirmwareComponents) > 0 {
+		opts.components = cfg.Redfish.FirmwareComponents
+	}
+
+	if cfg != nil {
+		opts.updateable = cfg.Redfish.UpdateableFirmware
+	}
+
+	return opts
+}
+
+// exposes reports whether the firmware component firmwareID is part of the inventory
+func (o firmwareOptions) exposes(firmwareID string) bool {
+	return o.components == nil || slices.Contains(o.components, firmwareID)
+}
+
+// isUpdateable reports whether the firmware component firmwareID is configured as updateable. AMT
+// offers no remote firmware update, so this is a deployment decision rather than a device probe.
+func (o firmwareOptions) isUpdateable(firmwareID string) bool {
+	return slices.Contains(o.updateable, firmwareID)
+}
+
+// firmwareCacheSeconds returns the configured firmware Cache-Control max-age, falling back to
+// the default when unset or out of range
+func firmwareCacheSeconds(cfg *config.Config) int {
+	if cfg == nil || cfg.Redfish.FirmwareCacheSeconds <= 0 || cfg.Redfish.FirmwareCacheSeconds > maxFirmwareCacheSeconds {
+		return DefaultFirmwareCacheSeconds
+	}
+
+	return cfg.Redfish.FirmwareCacheSeconds
+}
+
+// cacheControl formats a Cache-Control max-age directive
+func cacheControl(maxAge int) string {
+	return "max-age=" + strconv.Itoa(maxAge)
+}
+
 // getFirmwareInventoryCollectionHandler handles GET /Systems/{id}/FirmwareInventory
-func getFirmwareInventoryCollectionHandler(d devices.Feature, l logger.Interface) gin.HandlerFunc {
+func getFirmwareInventoryCollectionHandler(d devices.Feature, opts firmwareOptions, l logger.Interface) gin.HandlerFunc {
+	tracker := newLastModifiedTracker()
+
 	return func(c *gin.Context) {
 		systemID := c.Param("id")
 
-		// Get AMT version information for AMT firmware components
-		_, versionInfo, err := d.GetVersion(c.Request.Context(), systemID)
-		if err != nil {
-			l.Error(err, "redfish v1 - FirmwareInventory: failed to get version for system %s", systemID)
-			ResourceNotFoundError(c, "ComputerSystem", systemID)
-
+		if !validateSystemID(c, systemID) {
 			return
 		}
 
+		// Get AMT version information for AMT firmware components. When only the version read
+		// fails, the collection still lists the components the hardware info resolves.
+		_, versionInfo, versionErr := d.GetVersion(c.Request.Context(), systemID)
+		if versionErr != nil {
+			var nfErr sqldb.NotFoundError
+			if errors.As(versionErr, &nfErr) {
+				firmwareVersionError(c, l, versionErr, systemID)
+
+				return
+			}
+
+			l.Warn("redfish v1 - FirmwareInventory: failed to get version for system %s: %v", systemID, versionErr)
+		}
+
 		// Get hardware info and build collection
-		collection := buildFirmwareCollection(d, l, c, systemID, versionInfo)
+		collection, hardwareRead := buildFirmwareCollection(d, l, c, systemID, versionInfo, opts)
+		if versionErr != nil && !hardwareRead {
+			firmwareVersionError(c, l, versionErr, systemID)
+
+			return
+		}
+
+		// The OEM LastUpdated reports when the collection last changed
+		lastModified := tracker.touch(systemID, collection.ODataEtag)
+		collection.Oem = createIntelOemSection(systemID, lastModified)
 
 		// Set Redfish-compliant headers
 		SetRedfishHeaders(c)
 
 		// Set ETag header for HTTP caching
 		c.Header("ETag", collection.ODataEtag)
-		c.Header("Cache-Control", "max-age=300") // Cache for 5 minutes
+		c.Header("Cache-Control", cacheControl(opts.cacheSeconds))
+
+		if ifNoneMatch(c, collection.ODataEtag) || ifModifiedSince(c, lastModified) {
+			return
+		}
 
 		c.JSON(http.StatusOK, collection)
 	}
 }
 
-// buildFirmwareCollection creates the firmware inventory collection
-func buildFirmwareCollection(d devices.Feature, l logger.Interface, c *gin.Context, systemID string, versionInfo interface{}) FirmwareInventoryCollection {
-	// Get hardware information for BIOS and system firmware
-	// Add small delay to avoid potential connection conflicts
-	time.Sleep(sleepDurationMs * time.Millisecond)
-	l.Info("redfish v1 - FirmwareInventory: attempting to get hardware info for system %s", systemID)
+// firmwareVersionError writes the Redfish error for a failed version read: 404 when the system does
+// not exist, otherwise the status deviceCallError derives from the error, such as 502 for an
+// unreachable device or 503 for an overloaded backend
+func firmwareVersionError(c *gin.Context, l logger.Interface, err error, systemID string) {
+	var nfErr sqldb.NotFoundError
+	if errors.As(err, &nfErr) {
+		ResourceNotFoundError(c, "ComputerSystem", systemID)
+
+		return
+	}
 
-	hwInfo, hwErr := d.GetHardwareInfo(c.Request.Context(), systemID)
-	if hwErr != nil {
-		l.Warn("redfish v1 - FirmwareInventory: failed to get hardware info for system %s: %v", systemID, hwErr)
-	} else {
-		// Debug: Log the hwInfo structure to understand what we're getting
-		if hwInfoJSON, err := json.Marshal(hwInfo); err == nil {
-			l.Info("redfish v1 - FirmwareInventory: hwInfo structure: %s", string(hwInfoJSON))
+	l.Error(err, "redfish v1 - FirmwareInventory: failed to get version for system %s [request %s]", systemID, requestID(c))
+	deviceCallError(c, err)
+}
+
+// buildFirmwareCollection creates the firmware inventory collection of the components opts exposes.
+// hardwareRead reports whether the hardware info of the system was read.
+func buildFirmwareCollection(d devices.Feature, l logger.Interface, c *gin.Context, systemID string, versionInfo interface{}, opts firmwareOptions) (collection FirmwareInventoryCollection, hardwareRead bool) {
+	var (
+		hwInfo dto.HardwareInfo
+		hwErr  error
+	)
+
+	// Get hardware information for BIOS and system firmware, unless BIOS is hidden
+	if opts.exposes(biosID) {
+		l.Info("redfish v1 - FirmwareInventory: attempting to get hardware info for system %s", systemID)
+
+		hwInfo, hwErr = getHardwareInfoWithRetry(c.Request.Context(), d, l, systemID)
+		if hwErr != nil {
+			l.Warn("redfish v1 - FirmwareInventory: failed to get hardware info for system %s: %v", systemID, hwErr)
 		} else {
-			l.Warn("redfish v1 - FirmwareInventory: failed to marshal hwInfo: %v", err)
+			logHardwareInfo(l, opts.verboseLogging, systemID, hwInfo)
 		}
 	}
 
+	base := linkBase(c)
+
 	// Build firmware inventory collection from AMT version data
-	collection := FirmwareInventoryCollection{
-		ODataContext: "/redfish/v1/$metadata#SoftwareInventoryCollection.SoftwareInventoryCollection",
-		ODataID:      "/redfish/v1/Systems/" + systemID + "/FirmwareInventory",
+	collection = FirmwareInventoryCollection{
+		ODataContext: base + "/$metadata#SoftwareInventoryCollection.SoftwareInventoryCollection",
+		ODataID:      firmwareInventoryPath(base, systemID),
 		ODataType:    "#SoftwareInventoryCollection.SoftwareInventoryCollection",
 		ID:           "FirmwareInventory",
 		Name:         "Firmware Inventory Collection",
 		Description:  "Collection of firmware inventory for this system",
 		Members:      []FirmwareInventoryMember{},
 		MembersCount: 0,
-		Oem:          createIntelOemSection(systemID),
 	}
 
 	// Add firmware members based on available version info
-	addFirmwareMembers(&collection, systemID, versionInfo)
+	addFirmwareMembers(&collection, base, systemID, versionInfo)
 
 	// Add system firmware from hardware info
-	if hwErr == nil && hwInfo != nil {
-		addBIOSMember(&collection, systemID)
+	if hwErr == nil && hwInfo.CIMBIOSElement.Response != nil {
+		addBIOSMember(&collection, base, systemID)
 	}
 
+	// Drop the components the deployment hides
+	collection.Members = slices.DeleteFunc(collection.Members, func(member FirmwareInventoryMember) bool {
+		return !opts.exposes(path.Base(member.ODataID))
+	})
+
 	collection.MembersCount = len(collection.Members)
 
-	// Generate ETag for caching
-	collectionContent := fmt.Sprintf("FirmwareInventory-%s-%d", systemID, collection.MembersCount)
-	collection.ODataEtag = generateETag(collectionContent)
+	collection.ODataEtag = firmwareCollectionETag(systemID, collection.Members)
 
-	return collection
+	return collection, opts.exposes(biosID) && hwErr == nil
+}
+
+// firmwareCollectionETag hashes the sorted member ids, so the ETag changes exactly when the
+// membership does and not merely when the member count does
+func firmwareCollectionETag(systemID string, members []FirmwareInventoryMember) string {
+	ids := make([]string, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, member.ODataID)
+	}
+
+	sort.Strings(ids)
+
+	return formatETag("FirmwareInventory-" + systemID + "-" + strings.Join(ids, ","))
 }
 
 // addFirmwareMembers adds firmware inventory members based on version info
-func addFirmwareMembers(collection *FirmwareInventoryCollection, systemID string, versionInfo interface{}) {
+func addFirmwareMembers(collection *FirmwareInventoryCollection, base, systemID string, versionInfo interface{}) {
 	// Use type assertion to access version info fields
 	// This assumes versionInfo has the expected structure
 	v := reflect.ValueOf(versionInfo)
@@ -443,25 +576,25 @@ func addFirmwareMembers(collection *FirmwareInventoryCollection, systemID string
 	// Add AMT firmware components as inventory items
 	if amt := getStringField(v, "AMT"); amt != "" {
 		collection.Members = append(collection.Members, FirmwareInventoryMember{
-			ODataID: "/redfish/v1/Systems/" + systemID + "/FirmwareInventory/AMT",
+			ODataID: firmwareInventoryPath(base, systemID) + "/AMT",
 		})
 	}
 
 	if flash := getStringField(v, "Flash"); flash != "" {
 		collection.Members = append(collection.Members, FirmwareInventoryMember{
-			ODataID: "/redfish/v1/Systems/" + systemID + "/FirmwareInventory/Flash",
+			ODataID: firmwareInventoryPath(base, systemID) + "/Flash",
 		})
 	}
 
 	if netstack := getStringField(v, "Netstack"); netstack != "" {
 		collection.Members = append(collection.Members, FirmwareInventoryMember{
-			ODataID: "/redfish/v1/Systems/" + systemID + "/FirmwareInventory/Netstack",
+			ODataID: firmwareInventoryPath(base, systemID) + "/Netstack",
 		})
 	}
 
 	if amtApps := getStringField(v, "AMTApps"); amtApps != "" {
 		collection.Members = append(collection.Members, FirmwareInventoryMember{
-			ODataID: "/redfish/v1/Systems/" + systemID + "/FirmwareInventory/AMTApps",
+			ODataID: firmwareInventoryPath(base, systemID) + "/AMTApps",
 		})
 	}
 }
@@ -477,55 +610,68 @@ func getStringField(v reflect.Value, fieldName string) string {
 }
 
 // addBIOSMember adds BIOS firmware member to the collection
-func addBIOSMember(collection *FirmwareInventoryCollection, systemID string) {
+func addBIOSMember(collection *FirmwareInventoryCollection, base, systemID string) {
 	collection.Members = append(collection.Members, FirmwareInventoryMember{
-		ODataID: "/redfish/v1/Systems/" + systemID + "/FirmwareInventory/BIOS",
+		ODataID: firmwareInventoryPath(base, systemID) + "/BIOS",
 	})
 }
 
 // getFirmwareInventoryInstanceHandler handles GET /Systems/{id}/FirmwareInventory/{firmwareId}
-func getFirmwareInventoryInstanceHandler(d devices.Feature, l logger.Interface) gin.HandlerFunc {
+func getFirmwareInventoryInstanceHandler(d devices.Feature, opts firmwareOptions, l logger.Interface) gin.HandlerFunc {
+	tracker := newLastModifiedTracker()
+
 	return func(c *gin.Context) {
 		systemID := c.Param("id")
 		firmwareID := c.Param("firmwareId")
 
+		if !validateSystemID(c, systemID) {
+			return
+		}
+
+		if !opts.exposes(firmwareID) {
+			ResourceNotFoundError(c, "SoftwareInventory", firmwareID)
+
+			return
+		}
+
 		// Get AMT version information
 		_, versionInfo, err := d.GetVersion(c.Request.Context(), systemID)
 		if err != nil {
-			l.Error(err, "redfish v1 - FirmwareInventory: failed to get version for system %s", systemID)
-			ResourceNotFoundError(c, "ComputerSystem", systemID)
+			firmwareVersionError(c, l, err, systemID)
 
 			return
 		}
 
 		// Get hardware info if needed for BIOS
-		hwInfo, err := getHardwareInfoIfNeeded(d, l, c, systemID, firmwareID)
+		hwInfo, err := getHardwareInfoIfNeeded(d, l, c, systemID, firmwareID, opts.verboseLogging)
 		if err != nil {
 			return // Error already handled in the function
 		}
 
 		// Get the specific firmware inventory item
-		firmware := getFirmwareItem(systemID, firmwareID, versionInfo, hwInfo, l)
+		firmware := getFirmwareItem(linkBase(c), systemID, firmwareID, versionInfo, hwInfo, l)
 		if firmware == nil {
 			ResourceNotFoundError(c, "SoftwareInventory", firmwareID)
 
 			return
 		}
 
+		firmware.Updateable = opts.isUpdateable(firmwareID)
+
 		// Send response
-		sendFirmwareResponse(c, firmware)
+		sendFirmwareResponse(c, firmware, tracker.touch(firmware.ODataID, firmware.ODataEtag), opts.cacheSeconds)
 	}
 }
 
 // getHardwareInfoIfNeeded gets hardware info only for BIOS requests
-func getHardwareInfoIfNeeded(d devices.Feature, l logger.Interface, c *gin.Context, systemID, firmwareID string) (interface{}, error) {
+func getHardwareInfoIfNeeded(d devices.Feature, l logger.Interface, c *gin.Context, systemID, firmwareID string, verbose bool) (interface{}, error) {
 	if firmwareID != biosID {
 		return nil, nil
 	}
 
 	l.Info("redfish v1 - FirmwareInventory: getting hardware info for BIOS firmware, system %s", systemID)
 
-	hwInfo, err := d.GetHardwareInfo(c.Request.Context(), systemID)
+	hwInfo, err := getHardwareInfoWithRetry(c.Request.Context(), d, l, systemID)
 	if err != nil {
 		l.Error(err, "redfish v1 - FirmwareInventory: failed to get hardware info for system %s", systemID)
 		ResourceNotFoundError(c, "SoftwareInventory", firmwareID)
@@ -533,36 +679,87 @@ func getHardwareInfoIfNeeded(d devices.Feature, l logger.Interface, c *gin.Conte
 		return nil, err
 	}
 
-	// Debug: Log what we actually got
-	if hwInfoJSON, marshalErr := json.Marshal(hwInfo); marshalErr == nil {
-		l.Info("redfish v1 - FirmwareInventory: BIOS hwInfo retrieved: %s", string(hwInfoJSON))
-	} else {
-		l.Warn("redfish v1 - FirmwareInventory: failed to marshal BIOS hwInfo: %v", marshalErr)
-	}
+	logHardwareInfo(l, verbose, systemID, hwInfo)
 
 	return hwInfo, nil
 }
 
+// getHardwareInfoWithRetry reads the hardware info of a system, retrying once when the first
+// attempt fails with a transient connection error such as a reset AMT connection
+func getHardwareInfoWithRetry(ctx context.Context, d devices.Feature, l logger.Interface, systemID string) (dto.HardwareInfo, error) {
+	hwInfo, err := d.GetHardwareInfo(ctx, systemID)
+	if err == nil || !isUpstreamCommunicationError(err) || ctx.Err() != nil {
+		return hwInfo, err
+	}
+
+	l.Warn("redfish v1 - FirmwareInventory: retrying hardware info for system %s after transient error: %v", systemID, err)
+
+	return d.GetHardwareInfo(ctx, systemID)
+}
+
+// logHardwareInfo dumps the full hardware info at debug level when verbose logging is enabled.
+// Otherwise only the number of populated CIM components is logged, keeping serial numbers out of the logs.
+func logHardwareInfo(l logger.Interface, verbose bool, systemID string, hwInfo dto.HardwareInfo) {
+	if !verbose {
+		l.Info("redfish v1 - FirmwareInventory: hardware info for system %s has %d components", systemID, hardwareComponentCount(hwInfo))
+
+		return
+	}
+
+	hwInfoJSON, err := json.Marshal(hwInfo)
+	if err != nil {
+		l.Warn("redfish v1 - FirmwareInventory: failed to marshal hwInfo: %v", err)
+
+		return
+	}
+
+	l.Debug("redfish v1 - FirmwareInventory: hwInfo for system %s: %s", systemID, string(hwInfoJSON))
+}
+
+// hardwareComponentCount counts the CIM components that carry data
+func hardwareComponentCount(hwInfo dto.HardwareInfo) int {
+	components := []dto.CIMResponse{
+		hwInfo.CIMComputerSystemPackage,
+		hwInfo.CIMSystemPackaging,
+		hwInfo.CIMChassis,
+		hwInfo.CIMChip,
+		hwInfo.CIMCard,
+		hwInfo.CIMBIOSElement,
+		hwInfo.CIMProcessor,
+		hwInfo.CIMPhysicalMemory,
+	}
+
+	count := 0
+
+	for _, component := range components {
+		if component.Response != nil || len(component.Responses) > 0 {
+			count++
+		}
+	}
+
+	return count
+}
+
 // getFirmwareItem creates the appropriate firmware inventory item based on firmware ID
-func getFirmwareItem(systemID, firmwareID string, versionInfo, hwInfo interface{}, l logger.Interface) *FirmwareInventory {
+func getFirmwareItem(base, systemID, firmwareID string, versionInfo, hwInfo interface{}, l logger.Interface) *FirmwareInventory {
 	switch firmwareID {
 	case "AMT":
-		return createAMTFirmware(systemID, versionInfo)
+		return createAMTFirmware(base, systemID, versionInfo)
 	case "Flash":
-		return createFlashFirmware(systemID, versionInfo)
+		return createFlashFirmware(base, systemID, versionInfo)
 	case "Netstack":
-		return createNetstackFirmware(systemID, versionInfo)
+		return createNetstackFirmware(base, systemID, versionInfo)
 	case "AMTApps":
-		return createAMTAppsFirmware(systemID, versionInfo)
+		return createAMTAppsFirmware(base, systemID, versionInfo)
 	case biosID:
-		return createBIOSFirmware(systemID, hwInfo, l)
+		return createBIOSFirmware(base, systemID, hwInfo, l)
 	}
 
 	return nil
 }
 
 // sendFirmwareResponse sends the firmware inventory response
-func sendFirmwareResponse(c *gin.Context, firmware *FirmwareInventory) {
+func sendFirmwareResponse(c *gin.Context, firmware *FirmwareInventory, lastModified time.Time, maxAge int) {
 	// Set Redfish-compliant headers
 	SetRedfishHeaders(c)
 
@@ -571,13 +768,17 @@ func sendFirmwareResponse(c *gin.Context, firmware *FirmwareInventory) {
 		c.Header("ETag", firmware.ODataEtag)
 	}
 
-	c.Header("Cache-Control", "max-age=300") // Cache for 5 minutes
+	c.Header("Cache-Control", cacheControl(maxAge))
+
+	if ifNoneMatch(c, firmware.ODataEtag) || ifModifiedSince(c, lastModified) {
+		return
+	}
 
 	c.JSON(http.StatusOK, firmware)
 }
 
 // createAMTFirmware creates firmware inventory for AMT
-func createAMTFirmware(systemID string, versionInfo interface{}) *FirmwareInventory {
+func createAMTFirmware(base, systemID string, versionInfo interface{}) *FirmwareInventory {
 	v := reflect.ValueOf(versionInfo)
 	if v.Kind() == reflect.Ptr {
 		v = v.Elem()
@@ -589,19 +790,18 @@ func createAMTFirmware(systemID string, versionInfo interface{}) *FirmwareInvent
 	}
 
 	return &FirmwareInventory{
-		ODataContext:  "/redfish/v1/$metadata#SoftwareInventory.SoftwareInventory",
-		ODataID:       "/redfish/v1/Systems/" + systemID + "/FirmwareInventory/AMT",
+		ODataContext:  base + "/$metadata#SoftwareInventory.SoftwareInventory",
+		ODataID:       firmwareInventoryPath(base, systemID) + "/AMT",
 		ODataType:     "#SoftwareInventory.v1_3_0.SoftwareInventory",
-		ODataEtag:     generateETag(fmt.Sprintf("AMT-%s-%s", systemID, amt)),
+		ODataEtag:     formatETag(fmt.Sprintf("AMT-%s-%s", systemID, amt)),
 		ID:            "AMT",
 		Name:          "Intel Active Management Technology",
 		Description:   "Intel AMT Firmware",
 		Version:       amt,
 		VersionString: amt,
 		Manufacturer:  "Intel Corporation",
-		ReleaseDate:   time.Now().UTC().Format("2006-01-02"), // Current date as placeholder
-		SoftwareID:    "AMT-" + systemID,
-		Updateable:    false, // AMT firmware updates typically require special procedures
+		SoftwareID:    softwareID("AMT"),
+		RelatedItem:   firmwareRelatedItem(base, systemID),
 		Status: Status{
 			State:  "Enabled",
 			Health: "OK",
@@ -611,7 +811,7 @@ func createAMTFirmware(systemID string, versionInfo interface{}) *FirmwareInvent
 }
 
 // createFlashFirmware creates firmware inventory for Flash
-func createFlashFirmware(systemID string, versionInfo interface{}) *FirmwareInventory {
+func createFlashFirmware(base, systemID string, versionInfo interface{}) *FirmwareInventory {
 	v := reflect.ValueOf(versionInfo)
 	if v.Kind() == reflect.Ptr {
 		v = v.Elem()
@@ -623,19 +823,18 @@ func createFlashFirmware(systemID string, versionInfo interface{}) *FirmwareInve
 	}
 
 	return &FirmwareInventory{
-		ODataContext:  "/redfish/v1/$metadata#SoftwareInventory.SoftwareInventory",
-		ODataID:       "/redfish/v1/Systems/" + systemID + "/FirmwareInventory/Flash",
+		ODataContext:  base + "/$metadata#SoftwareInventory.SoftwareInventory",
+		ODataID:       firmwareInventoryPath(base, systemID) + "/Flash",
 		ODataType:     "#SoftwareInventory.v1_3_0.SoftwareInventory",
-		ODataEtag:     generateETag(fmt.Sprintf("Flash-%s-%s", systemID, flash)),
+		ODataEtag:     formatETag(fmt.Sprintf("Flash-%s-%s", systemID, flash)),
 		ID:            "Flash",
 		Name:          "AMT Flash Firmware",
 		Description:   "AMT Flash Memory Firmware",
 		Version:       flash,
 		VersionString: flash,
 		Manufacturer:  "Intel Corporation",
-		ReleaseDate:   time.Now().UTC().Format("2006-01-02"),
-		SoftwareID:    "Flash-" + systemID,
-		Updateable:    false,
+		SoftwareID:    softwareID("Flash"),
+		RelatedItem:   firmwareRelatedItem(base, systemID),
 		Status: Status{
 			State:  "Enabled",
 			Health: "OK",
@@ -652,7 +851,7 @@ func createFlashFirmware(systemID string, versionInfo interface{}) *FirmwareInve
 }
 
 // createNetstackFirmware creates firmware inventory for Netstack
-func createNetstackFirmware(systemID string, versionInfo interface{}) *FirmwareInventory {
+func createNetstackFirmware(base, systemID string, versionInfo interface{}) *FirmwareInventory {
 	v := reflect.ValueOf(versionInfo)
 	if v.Kind() == reflect.Ptr {
 		v = v.Elem()
@@ -664,19 +863,18 @@ func createNetstackFirmware(systemID string, versionInfo interface{}) *FirmwareI
 	}
 
 	return &FirmwareInventory{
-		ODataContext:  "/redfish/v1/$metadata#SoftwareInventory.SoftwareInventory",
-		ODataID:       "/redfish/v1/Systems/" + systemID + "/FirmwareInventory/Netstack",
+		ODataContext:  base + "/$metadata#SoftwareInventory.SoftwareInventory",
+		ODataID:       firmwareInventoryPath(base, systemID) + "/Netstack",
 		ODataType:     "#SoftwareInventory.v1_3_0.SoftwareInventory",
-		ODataEtag:     generateETag(fmt.Sprintf("Netstack-%s-%s", systemID, netstack)),
+		ODataEtag:     formatETag(fmt.Sprintf("Netstack-%s-%s", systemID, netstack)),
 		ID:            "Netstack",
 		Name:          "AMT Network Stack",
 		Description:   "AMT Network Stack Firmware",
 		Version:       netstack,
 		VersionString: netstack,
 		Manufacturer:  "Intel Corporation",
-		ReleaseDate:   time.Now().UTC().Format("2006-01-02"),
-		SoftwareID:    "Netstack-" + systemID,
-		Updateable:    false,
+		SoftwareID:    softwareID("Netstack"),
+		RelatedItem:   firmwareRelatedItem(base, systemID),
 		Status: Status{
 			State:  "Enabled",
 			Health: "OK",
@@ -693,7 +891,7 @@ func createNetstackFirmware(systemID string, versionInfo interface{}) *FirmwareI
 }
 
 // createAMTAppsFirmware creates firmware inventory for AMTApps
-func createAMTAppsFirmware(systemID string, versionInfo interface{}) *FirmwareInventory {
+func createAMTAppsFirmware(base, systemID string, versionInfo interface{}) *FirmwareInventory {
 	v := reflect.ValueOf(versionInfo)
 	if v.Kind() == reflect.Ptr {
 		v = v.Elem()
@@ -705,19 +903,18 @@ func createAMTAppsFirmware(systemID string, versionInfo interface{}) *FirmwareIn
 	}
 
 	return &FirmwareInventory{
-		ODataContext:  "/redfish/v1/$metadata#SoftwareInventory.SoftwareInventory",
-		ODataID:       "/redfish/v1/Systems/" + systemID + "/FirmwareInventory/AMTApps",
+		ODataContext:  base + "/$metadata#SoftwareInventory.SoftwareInventory",
+		ODataID:       firmwareInventoryPath(base, systemID) + "/AMTApps",
 		ODataType:     "#SoftwareInventory.v1_3_0.SoftwareInventory",
-		ODataEtag:     generateETag(fmt.Sprintf("AMTApps-%s-%s", systemID, amtApps)),
+		ODataEtag:     formatETag(fmt.Sprintf("AMTApps-%s-%s", systemID, amtApps)),
 		ID:            "AMTApps",
 		Name:          "AMT Applications",
 		Description:   "AMT Applications Firmware",
 		Version:       amtApps,
 		VersionString: amtApps,
 		Manufacturer:  "Intel Corporation",
-		ReleaseDate:   time.Now().UTC().Format("2006-01-02"),
-		SoftwareID:    "AMTApps-" + systemID,
-		Updateable:    false,
+		SoftwareID:    softwareID("AMTApps"),
+		RelatedItem:   firmwareRelatedItem(base, systemID),
 		Status: Status{
 			State:  "Enabled",
 			Health: "OK",
@@ -734,7 +931,7 @@ func createAMTAppsFirmware(systemID string, versionInfo interface{}) *FirmwareIn
 }
 
 // createBIOSFirmware creates firmware inventory for BIOS
-func createBIOSFirmware(systemID string, hwInfo interface{}, l logger.Interface) *FirmwareInventory {
+func createBIOSFirmware(base, systemID string, hwInfo interface{}, l logger.Interface) *FirmwareInventory {
 	if hwInfo == nil {
 		l.Warn("BIOS firmware request - hwInfo is nil, cannot retrieve BIOS version")
 
@@ -750,10 +947,10 @@ func createBIOSFirmware(systemID string, hwInfo interface{}, l logger.Interface)
 	l.Info("BIOS case: parsed version=%s, manufacturer=%s, releaseDate=%s", version, manufacturer, releaseDate)
 
 	return &FirmwareInventory{
-		ODataContext:  "/redfish/v1/$metadata#SoftwareInventory.SoftwareInventory",
-		ODataID:       "/redfish/v1/Systems/" + systemID + "/FirmwareInventory/BIOS",
+		ODataContext:  base + "/$metadata#SoftwareInventory.SoftwareInventory",
+		ODataID:       firmwareInventoryPath(base, systemID) + "/BIOS",
 		ODataType:     "#SoftwareInventory.v1_3_0.SoftwareInventory",
-		ODataEtag:     generateETag(fmt.Sprintf("BIOS-%s-%s", systemID, version)),
+		ODataEtag:     formatETag(fmt.Sprintf("BIOS-%s-%s", systemID, version)),
 		ID:            "BIOS",
 		Name:          "System BIOS/UEFI",
 		Description:   "System BIOS/UEFI Firmware",
@@ -761,8 +958,8 @@ func createBIOSFirmware(systemID string, hwInfo interface{}, l logger.Interface)
 		VersionString: versionString,
 		Manufacturer:  manufacturer,
 		ReleaseDate:   releaseDate, // Use actual BIOS release date instead of current date
-		SoftwareID:    "BIOS-" + systemID,
-		Updateable:    false, // BIOS updates typically require special procedures
+		SoftwareID:    softwareID(biosID),
+		RelatedItem:   firmwareRelatedItem(base, systemID),
 		Status: Status{
 			State:  "Enabled",
 			Health: "OK",
@@ -778,6 +975,26 @@ func createBIOSFirmware(systemID string, hwInfo interface{}, l logger.Interface)
 	}
 }
 
+// softwareIDVendor qualifies the SoftwareId of every firmware component
+const softwareIDVendor = "Intel"
+
+// softwareID returns the SoftwareId of a firmware component, such as Intel:AMT. It names the
+// component independently of the system, so clients can group the same firmware across systems;
+// the system is identified by @odata.id.
+func softwareID(firmwareID string) string {
+	return softwareIDVendor + ":" + firmwareID
+}
+
+// firmwareRelatedItem links a firmware inventory item back to the ComputerSystem it belongs to
+func firmwareRelatedItem(base, systemID string) []map[string]string {
+	return []map[string]string{{"@odata.id": systemPath(base, systemID)}}
+}
+
+// firmwareInventoryPath returns the FirmwareInventory collection of a system under base
+func firmwareInventoryPath(base, systemID string) string {
+	return systemPath(base, systemID) + "/FirmwareInventory"
+}
+
 // createAMTOemSection creates the OEM section for AMT firmware
 func createAMTOemSection(versionInfo interface{}, _ string) map[string]interface{} {
 	v := reflect.ValueOf(versionInfo)
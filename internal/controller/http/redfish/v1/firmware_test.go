@@ -9,10 +9,13 @@ package v1
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"path"
 	"reflect"
+	"slices"
 	"testing"
 	"time"
 
@@ -21,47 +24,16 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/device-management-toolkit/console/config"
 	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	dtov2 "github.com/device-management-toolkit/console/internal/entity/dto/v2"
 	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
-const testSystemID = "test-system-123"
-
-func TestGenerateETag(t *testing.T) {
-	t.Parallel()
-
-	tests := []struct {
-		name     string
-		content  string
-		expected string
-	}{
-		{
-			name:     "empty content",
-			content:  "",
-			expected: `W/"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"`,
-		},
-		{
-			name:     "simple content",
-			content:  "test",
-			expected: `W/"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`,
-		},
-		{
-			name:     "firmware inventory content",
-			content:  "FirmwareInventory-system-123-5",
-			expected: `W/"f10d99bfef532179d77a3e1229f4a1a98ae4b9bfbed03d6839442365a8d759fd"`,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-
-			result := generateETag(tt.content)
-			assert.Equal(t, tt.expected, result)
-		})
-	}
-}
+const testSystemID = "3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47"
 
 func TestParseBIOSInfo(t *testing.T) {
 	t.Parallel()
@@ -125,6 +97,32 @@ func TestParseBIOSInfo(t *testing.T) {
 			expectedManufacturer: "System Manufacturer",
 			expectedRelease:      time.Now().UTC().Format("2006-01-02"),
 		},
+		{
+			name: "complete BIOS info from typed hardware info",
+			hwInfo: dto.HardwareInfo{
+				CIMBIOSElement: dto.CIMResponse{
+					Response: map[string]interface{}{
+						"Version":      "BIOS-2.0.0",
+						"Manufacturer": "ACME Corp.",
+						"ReleaseDate": map[string]interface{}{
+							"DateTime": "2024-03-21T00:00:00Z",
+						},
+					},
+				},
+			},
+			expectedVersion:      "BIOS-2.0.0",
+			expectedVersionStr:   "BIOS-2.0.0 (Released: 2024-03-21)",
+			expectedManufacturer: "ACME Corp.",
+			expectedRelease:      "2024-03-21",
+		},
+		{
+			name:                 "typed hardware info without BIOS response",
+			hwInfo:               dto.HardwareInfo{},
+			expectedVersion:      "Unknown",
+			expectedVersionStr:   "Unknown",
+			expectedManufacturer: "System Manufacturer",
+			expectedRelease:      time.Now().UTC().Format("2006-01-02"),
+		},
 		{
 			name:                 "invalid hardware info structure",
 			hwInfo:               "invalid",
@@ -311,7 +309,8 @@ func TestCreateIntelOemSection(t *testing.T) {
 	t.Parallel()
 
 	systemID := testSystemID
-	result := createIntelOemSection(systemID)
+	lastUpdated := time.Date(2025, time.March, 4, 5, 6, 7, 0, time.UTC)
+	result := createIntelOemSection(systemID, lastUpdated)
 
 	assert.Contains(t, result, "Intel")
 	intel, ok := result["Intel"].(map[string]interface{})
@@ -319,7 +318,7 @@ func TestCreateIntelOemSection(t *testing.T) {
 
 	assert.Equal(t, "#Intel.v1_0_0.Intel", intel["@odata.type"])
 	assert.Equal(t, systemID, intel["SystemGUID"])
-	assert.Contains(t, intel, "LastUpdated")
+	assert.Equal(t, "2025-03-04T05:06:07Z", intel["LastUpdated"])
 	assert.Contains(t, intel, "AMTCapabilities")
 
 	caps, ok := intel["AMTCapabilities"].(map[string]interface{})
@@ -343,23 +342,23 @@ func TestGetFirmwareInventoryCollectionHandler(t *testing.T) {
 	}{
 		{
 			name:     "successful collection retrieval",
-			systemID: "valid-system-id",
+			systemID: "6a7b8c9d-0e1f-4a2b-9c3d-4e5f6a7b8c9d",
 			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
 				// Mock successful GetVersion call
 				mockFeature.EXPECT().
-					GetVersion(gomock.Any(), "valid-system-id").
+					GetVersion(gomock.Any(), "6a7b8c9d-0e1f-4a2b-9c3d-4e5f6a7b8c9d").
 					Return(dto.Version{}, dtov2.Version{AMT: "15.0.25"}, nil)
 
 				// Mock successful GetHardwareInfo call
-				hwInfo := map[string]interface{}{
-					"CIM_BIOSElement": map[string]interface{}{
-						"response": map[string]interface{}{
+				hwInfo := dto.HardwareInfo{
+					CIMBIOSElement: dto.CIMResponse{
+						Response: map[string]interface{}{
 							"Version": "BIOS-1.0.0",
 						},
 					},
 				}
 				mockFeature.EXPECT().
-					GetHardwareInfo(gomock.Any(), "valid-system-id").
+					GetHardwareInfo(gomock.Any(), "6a7b8c9d-0e1f-4a2b-9c3d-4e5f6a7b8c9d").
 					Return(hwInfo, nil)
 
 				// Logger expectations
@@ -376,7 +375,7 @@ func TestGetFirmwareInventoryCollectionHandler(t *testing.T) {
 				err := json.Unmarshal([]byte(body), &collection)
 				require.NoError(t, err)
 
-				assert.Equal(t, "/redfish/v1/Systems/valid-system-id/FirmwareInventory", collection.ODataID)
+				assert.Equal(t, "/redfish/v1/Systems/6a7b8c9d-0e1f-4a2b-9c3d-4e5f6a7b8c9d/FirmwareInventory", collection.ODataID)
 				assert.Equal(t, "#SoftwareInventoryCollection.SoftwareInventoryCollection", collection.ODataType)
 				assert.Equal(t, "FirmwareInventory", collection.ID)
 				assert.Equal(t, "Firmware Inventory Collection", collection.Name)
@@ -392,13 +391,12 @@ func TestGetFirmwareInventoryCollectionHandler(t *testing.T) {
 		},
 		{
 			name:     "GetVersion failure - system not found",
-			systemID: "invalid-system-id",
+			systemID: "5d6e7f80-9a1b-4c2d-8e3f-4a5b6c7d8e9f",
 			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
 				mockFeature.EXPECT().
-					GetVersion(gomock.Any(), "invalid-system-id").
-					Return(dto.Version{}, dtov2.Version{}, fmt.Errorf("system not found"))
+					GetVersion(gomock.Any(), "5d6e7f80-9a1b-4c2d-8e3f-4a5b6c7d8e9f").
+					Return(dto.Version{}, dtov2.Version{}, devices.ErrNotFound)
 
-				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
 			},
 			expectedStatus: http.StatusNotFound,
@@ -406,20 +404,70 @@ func TestGetFirmwareInventoryCollectionHandler(t *testing.T) {
 				t.Helper()
 				assert.Contains(t, body, "Base.1.11.0.ResourceNotFound")
 				assert.Contains(t, body, "ComputerSystem")
-				assert.Contains(t, body, "invalid-system-id")
+				assert.Contains(t, body, "5d6e7f80-9a1b-4c2d-8e3f-4a5b6c7d8e9f")
+			},
+		},
+		{
+			name:     "GetVersion failure with BIOS from hardware info",
+			systemID: "3a4b5c6d-7e8f-4a0b-8c1d-2e3f4a5b6c7d",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().
+					GetVersion(gomock.Any(), "3a4b5c6d-7e8f-4a0b-8c1d-2e3f4a5b6c7d").
+					Return(dto.Version{}, dtov2.Version{}, fmt.Errorf("wsman: AMT_SetupAndConfigurationService pull failed"))
+
+				mockFeature.EXPECT().
+					GetHardwareInfo(gomock.Any(), "3a4b5c6d-7e8f-4a0b-8c1d-2e3f4a5b6c7d").
+					Return(dto.HardwareInfo{CIMBIOSElement: dto.CIMResponse{Response: map[string]interface{}{"Version": "BIOS-1.0.0"}}}, nil)
+
+				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+				mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).Times(1)
+			},
+			expectedStatus:       http.StatusOK,
+			expectedMembersCount: 1, // BIOS only
+			validateResponse: func(t *testing.T, body string, _ http.Header) {
+				t.Helper()
+
+				var collection FirmwareInventoryCollection
+
+				require.NoError(t, json.Unmarshal([]byte(body), &collection))
+				require.Len(t, collection.Members, 1)
+				assert.Equal(t, "/redfish/v1/Systems/3a4b5c6d-7e8f-4a0b-8c1d-2e3f4a5b6c7d/FirmwareInventory/BIOS", collection.Members[0].ODataID)
+			},
+		},
+		{
+			name:     "GetVersion and GetHardwareInfo failure",
+			systemID: "4b5c6d7e-8f9a-4b1c-9d2e-3f4a5b6c7d8e",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().
+					GetVersion(gomock.Any(), "4b5c6d7e-8f9a-4b1c-9d2e-3f4a5b6c7d8e").
+					Return(dto.Version{}, dtov2.Version{}, fmt.Errorf("dial tcp 10.0.0.5:16993: connection refused"))
+
+				mockFeature.EXPECT().
+					GetHardwareInfo(gomock.Any(), "4b5c6d7e-8f9a-4b1c-9d2e-3f4a5b6c7d8e").
+					Return(dto.HardwareInfo{}, fmt.Errorf("dial tcp 10.0.0.5:16993: connection refused")).Times(2)
+
+				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+				mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+			},
+			expectedStatus: http.StatusBadGateway,
+			validateResponse: func(t *testing.T, body string, _ http.Header) {
+				t.Helper()
+				assert.Contains(t, body, BaseErrorMessageID)
+				assert.NotContains(t, body, BaseResourceNotFoundID)
 			},
 		},
 		{
 			name:     "GetHardwareInfo failure but GetVersion succeeds",
-			systemID: "partial-system-id",
+			systemID: "2f3a4b5c-6d7e-4f80-b192-a3b4c5d6e7f8",
 			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
 				mockFeature.EXPECT().
-					GetVersion(gomock.Any(), "partial-system-id").
+					GetVersion(gomock.Any(), "2f3a4b5c-6d7e-4f80-b192-a3b4c5d6e7f8").
 					Return(dto.Version{}, dtov2.Version{AMT: "15.0.25", Flash: "1.2.3"}, nil)
 
 				mockFeature.EXPECT().
-					GetHardwareInfo(gomock.Any(), "partial-system-id").
-					Return(nil, fmt.Errorf("hardware info not available"))
+					GetHardwareInfo(gomock.Any(), "2f3a4b5c-6d7e-4f80-b192-a3b4c5d6e7f8").
+					Return(dto.HardwareInfo{}, fmt.Errorf("hardware info not available"))
 
 				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
 				mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).Times(1)
@@ -441,6 +489,35 @@ func TestGetFirmwareInventoryCollectionHandler(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:     "hardware info without BIOS response",
+			systemID: "7e8f9a0b-1c2d-4e3f-9a4b-5c6d7e8f9a0b",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().
+					GetVersion(gomock.Any(), "7e8f9a0b-1c2d-4e3f-9a4b-5c6d7e8f9a0b").
+					Return(dto.Version{}, dtov2.Version{AMT: "15.0.25"}, nil)
+
+				mockFeature.EXPECT().
+					GetHardwareInfo(gomock.Any(), "7e8f9a0b-1c2d-4e3f-9a4b-5c6d7e8f9a0b").
+					Return(dto.HardwareInfo{}, nil)
+
+				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectedStatus:       http.StatusOK,
+			expectedMembersCount: 1, // AMT only, no BIOS
+			validateResponse: func(t *testing.T, body string, _ http.Header) {
+				t.Helper()
+
+				var collection FirmwareInventoryCollection
+
+				err := json.Unmarshal([]byte(body), &collection)
+				require.NoError(t, err)
+
+				for _, member := range collection.Members {
+					assert.NotContains(t, member.ODataID, "/BIOS")
+				}
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -460,7 +537,7 @@ func TestGetFirmwareInventoryCollectionHandler(t *testing.T) {
 
 			// Setup routes
 			systems := router.Group("/redfish/v1/Systems")
-			NewFirmwareRoutes(systems, mockFeature, mockLogger)
+			NewFirmwareRoutes(systems, mockFeature, nil, mockLogger)
 
 			w := httptest.NewRecorder()
 			req, _ := http.NewRequestWithContext(
@@ -494,11 +571,11 @@ func TestGetFirmwareInventoryInstanceHandler(t *testing.T) {
 	}{
 		{
 			name:       "successful AMT firmware retrieval",
-			systemID:   "test-system",
+			systemID:   "c0ffee00-1234-4abc-9def-0123456789ab",
 			firmwareID: "AMT",
 			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
 				mockFeature.EXPECT().
-					GetVersion(gomock.Any(), "test-system").
+					GetVersion(gomock.Any(), "c0ffee00-1234-4abc-9def-0123456789ab").
 					Return(dto.Version{}, dtov2.Version{AMT: "15.0.25"}, nil)
 
 				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
@@ -521,16 +598,16 @@ func TestGetFirmwareInventoryInstanceHandler(t *testing.T) {
 		},
 		{
 			name:       "successful BIOS firmware retrieval",
-			systemID:   "test-system",
+			systemID:   "c0ffee00-1234-4abc-9def-0123456789ab",
 			firmwareID: "BIOS",
 			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
 				mockFeature.EXPECT().
-					GetVersion(gomock.Any(), "test-system").
+					GetVersion(gomock.Any(), "c0ffee00-1234-4abc-9def-0123456789ab").
 					Return(dto.Version{}, dtov2.Version{}, nil)
 
-				hwInfo := map[string]interface{}{
-					"CIM_BIOSElement": map[string]interface{}{
-						"response": map[string]interface{}{
+				hwInfo := dto.HardwareInfo{
+					CIMBIOSElement: dto.CIMResponse{
+						Response: map[string]interface{}{
 							"Version":      "DNKBLi7v.86A.0082.2024.0321.1028",
 							"Manufacturer": "Intel Corp.",
 							"ReleaseDate": map[string]interface{}{
@@ -540,7 +617,7 @@ func TestGetFirmwareInventoryInstanceHandler(t *testing.T) {
 					},
 				}
 				mockFeature.EXPECT().
-					GetHardwareInfo(gomock.Any(), "test-system").
+					GetHardwareInfo(gomock.Any(), "c0ffee00-1234-4abc-9def-0123456789ab").
 					Return(hwInfo, nil)
 
 				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
@@ -563,11 +640,11 @@ func TestGetFirmwareInventoryInstanceHandler(t *testing.T) {
 		},
 		{
 			name:       "firmware not found",
-			systemID:   "test-system",
+			systemID:   "c0ffee00-1234-4abc-9def-0123456789ab",
 			firmwareID: "NonExistent",
 			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
 				mockFeature.EXPECT().
-					GetVersion(gomock.Any(), "test-system").
+					GetVersion(gomock.Any(), "c0ffee00-1234-4abc-9def-0123456789ab").
 					Return(dto.Version{}, dtov2.Version{}, nil)
 
 				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
@@ -582,14 +659,13 @@ func TestGetFirmwareInventoryInstanceHandler(t *testing.T) {
 		},
 		{
 			name:       "system not found",
-			systemID:   "invalid-system",
+			systemID:   "0b1c2d3e-4f50-4617-a829-3a4b5c6d7e8f",
 			firmwareID: "AMT",
 			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
 				mockFeature.EXPECT().
-					GetVersion(gomock.Any(), "invalid-system").
-					Return(dto.Version{}, dtov2.Version{}, fmt.Errorf("system not found"))
+					GetVersion(gomock.Any(), "0b1c2d3e-4f50-4617-a829-3a4b5c6d7e8f").
+					Return(dto.Version{}, dtov2.Version{}, devices.ErrNotFound)
 
-				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
 			},
 			expectedStatus: http.StatusNotFound,
@@ -601,16 +677,16 @@ func TestGetFirmwareInventoryInstanceHandler(t *testing.T) {
 		},
 		{
 			name:       "BIOS hardware info failure",
-			systemID:   "test-system",
+			systemID:   "c0ffee00-1234-4abc-9def-0123456789ab",
 			firmwareID: "BIOS",
 			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
 				mockFeature.EXPECT().
-					GetVersion(gomock.Any(), "test-system").
+					GetVersion(gomock.Any(), "c0ffee00-1234-4abc-9def-0123456789ab").
 					Return(dto.Version{}, dtov2.Version{}, nil)
 
 				mockFeature.EXPECT().
-					GetHardwareInfo(gomock.Any(), "test-system").
-					Return(nil, fmt.Errorf("hardware info not available"))
+					GetHardwareInfo(gomock.Any(), "c0ffee00-1234-4abc-9def-0123456789ab").
+					Return(dto.HardwareInfo{}, fmt.Errorf("hardware info not available"))
 
 				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
 				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
@@ -641,7 +717,7 @@ func TestGetFirmwareInventoryInstanceHandler(t *testing.T) {
 
 			// Setup routes
 			systems := router.Group("/redfish/v1/Systems")
-			NewFirmwareRoutes(systems, mockFeature, mockLogger)
+			NewFirmwareRoutes(systems, mockFeature, nil, mockLogger)
 
 			w := httptest.NewRecorder()
 			req, _ := http.NewRequestWithContext(
@@ -662,6 +738,58 @@ func TestGetFirmwareInventoryInstanceHandler(t *testing.T) {
 	}
 }
 
+func TestFirmwareUpdateable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		updateable []string
+		firmwareID string
+		expected   bool
+	}{
+		{name: "updateable component", updateable: []string{"AMT", "Flash"}, firmwareID: "AMT", expected: true},
+		{name: "component not listed", updateable: []string{"Flash"}, firmwareID: "AMT"},
+		{name: "nothing configured", firmwareID: "AMT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().GetVersion(gomock.Any(), testSystemID).
+				Return(dto.Version{}, dtov2.Version{AMT: "15.0.25"}, nil)
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+			cfg := &config.Config{}
+			cfg.Redfish.UpdateableFirmware = tt.updateable
+
+			// The full Systems routes wrap the devices feature, as in production
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			NewSystemsRoutes(router.Group("/redfish/v1"), mockFeature, cfg, mockLogger)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet,
+				"/redfish/v1/Systems/"+testSystemID+"/FirmwareInventory/"+tt.firmwareID, http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var firmware FirmwareInventory
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &firmware))
+			assert.Equal(t, tt.expected, firmware.Updateable)
+		})
+	}
+}
+
 func TestCreateFirmwareFunctions(t *testing.T) {
 	t.Parallel()
 
@@ -672,7 +800,7 @@ func TestCreateFirmwareFunctions(t *testing.T) {
 
 		versionInfo := dtov2.Version{AMT: "15.0.25"}
 
-		firmware := createAMTFirmware(systemID, versionInfo)
+		firmware := createAMTFirmware(DefaultRedfishBasePath, systemID, versionInfo)
 		require.NotNil(t, firmware)
 
 		assert.Equal(t, "AMT", firmware.ID)
@@ -682,6 +810,7 @@ func TestCreateFirmwareFunctions(t *testing.T) {
 		assert.False(t, firmware.Updateable)
 		assert.Equal(t, "Enabled", firmware.Status.State)
 		assert.Equal(t, "OK", firmware.Status.Health)
+		assert.Empty(t, firmware.ReleaseDate, "release date is omitted when the version carries none")
 	})
 
 	t.Run("createAMTFirmware with empty version", func(t *testing.T) {
@@ -689,7 +818,7 @@ func TestCreateFirmwareFunctions(t *testing.T) {
 
 		versionInfo := dtov2.Version{AMT: ""}
 
-		firmware := createAMTFirmware(systemID, versionInfo)
+		firmware := createAMTFirmware(DefaultRedfishBasePath, systemID, versionInfo)
 		assert.Nil(t, firmware)
 	})
 
@@ -698,7 +827,7 @@ func TestCreateFirmwareFunctions(t *testing.T) {
 
 		versionInfo := dtov2.Version{Flash: "1.2.3"}
 
-		firmware := createFlashFirmware(systemID, versionInfo)
+		firmware := createFlashFirmware(DefaultRedfishBasePath, systemID, versionInfo)
 		require.NotNil(t, firmware)
 
 		assert.Equal(t, "Flash", firmware.ID)
@@ -712,7 +841,7 @@ func TestCreateFirmwareFunctions(t *testing.T) {
 
 		versionInfo := dtov2.Version{Netstack: "2.3.4"}
 
-		firmware := createNetstackFirmware(systemID, versionInfo)
+		firmware := createNetstackFirmware(DefaultRedfishBasePath, systemID, versionInfo)
 		require.NotNil(t, firmware)
 
 		assert.Equal(t, "Netstack", firmware.ID)
@@ -726,7 +855,7 @@ func TestCreateFirmwareFunctions(t *testing.T) {
 
 		versionInfo := dtov2.Version{AMTApps: "3.4.5"}
 
-		firmware := createAMTAppsFirmware(systemID, versionInfo)
+		firmware := createAMTAppsFirmware(DefaultRedfishBasePath, systemID, versionInfo)
 		require.NotNil(t, firmware)
 
 		assert.Equal(t, "AMTApps", firmware.ID)
@@ -735,6 +864,25 @@ func TestCreateFirmwareFunctions(t *testing.T) {
 		assert.Equal(t, "Intel Corporation", firmware.Manufacturer)
 	})
 
+	t.Run("AMT firmware omits an unknown release date", func(t *testing.T) {
+		t.Parallel()
+
+		versionInfo := dtov2.Version{AMT: "15.0.25", Flash: "1.2.3", Netstack: "2.3.4", AMTApps: "3.4.5"}
+
+		for _, create := range []func(string, string, interface{}) *FirmwareInventory{
+			createAMTFirmware, createFlashFirmware, createNetstackFirmware, createAMTAppsFirmware,
+		} {
+			firmware := create(DefaultRedfishBasePath, systemID, versionInfo)
+			require.NotNil(t, firmware)
+
+			assert.Empty(t, firmware.ReleaseDate)
+
+			body, err := json.Marshal(firmware)
+			require.NoError(t, err)
+			assert.NotContains(t, string(body), "ReleaseDate")
+		}
+	})
+
 	t.Run("createBIOSFirmware", func(t *testing.T) {
 		t.Parallel()
 
@@ -756,7 +904,7 @@ func TestCreateFirmwareFunctions(t *testing.T) {
 			},
 		}
 
-		firmware := createBIOSFirmware(systemID, hwInfo, mockLogger)
+		firmware := createBIOSFirmware(DefaultRedfishBasePath, systemID, hwInfo, mockLogger)
 		require.NotNil(t, firmware)
 
 		assert.Equal(t, "BIOS", firmware.ID)
@@ -764,6 +912,42 @@ func TestCreateFirmwareFunctions(t *testing.T) {
 		assert.Equal(t, "BIOS-1.0.0", firmware.Version)
 		assert.Equal(t, "Test Corp.", firmware.Manufacturer)
 		assert.Equal(t, "2024-01-15", firmware.ReleaseDate)
+		assert.Equal(t, []map[string]string{{"@odata.id": "/redfish/v1/Systems/" + systemID}}, firmware.RelatedItem)
+		assert.Equal(t, "Intel:BIOS", firmware.SoftwareID)
+	})
+
+	t.Run("SoftwareId names the component, not the system", func(t *testing.T) {
+		t.Parallel()
+
+		versionInfo := dtov2.Version{AMT: "15.0.25", Flash: "1.2.3", Netstack: "2.3.4", AMTApps: "3.4.5"}
+
+		for _, create := range []func(string, string, interface{}) *FirmwareInventory{
+			createAMTFirmware, createFlashFirmware, createNetstackFirmware, createAMTAppsFirmware,
+		} {
+			firmware := create(DefaultRedfishBasePath, systemID, versionInfo)
+			require.NotNil(t, firmware)
+
+			assert.Equal(t, "Intel:"+firmware.ID, firmware.SoftwareID)
+			assert.Equal(t, firmware.SoftwareID, create(DefaultRedfishBasePath, otherSystemGUID, versionInfo).SoftwareID)
+			assert.Contains(t, firmware.ODataID, systemID)
+		}
+	})
+
+	t.Run("AMT firmware links back to the system", func(t *testing.T) {
+		t.Parallel()
+
+		versionInfo := dtov2.Version{AMT: "15.0.25", Flash: "1.2.3", Netstack: "2.3.4", AMTApps: "3.4.5"}
+
+		for _, create := range []func(string, string, interface{}) *FirmwareInventory{
+			createAMTFirmware, createFlashFirmware, createNetstackFirmware, createAMTAppsFirmware,
+		} {
+			firmware := create(DefaultRedfishBasePath, systemID, versionInfo)
+			require.NotNil(t, firmware)
+
+			body, err := json.Marshal(firmware)
+			require.NoError(t, err)
+			assert.Contains(t, string(body), `"RelatedItem":[{"@odata.id":"/redfish/v1/Systems/`+systemID+`"}]`, firmware.ID)
+		}
 	})
 
 	t.Run("createBIOSFirmware with nil hwInfo", func(t *testing.T) {
@@ -775,7 +959,7 @@ func TestCreateFirmwareFunctions(t *testing.T) {
 		mockLogger := mocks.NewMockLogger(ctrl)
 		mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).Times(1)
 
-		firmware := createBIOSFirmware(systemID, nil, mockLogger)
+		firmware := createBIOSFirmware(DefaultRedfishBasePath, systemID, nil, mockLogger)
 		assert.Nil(t, firmware)
 	})
 }
@@ -857,7 +1041,7 @@ func TestHTTPMethodNotAllowedHandlers(t *testing.T) {
 
 			// Setup routes
 			systems := router.Group("/redfish/v1/Systems")
-			NewFirmwareRoutes(systems, mockFeature, mockLogger)
+			NewFirmwareRoutes(systems, mockFeature, nil, mockLogger)
 
 			w := httptest.NewRecorder()
 			req, _ := http.NewRequestWithContext(
@@ -935,7 +1119,7 @@ func TestAddFirmwareMembers(t *testing.T) {
 				Members: []FirmwareInventoryMember{},
 			}
 
-			addFirmwareMembers(collection, "test-id", tt.versionInfo)
+			addFirmwareMembers(collection, DefaultRedfishBasePath, "test-id", tt.versionInfo)
 
 			assert.Equal(t, tt.expectedCount, len(collection.Members))
 
@@ -1023,7 +1207,7 @@ func TestGetFirmwareItem(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			result := getFirmwareItem("test-system", tt.firmwareID, versionInfo, hwInfo, mockLogger)
+			result := getFirmwareItem(DefaultRedfishBasePath, "c0ffee00-1234-4abc-9def-0123456789ab", tt.firmwareID, versionInfo, hwInfo, mockLogger)
 
 			if tt.expectNil {
 				assert.Nil(t, result)
@@ -1045,7 +1229,7 @@ func TestCreateAMTOemSection(t *testing.T) {
 		VendorID:         "8086",
 	}
 
-	result := createAMTOemSection(versionInfo, "test-system")
+	result := createAMTOemSection(versionInfo, "c0ffee00-1234-4abc-9def-0123456789ab")
 
 	require.Contains(t, result, "Intel")
 	intel, ok := result["Intel"].(map[string]interface{})
@@ -1058,3 +1242,517 @@ func TestCreateAMTOemSection(t *testing.T) {
 	assert.Equal(t, "16392", intel["SKU"])
 	assert.Equal(t, "8086", intel["VendorID"])
 }
+
+func TestFirmwareCacheControl(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		cacheSeconds   int
+		expectedHeader string
+	}{
+		{name: "default", cacheSeconds: 0, expectedHeader: "max-age=300"},
+		{name: "overridden", cacheSeconds: 60, expectedHeader: "max-age=60"},
+		{name: "one day", cacheSeconds: maxFirmwareCacheSeconds, expectedHeader: "max-age=86400"},
+		{name: "negative falls back to default", cacheSeconds: -1, expectedHeader: "max-age=300"},
+		{name: "above one day falls back to default", cacheSeconds: maxFirmwareCacheSeconds + 1, expectedHeader: "max-age=300"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().
+				GetVersion(gomock.Any(), "c0ffee00-1234-4abc-9def-0123456789ab").
+				Return(dto.Version{}, dtov2.Version{}, nil).
+				Times(2)
+			mockFeature.EXPECT().
+				GetHardwareInfo(gomock.Any(), "c0ffee00-1234-4abc-9def-0123456789ab").
+				Return(dto.HardwareInfo{}, nil).
+				AnyTimes()
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+			cfg := &config.Config{}
+			cfg.Redfish.FirmwareCacheSeconds = tt.cacheSeconds
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			NewFirmwareRoutes(router.Group("/redfish/v1/Systems"), mockFeature, cfg, mockLogger)
+
+			for _, path := range []string{
+				"/redfish/v1/Systems/c0ffee00-1234-4abc-9def-0123456789ab/FirmwareInventory/BIOS",
+				"/redfish/v1/Systems/c0ffee00-1234-4abc-9def-0123456789ab/FirmwareInventory",
+			} {
+				w := httptest.NewRecorder()
+				req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, path, http.NoBody)
+
+				router.ServeHTTP(w, req)
+
+				assert.Equal(t, http.StatusOK, w.Code, path)
+				assert.Equal(t, tt.expectedHeader, w.Header().Get("Cache-Control"), path)
+			}
+		})
+	}
+}
+
+func TestFirmwareIfModifiedSince(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		ifModifiedSince string
+		expectedStatus  int
+	}{
+		{name: "no header", expectedStatus: http.StatusOK},
+		{name: "future timestamp", ifModifiedSince: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), expectedStatus: http.StatusNotModified},
+		{name: "past timestamp", ifModifiedSince: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), expectedStatus: http.StatusOK},
+		{name: "unparsable timestamp", ifModifiedSince: "yesterday", expectedStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().
+				GetVersion(gomock.Any(), "c0ffee00-1234-4abc-9def-0123456789ab").
+				Return(dto.Version{}, dtov2.Version{}, nil).
+				Times(2)
+			mockFeature.EXPECT().
+				GetHardwareInfo(gomock.Any(), "c0ffee00-1234-4abc-9def-0123456789ab").
+				Return(dto.HardwareInfo{}, nil).
+				AnyTimes()
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			NewFirmwareRoutes(router.Group("/redfish/v1/Systems"), mockFeature, nil, mockLogger)
+
+			for _, path := range []string{
+				"/redfish/v1/Systems/c0ffee00-1234-4abc-9def-0123456789ab/FirmwareInventory/BIOS",
+				"/redfish/v1/Systems/c0ffee00-1234-4abc-9def-0123456789ab/FirmwareInventory",
+			} {
+				w := httptest.NewRecorder()
+				req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, path, http.NoBody)
+
+				if tt.ifModifiedSince != "" {
+					req.Header.Set("If-Modified-Since", tt.ifModifiedSince)
+				}
+
+				router.ServeHTTP(w, req)
+
+				assert.Equal(t, tt.expectedStatus, w.Code, path)
+				assert.NotEmpty(t, w.Header().Get("Last-Modified"), path)
+				assert.NotEmpty(t, w.Header().Get("ETag"), path)
+
+				if tt.expectedStatus == http.StatusNotModified {
+					assert.Empty(t, w.Body.String(), path)
+				}
+			}
+		})
+	}
+}
+
+func TestFirmwareComponents(t *testing.T) {
+	t.Parallel()
+
+	versionInfo := dtov2.Version{AMT: "15.0.25", Flash: "1.2.3", Netstack: "2.3.4", AMTApps: "3.4.5"}
+	biosInfo := dto.HardwareInfo{
+		CIMBIOSElement: dto.CIMResponse{Response: map[string]interface{}{"Version": "BIOS-1.0.0"}},
+	}
+
+	tests := []struct {
+		name            string
+		components      []string
+		expectedMembers []string
+		hidden          string
+	}{
+		{
+			name:            "all components by default",
+			expectedMembers: []string{"AMT", "Flash", "Netstack", "AMTApps", "BIOS"},
+		},
+		{
+			name:            "Netstack hidden",
+			components:      []string{"AMT", "Flash", "AMTApps", "BIOS"},
+			expectedMembers: []string{"AMT", "Flash", "AMTApps", "BIOS"},
+			hidden:          "Netstack",
+		},
+		{
+			name:            "BIOS hidden skips the hardware info",
+			components:      []string{"AMT"},
+			expectedMembers: []string{"AMT"},
+			hidden:          "BIOS",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().GetVersion(gomock.Any(), testSystemID).Return(dto.Version{}, versionInfo, nil)
+
+			if slices.Contains(tt.expectedMembers, biosID) {
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemID).Return(biosInfo, nil)
+			}
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+			cfg := &config.Config{}
+			cfg.Redfish.FirmwareComponents = tt.components
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			NewFirmwareRoutes(router.Group("/redfish/v1/Systems"), mockFeature, cfg, mockLogger)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet,
+				"/redfish/v1/Systems/"+testSystemID+"/FirmwareInventory", http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var collection FirmwareInventoryCollection
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &collection))
+
+			members := make([]string, 0, len(collection.Members))
+			for _, member := range collection.Members {
+				members = append(members, path.Base(member.ODataID))
+			}
+
+			assert.Equal(t, tt.expectedMembers, members)
+			assert.Equal(t, len(tt.expectedMembers), collection.MembersCount)
+
+			if tt.hidden == "" {
+				return
+			}
+
+			w = httptest.NewRecorder()
+			req, _ = http.NewRequestWithContext(context.Background(), http.MethodGet,
+				"/redfish/v1/Systems/"+testSystemID+"/FirmwareInventory/"+tt.hidden, http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusNotFound, w.Code)
+			assert.Contains(t, w.Body.String(), BaseResourceNotFoundID)
+		})
+	}
+}
+
+func TestFirmwareCollectionHardwareInfo(t *testing.T) {
+	t.Parallel()
+
+	biosInfo := dto.HardwareInfo{
+		CIMBIOSElement: dto.CIMResponse{Response: map[string]interface{}{"Version": "BIOS-1.0.0"}},
+	}
+
+	tests := []struct {
+		name            string
+		setupMocks      func(*mocks.MockDeviceManagementFeature)
+		expectedMembers int
+	}{
+		{
+			name: "happy path reads hardware info once",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature) {
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), "c0ffee00-1234-4abc-9def-0123456789ab").Return(biosInfo, nil).Times(1)
+			},
+			expectedMembers: 1,
+		},
+		{
+			name: "transient connection error is retried once",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature) {
+				gomock.InOrder(
+					mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), "c0ffee00-1234-4abc-9def-0123456789ab").
+						Return(dto.HardwareInfo{}, fmt.Errorf("read tcp 10.0.0.5:16993: connection reset by peer")),
+					mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), "c0ffee00-1234-4abc-9def-0123456789ab").Return(biosInfo, nil),
+				)
+			},
+			expectedMembers: 1,
+		},
+		{
+			name: "persistent connection error gives up after one retry",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature) {
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), "c0ffee00-1234-4abc-9def-0123456789ab").
+					Return(dto.HardwareInfo{}, fmt.Errorf("dial tcp 10.0.0.5:16993: connection refused")).Times(2)
+			},
+			expectedMembers: 0,
+		},
+		{
+			name: "non-transient error is not retried",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature) {
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), "c0ffee00-1234-4abc-9def-0123456789ab").
+					Return(dto.HardwareInfo{}, fmt.Errorf("hardware info not available")).Times(1)
+			},
+			expectedMembers: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			tt.setupMocks(mockFeature)
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+			gin.SetMode(gin.TestMode)
+
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request, _ = http.NewRequestWithContext(context.Background(), http.MethodGet, "/", http.NoBody)
+
+			start := time.Now()
+			collection, _ := buildFirmwareCollection(mockFeature, mockLogger, c, "c0ffee00-1234-4abc-9def-0123456789ab", dto.Version{}, firmwareOptions{})
+
+			assert.Less(t, time.Since(start), 100*time.Millisecond, "no unconditional delay before reading hardware info")
+			assert.Equal(t, tt.expectedMembers, collection.MembersCount)
+		})
+	}
+}
+
+func TestFirmwareVersionErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedID     string
+	}{
+		{name: "system not found", err: devices.ErrNotFound, expectedStatus: http.StatusNotFound, expectedID: BaseResourceNotFoundID},
+		{name: "unreachable device", err: errors.New("dial tcp 10.0.0.5:16993: connection refused"), expectedStatus: http.StatusBadGateway, expectedID: BaseErrorMessageID},
+		{name: "device timeout", err: context.DeadlineExceeded, expectedStatus: http.StatusGatewayTimeout, expectedID: BaseErrorMessageID},
+		{name: "overloaded backend", err: fmt.Errorf("%w: too many sessions", consoleerrors.ErrServiceOverloaded), expectedStatus: http.StatusServiceUnavailable, expectedID: BaseErrorMessageID},
+		{name: "other failure", err: errors.New("unexpected wsman response"), expectedStatus: http.StatusInternalServerError, expectedID: BaseErrorMessageID},
+	}
+
+	// BIOS is hidden, so the collection has no hardware info to fall back on
+	opts := firmwareOptions{cacheSeconds: DefaultFirmwareCacheSeconds, components: []string{"AMT"}}
+
+	for _, tt := range tests {
+		for _, path := range []string{"/redfish/v1/Systems/" + testSystemID + "/FirmwareInventory", "/redfish/v1/Systems/" + testSystemID + "/FirmwareInventory/AMT"} {
+			t.Run(tt.name+" "+path, func(t *testing.T) {
+				t.Parallel()
+
+				ctrl := gomock.NewController(t)
+				t.Cleanup(ctrl.Finish)
+
+				mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+				mockFeature.EXPECT().GetVersion(gomock.Any(), testSystemID).Return(dto.Version{}, dtov2.Version{}, tt.err)
+
+				mockLogger := mocks.NewMockLogger(ctrl)
+				mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+				gin.SetMode(gin.TestMode)
+				router := gin.New()
+				router.GET("/redfish/v1/Systems/:id/FirmwareInventory", getFirmwareInventoryCollectionHandler(mockFeature, opts, mockLogger))
+				router.GET("/redfish/v1/Systems/:id/FirmwareInventory/:firmwareId", getFirmwareInventoryInstanceHandler(mockFeature, opts, mockLogger))
+
+				w := httptest.NewRecorder()
+				req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, path, http.NoBody)
+
+				router.ServeHTTP(w, req)
+
+				assert.Equal(t, tt.expectedStatus, w.Code)
+				assert.Contains(t, w.Body.String(), tt.expectedID)
+			})
+		}
+	}
+}
+
+func TestFirmwareVerboseLogging(t *testing.T) {
+	t.Parallel()
+
+	hwInfo := dto.HardwareInfo{
+		CIMBIOSElement: dto.CIMResponse{Response: map[string]interface{}{"Version": "BIOS-1.0.0"}},
+		CIMChassis:     dto.CIMResponse{Response: map[string]interface{}{"SerialNumber": "SN-12345"}},
+	}
+
+	tests := []struct {
+		name    string
+		verbose bool
+	}{
+		{name: "summary only by default", verbose: false},
+		{name: "full dump when verbose", verbose: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().GetVersion(gomock.Any(), "c0ffee00-1234-4abc-9def-0123456789ab").Return(dto.Version{}, dtov2.Version{}, nil).Times(2)
+			mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), "c0ffee00-1234-4abc-9def-0123456789ab").Return(hwInfo, nil).Times(2)
+
+			var dumps []string
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes().Do(func(msg string, args ...interface{}) {
+				assert.NotContains(t, fmt.Sprintf(msg, args...), "SN-12345")
+			})
+			mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes().Do(func(msg interface{}, args ...interface{}) {
+				format, _ := msg.(string)
+				dumps = append(dumps, fmt.Sprintf(format, args...))
+			})
+
+			cfg := &config.Config{}
+			cfg.Redfish.VerboseLogging = tt.verbose
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			NewFirmwareRoutes(router.Group("/redfish/v1/Systems"), mockFeature, cfg, mockLogger)
+
+			for _, path := range []string{
+				"/redfish/v1/Systems/c0ffee00-1234-4abc-9def-0123456789ab/FirmwareInventory",
+				"/redfish/v1/Systems/c0ffee00-1234-4abc-9def-0123456789ab/FirmwareInventory/BIOS",
+			} {
+				w := httptest.NewRecorder()
+				req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, path, http.NoBody)
+
+				router.ServeHTTP(w, req)
+				require.Equal(t, http.StatusOK, w.Code, path)
+			}
+
+			if !tt.verbose {
+				assert.Empty(t, dumps)
+
+				return
+			}
+
+			require.Len(t, dumps, 2)
+
+			for _, dump := range dumps {
+				assert.Contains(t, dump, "SN-12345")
+			}
+		})
+	}
+}
+
+func TestHardwareComponentCount(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 0, hardwareComponentCount(dto.HardwareInfo{}))
+	assert.Equal(t, 2, hardwareComponentCount(dto.HardwareInfo{
+		CIMBIOSElement: dto.CIMResponse{Response: map[string]interface{}{"Version": "1.0"}},
+		CIMProcessor:   dto.CIMResponse{Responses: []interface{}{map[string]interface{}{}}},
+	}))
+}
+
+func TestFirmwareRoutesRequireAuth(t *testing.T) {
+	t.Parallel()
+
+	cfg := createTestConfig(false)
+
+	tests := []struct {
+		name           string
+		path           string
+		authHeader     string
+		expectedStatus int
+	}{
+		{
+			name:           "collection without token",
+			path:           "/redfish/v1/Systems/" + testSystemID + "/FirmwareInventory",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "instance without token",
+			path:           "/redfish/v1/Systems/" + testSystemID + "/FirmwareInventory/AMT",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "instance with invalid token",
+			path:           "/redfish/v1/Systems/" + testSystemID + "/FirmwareInventory/AMT",
+			authHeader:     "Bearer not-a-jwt",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "instance with valid token",
+			path:           "/redfish/v1/Systems/" + testSystemID + "/FirmwareInventory/AMT",
+			authHeader:     createValidJWT(cfg.JWTKey),
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			if tt.expectedStatus == http.StatusOK {
+				mockFeature.EXPECT().
+					GetVersion(gomock.Any(), testSystemID).
+					Return(dto.Version{}, dtov2.Version{AMT: "16.1.25"}, nil)
+			}
+
+			// Auth is installed by NewServiceRootRoutes, so the Systems routes must share its group
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			redfish := router.Group("/redfish/v1")
+			NewServiceRootRoutes(redfish, nil, cfg, testServiceCapabilities, logger.New("test"))
+			NewSystemsRoutes(redfish, mockFeature, cfg, logger.New("test"))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, tt.path, http.NoBody)
+
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusUnauthorized {
+				assert.Contains(t, w.Body.String(), BaseNoValidSessionID)
+			}
+		})
+	}
+}
+
+func TestFirmwareCollectionETag(t *testing.T) {
+	t.Parallel()
+
+	member := func(id string) FirmwareInventoryMember {
+		return FirmwareInventoryMember{ODataID: "/redfish/v1/Systems/" + testSystemID + "/FirmwareInventory/" + id}
+	}
+
+	amtAndBIOS := []FirmwareInventoryMember{member("AMT"), member("BIOS")}
+	etag := firmwareCollectionETag(testSystemID, amtAndBIOS)
+
+	assert.Equal(t, etag, firmwareCollectionETag(testSystemID, amtAndBIOS), "stable across calls")
+	assert.Equal(t, etag, firmwareCollectionETag(testSystemID, []FirmwareInventoryMember{member("BIOS"), member("AMT")}), "independent of member order")
+	assert.NotEqual(t, etag, firmwareCollectionETag(testSystemID, []FirmwareInventoryMember{member("AMT"), member("Flash")}), "same count, different members")
+	assert.NotEqual(t, etag, firmwareCollectionETag(testSystemID, []FirmwareInventoryMember{member("AMT")}), "member removed")
+	assert.NotEqual(t, etag, firmwareCollectionETag("8f14e45f-ceea-467a-9575-f3a1d2b1c6a0", amtAndBIOS), "different system")
+}
@@ -0,0 +1,158 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements the hardware info cache for the Redfish API v1.
+package v1
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/device-management-toolkit/console/config"
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+// Hardware info cache defaults used when the configuration leaves them unset
+const (
+	DefaultHardwareInfoCacheTTL  = 10 * time.Second
+	DefaultHardwareInfoCacheSize = 256
+)
+
+// hardwareInfoEntry is a cached hardware info read
+type hardwareInfoEntry struct {
+	info    dto.HardwareInfo
+	expires time.Time
+}
+
+// hardwareInfoCache wraps a devices.Feature so that GetHardwareInfo results are cached per system
+// id for a short time. Concurrent reads of the same system share one backend call. Failed reads are
+// not cached. All other methods go straight to the wrapped feature.
+type hardwareInfoCache struct {
+	devices.Feature
+
+	ttl        time.Duration
+	maxEntries int
+	timeout    time.Duration
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]hardwareInfoEntry
+	group   singleflight.Group
+}
+
+func newHardwareInfoCache(d devices.Feature, cfg *config.Config) *hardwareInfoCache {
+	ttl, size := hardwareInfoCacheLimits(cfg)
+
+	return &hardwareInfoCache{
+		Feature:    d,
+		ttl:        ttl,
+		maxEntries: size,
+		timeout:    deviceTimeout(cfg),
+		now:        time.Now,
+		entries:    map[string]hardwareInfoEntry{},
+	}
+}
+
+// GetHardwareInfo returns the cached hardware info of guid, reading it from the device when the
+// entry is missing or expired. The shared read is detached from the caller that started it and
+// bounded by the device timeout, so one client going away does not fail the others; a caller
+// whose context ends only stops waiting for it.
+func (h *hardwareInfoCache) GetHardwareInfo(ctx context.Context, guid string) (dto.HardwareInfo, error) {
+	if info, ok := h.lookup(guid); ok {
+		return info, nil
+	}
+
+	ch := h.group.DoChan(guid, func() (any, error) {
+		readCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), h.timeout)
+		defer cancel()
+
+		info, err := h.Feature.GetHardwareInfo(readCtx, guid)
+		if err == nil {
+			h.store(guid, info)
+		}
+
+		return info, err
+	})
+
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return dto.HardwareInfo{}, res.Err
+		}
+
+		info, _ := res.Val.(dto.HardwareInfo)
+
+		return info, nil
+	case <-ctx.Done():
+		return dto.HardwareInfo{}, ctx.Err()
+	}
+}
+
+// lookup returns the unexpired entry of guid
+func (h *hardwareInfoCache) lookup(guid string) (dto.HardwareInfo, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	entry, ok := h.entries[guid]
+	if !ok || !h.now().Before(entry.expires) {
+		return dto.HardwareInfo{}, false
+	}
+
+	return entry.info, true
+}
+
+// store caches info for guid. When the cache is full, expired entries are dropped first and then
+// the entry closest to expiry.
+func (h *hardwareInfoCache) store(guid string, info dto.HardwareInfo) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+
+	if _, ok := h.entries[guid]; !ok && len(h.entries) >= h.maxEntries {
+		oldest := ""
+
+		for key, entry := range h.entries {
+			if !now.Before(entry.expires) {
+				delete(h.entries, key)
+
+				continue
+			}
+
+			if oldest == "" || entry.expires.Before(h.entries[oldest].expires) {
+				oldest = key
+			}
+		}
+
+		if len(h.entries) >= h.maxEntries {
+			delete(h.entries, oldest)
+		}
+	}
+
+	h.entries[guid] = hardwareInfoEntry{info: info, expires: now.Add(h.ttl)}
+}
+
+// hardwareInfoCacheLimits returns the configured cache TTL and size, falling back to the defaults
+func hardwareInfoCacheLimits(cfg *config.Config) (ttl time.Duration, size int) {
+	ttl, size = DefaultHardwareInfoCacheTTL, DefaultHardwareInfoCacheSize
+
+	if cfg == nil {
+		return ttl, size
+	}
+
+	if cfg.Redfish.HardwareInfoCacheTTL > 0 {
+		ttl = cfg.Redfish.HardwareInfoCacheTTL
+	}
+
+	if cfg.Redfish.HardwareInfoCacheSize > 0 {
+		size = cfg.Redfish.HardwareInfoCacheSize
+	}
+
+	return ttl, size
+}
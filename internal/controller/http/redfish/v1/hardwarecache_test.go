@@ -0,0 +1,308 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/config"
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+)
+
+var testHardwareInfo = dto.HardwareInfo{
+	CIMChassis: dto.CIMResponse{Response: map[string]any{"Manufacturer": "Intel Corporation"}},
+}
+
+func TestHardwareInfoCacheReusesEntries(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(testHardwareInfo, nil).Times(2)
+
+	cache := newHardwareInfoCache(mockFeature, nil)
+	cache.now = func() time.Time { return now }
+
+	for range 3 {
+		info, err := cache.GetHardwareInfo(context.Background(), testSystemGUID)
+		require.NoError(t, err)
+		assert.Equal(t, testHardwareInfo, info)
+	}
+
+	now = now.Add(DefaultHardwareInfoCacheTTL)
+
+	info, err := cache.GetHardwareInfo(context.Background(), testSystemGUID)
+	require.NoError(t, err)
+	assert.Equal(t, testHardwareInfo, info, "an expired entry is read again")
+}
+
+func TestHardwareInfoCacheCoalescesConcurrentReads(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	const callers = 10
+
+	release := make(chan struct{})
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).
+		DoAndReturn(func(context.Context, string) (dto.HardwareInfo, error) {
+			<-release
+
+			return testHardwareInfo, nil
+		}).Times(1)
+
+	cache := newHardwareInfoCache(mockFeature, nil)
+
+	var (
+		started sync.WaitGroup
+		done    sync.WaitGroup
+	)
+
+	results := make([]dto.HardwareInfo, callers)
+
+	for i := range callers {
+		started.Add(1)
+		done.Add(1)
+
+		go func() {
+			defer done.Done()
+
+			started.Done()
+
+			info, err := cache.GetHardwareInfo(context.Background(), testSystemGUID)
+			assert.NoError(t, err)
+
+			results[i] = info
+		}()
+	}
+
+	started.Wait()
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	done.Wait()
+
+	for _, info := range results {
+		assert.Equal(t, testHardwareInfo, info)
+	}
+}
+
+func TestHardwareInfoCacheSkipsFailures(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	errUnreachable := errors.New("unreachable")
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	gomock.InOrder(
+		mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(dto.HardwareInfo{}, errUnreachable),
+		mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(testHardwareInfo, nil),
+	)
+
+	cache := newHardwareInfoCache(mockFeature, nil)
+
+	_, err := cache.GetHardwareInfo(context.Background(), testSystemGUID)
+	require.ErrorIs(t, err, errUnreachable)
+
+	info, err := cache.GetHardwareInfo(context.Background(), testSystemGUID)
+	require.NoError(t, err)
+	assert.Equal(t, testHardwareInfo, info)
+}
+
+func TestHardwareInfoCacheEviction(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), "a").Return(testHardwareInfo, nil).Times(2)
+	mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), "b").Return(testHardwareInfo, nil).Times(1)
+	mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), "c").Return(testHardwareInfo, nil).Times(1)
+
+	cfg := &config.Config{}
+	cfg.Redfish.HardwareInfoCacheSize = 2
+
+	cache := newHardwareInfoCache(mockFeature, cfg)
+	cache.now = func() time.Time { return now }
+
+	for _, guid := range []string{"a", "b"} {
+		_, err := cache.GetHardwareInfo(context.Background(), guid)
+		require.NoError(t, err)
+
+		now = now.Add(time.Second)
+	}
+
+	// a full cache drops the entry closest to expiry, a
+	_, err := cache.GetHardwareInfo(context.Background(), "c")
+	require.NoError(t, err)
+	assert.Len(t, cache.entries, 2)
+
+	for _, guid := range []string{"b", "c", "a"} {
+		_, err := cache.GetHardwareInfo(context.Background(), guid)
+		require.NoError(t, err)
+	}
+}
+
+func TestHardwareInfoCacheCallerContext(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).
+		DoAndReturn(func(context.Context, string) (dto.HardwareInfo, error) {
+			<-release
+
+			return testHardwareInfo, nil
+		}).AnyTimes()
+
+	cache := newHardwareInfoCache(mockFeature, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := cache.GetHardwareInfo(ctx, testSystemGUID)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestHardwareInfoCacheSharedReadOutlivesFirstCaller(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).
+		DoAndReturn(func(ctx context.Context, _ string) (dto.HardwareInfo, error) {
+			close(started)
+			<-release
+
+			if err := ctx.Err(); err != nil {
+				return dto.HardwareInfo{}, err
+			}
+
+			return testHardwareInfo, nil
+		}).Times(1)
+
+	cache := newHardwareInfoCache(mockFeature, nil)
+
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+
+	go func() {
+		_, err := cache.GetHardwareInfo(firstCtx, testSystemGUID)
+		firstErr <- err
+	}()
+
+	<-started
+
+	second := make(chan dto.HardwareInfo, 1)
+	secondErr := make(chan error, 1)
+
+	go func() {
+		info, err := cache.GetHardwareInfo(context.Background(), testSystemGUID)
+		second <- info
+		secondErr <- err
+	}()
+
+	// the first client disconnects while the shared read is in flight
+	cancelFirst()
+	require.ErrorIs(t, <-firstErr, context.Canceled)
+
+	close(release)
+
+	require.NoError(t, <-secondErr)
+	assert.Equal(t, testHardwareInfo, <-second)
+}
+
+func TestHardwareInfoCacheSharedReadTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).
+		DoAndReturn(func(ctx context.Context, _ string) (dto.HardwareInfo, error) {
+			<-ctx.Done()
+
+			return dto.HardwareInfo{}, ctx.Err()
+		}).Times(1)
+
+	cfg := &config.Config{}
+	cfg.Redfish.DeviceTimeout = 20 * time.Millisecond
+
+	cache := newHardwareInfoCache(mockFeature, cfg)
+
+	_, err := cache.GetHardwareInfo(context.Background(), testSystemGUID)
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "the detached read is still bounded by the device timeout")
+}
+
+func TestHardwareInfoCacheDelegatesOtherMethods(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().GetPowerState(gomock.Any(), testSystemGUID).Return(dto.PowerState{PowerState: cimPowerOn}, nil).Times(2)
+
+	cache := newHardwareInfoCache(mockFeature, nil)
+
+	for range 2 {
+		ps, err := cache.GetPowerState(context.Background(), testSystemGUID)
+		require.NoError(t, err)
+		assert.Equal(t, cimPowerOn, ps.PowerState)
+	}
+}
+
+func TestHardwareInfoCacheLimits(t *testing.T) {
+	t.Parallel()
+
+	ttl, size := hardwareInfoCacheLimits(nil)
+	assert.Equal(t, DefaultHardwareInfoCacheTTL, ttl)
+	assert.Equal(t, DefaultHardwareInfoCacheSize, size)
+
+	cfg := &config.Config{}
+
+	ttl, size = hardwareInfoCacheLimits(cfg)
+	assert.Equal(t, DefaultHardwareInfoCacheTTL, ttl)
+	assert.Equal(t, DefaultHardwareInfoCacheSize, size)
+
+	cfg.Redfish.HardwareInfoCacheTTL = time.Minute
+	cfg.Redfish.HardwareInfoCacheSize = 16
+
+	ttl, size = hardwareInfoCacheLimits(cfg)
+	assert.Equal(t, time.Minute, ttl)
+	assert.Equal(t, 16, size)
+}
@@ -0,0 +1,48 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements Redfish API v1 HEAD request handling.
+package v1
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// headResponseWriter discards the response body while counting its length
+type headResponseWriter struct {
+	gin.ResponseWriter
+	length int
+}
+
+func (w *headResponseWriter) Write(data []byte) (int, error) {
+	w.length += len(data)
+
+	return len(data), nil
+}
+
+func (w *headResponseWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// headHandler serves HEAD by running the GET handler with the body suppressed. The response
+// carries the same status and headers as GET, with Content-Length set to the length of the
+// body GET would have sent.
+func headHandler(get gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		original := c.Writer
+		writer := &headResponseWriter{ResponseWriter: original}
+		c.Writer = writer
+
+		defer func() { c.Writer = original }()
+
+		get(c)
+
+		if writer.length > 0 && !original.Written() {
+			original.Header().Set("Content-Length", strconv.Itoa(writer.length))
+		}
+	}
+}
@@ -0,0 +1,112 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	dtov2 "github.com/device-management-toolkit/console/internal/entity/dto/v2"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestHeadHandler(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	get := func(c *gin.Context) {
+		SetRedfishHeaders(c)
+		c.Header("ETag", `W/"abc"`)
+		c.JSON(http.StatusOK, map[string]any{"Id": "resource"})
+	}
+	router.GET("/resource", get)
+	router.HEAD("/resource", headHandler(get))
+
+	getRecorder := httptest.NewRecorder()
+	getReq, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/resource", http.NoBody)
+	router.ServeHTTP(getRecorder, getReq)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodHead, "/resource", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Body.String())
+	assert.Equal(t, `W/"abc"`, w.Header().Get("ETag"))
+	assert.Equal(t, "4.0", w.Header().Get("OData-Version"))
+	assert.Equal(t, strconv.Itoa(getRecorder.Body.Len()), w.Header().Get("Content-Length"))
+}
+
+func TestHeadRoutes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		path       string
+		expectETag bool
+	}{
+		{name: "ServiceRoot", path: "/redfish/v1/"},
+		{name: "Systems collection", path: "/redfish/v1/Systems"},
+		{name: "Systems instance", path: "/redfish/v1/Systems/" + testSystemID, expectETag: true},
+		{name: "FirmwareInventory collection", path: "/redfish/v1/Systems/" + testSystemID + "/FirmwareInventory", expectETag: true},
+		{name: "FirmwareInventory instance", path: "/redfish/v1/Systems/" + testSystemID + "/FirmwareInventory/BIOS", expectETag: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), "").
+				Return([]dto.Device{{GUID: testSystemID}}, nil).AnyTimes()
+			mockFeature.EXPECT().GetPowerState(gomock.Any(), testSystemID).
+				Return(dto.PowerState{PowerState: cimPowerOn}, nil).AnyTimes()
+			mockFeature.EXPECT().GetVersion(gomock.Any(), testSystemID).
+				Return(dto.Version{}, dtov2.Version{}, nil).AnyTimes()
+			mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemID).
+				Return(dto.HardwareInfo{}, nil).AnyTimes()
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			v1 := router.Group("/redfish/v1")
+			NewServiceRootRoutes(v1, nil, createTestConfig(true), testServiceCapabilities, logger.New("test"))
+			NewSystemsRoutes(v1, mockFeature, nil, mockLogger)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodHead, tt.path, http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			assert.Empty(t, w.Body.String())
+			assert.Equal(t, "4.0", w.Header().Get("OData-Version"))
+			assert.NotEmpty(t, w.Header().Get("Content-Length"))
+
+			if tt.expectETag {
+				assert.NotEmpty(t, w.Header().Get("ETag"))
+			}
+		})
+	}
+}
@@ -0,0 +1,325 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements Redfish API v1 LogService resources.
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/config"
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// LogService constants
+const (
+	eventLogID     = "EventLog"
+	maxLogEntries  = 100
+	clearLogAction = "LogService.ClearLog"
+	// AMT event log severities as decoded by the message log service
+	amtSeverityCritical       = "Critical condition"
+	amtSeverityNonRecoverable = "Non-recoverable condition"
+	amtSeverityNonCritical    = "Non-critical condition"
+	healthOK                  = "OK"
+	healthWarning             = "Warning"
+	healthCritical            = "Critical"
+	// amtEventTimeLayout is the time.Time String() layout the devices feature uses for event times
+	amtEventTimeLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+)
+
+// LogEntryCollection represents a Redfish LogEntry collection
+type LogEntryCollection struct {
+	ODataID      string     `json:"@odata.id"`
+	ODataType    string     `json:"@odata.type"`
+	Name         string     `json:"Name"`
+	Members      []LogEntry `json:"Members"`
+	MembersCount int        `json:"Members@odata.count"`
+	NextLink     string     `json:"Members@odata.nextLink,omitempty"`
+}
+
+// LogEntry represents a single AMT event log record
+type LogEntry struct {
+	ODataID   string `json:"@odata.id"`
+	ODataType string `json:"@odata.type"`
+	ID        string `json:"Id"`
+	Name      string `json:"Name"`
+	EntryType string `json:"EntryType"`
+	Created   string `json:"Created"`
+	Severity  string `json:"Severity"`
+	Message   string `json:"Message"`
+}
+
+// NewLogServiceRoutes registers Redfish LogService routes for Systems
+// It exposes:
+// - GET /redfish/v1/Systems/:id/LogServices
+// - GET /redfish/v1/Systems/:id/LogServices/EventLog
+// - GET /redfish/v1/Systems/:id/LogServices/EventLog/Entries
+// - GET /redfish/v1/Systems/:id/LogServices/EventLog/Entries/:entryId
+// - POST /redfish/v1/Systems/:id/LogServices/EventLog/Actions/LogService.ClearLog
+func NewLogServiceRoutes(systems *gin.RouterGroup, d devices.Feature, store *TaskStore, cfg *config.Config, l logger.Interface) {
+	systems.GET(":id/LogServices", getLogServicesCollectionHandler)
+	systems.GET(":id/LogServices/"+eventLogID, getEventLogServiceHandler)
+	systems.GET(":id/LogServices/"+eventLogID+"/Entries", getEventLogEntriesHandler(d, cfg, l))
+	systems.GET(":id/LogServices/"+eventLogID+"/Entries/:entryId", getEventLogEntryHandler(d, cfg, l))
+	systems.POST(":id/LogServices/"+eventLogID+"/Actions/"+clearLogAction, RequireRole(roleOperator), RedfishRateLimitMiddleware(cfg), postClearLogHandler(d, store, cfg, l))
+
+	l.Info("Registered Redfish LogService routes under %s", systems.BasePath())
+}
+
+func logServicesPath(base, systemID string) string {
+	return systemPath(base, systemID) + "/LogServices"
+}
+
+func eventLogEntriesPath(base, systemID string) string {
+	return logServicesPath(base, systemID) + "/" + eventLogID + "/Entries"
+}
+
+func getLogServicesCollectionHandler(c *gin.Context) {
+	systemID := c.Param("id")
+
+	if !validateSystemID(c, systemID) {
+		return
+	}
+
+	SetRedfishHeaders(c)
+	c.JSON(http.StatusOK, map[string]any{
+		"@odata.type":         "#LogServiceCollection.LogServiceCollection",
+		"@odata.id":           logServicesPath(linkBase(c), systemID),
+		"Name":                "Log Service Collection",
+		"Members@odata.count": 1,
+		"Members": []any{
+			map[string]any{"@odata.id": logServicesPath(linkBase(c), systemID) + "/" + eventLogID},
+		},
+	})
+}
+
+func getEventLogServiceHandler(c *gin.Context) {
+	systemID := c.Param("id")
+
+	if !validateSystemID(c, systemID) {
+		return
+	}
+
+	servicePath := logServicesPath(linkBase(c), systemID) + "/" + eventLogID
+
+	SetRedfishHeaders(c)
+	c.JSON(http.StatusOK, map[string]any{
+		"@odata.type":     "#LogService.v1_1_0.LogService",
+		"@odata.id":       servicePath,
+		"Id":              eventLogID,
+		"Name":            "AMT Event Log",
+		"ServiceEnabled":  true,
+		"OverWritePolicy": "WrapsWhenFull",
+		"Entries":         map[string]any{"@odata.id": servicePath + "/Entries"},
+		"Actions": map[string]any{
+			"#" + clearLogAction: map[string]any{
+				"target": servicePath + "/Actions/" + clearLogAction,
+			},
+		},
+	})
+}
+
+// postClearLogHandler clears the AMT event log and answers with the finished Task.
+// Device failures are answered with the Redfish gateway errors rather than a Task.
+func postClearLogHandler(d devices.Feature, store *TaskStore, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+
+	return func(c *gin.Context) {
+		systemID := c.Param("id")
+
+		if !validateSystemID(c, systemID) {
+			return
+		}
+
+		task := store.Start("Clear event log of system " + systemID)
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		if err := d.ClearEventLog(ctx, systemID); err != nil {
+			store.Finish(task.ID, TaskStateException, TaskStatusCritical,
+				taskMessage(BaseErrorMessageID, "Clearing the event log of system "+systemID+" failed."))
+
+			var nfErr sqldb.NotFoundError
+			if errors.As(err, &nfErr) {
+				ResourceNotFoundError(c, "ComputerSystem", systemID)
+
+				return
+			}
+
+			l.Error(err, "http - redfish - ClearLog for %s [request %s]", systemID, requestID(c))
+			deviceCallError(c, err)
+
+			return
+		}
+
+		task, _ = store.Finish(task.ID, TaskStateCompleted, TaskStatusOK,
+			taskMessage(BaseSuccessMessageID, "The event log of system "+systemID+" was cleared."))
+
+		// The log was cleared within the request, so the finished Task is answered with 200 rather than 202
+		linked := task.linkedUnder(linkBase(c))
+
+		SetRedfishHeaders(c)
+		c.Header("Location", linked.ODataID)
+		c.JSON(http.StatusOK, linked)
+	}
+}
+
+// getEventLogEntriesHandler reads a page of the AMT event log. AMT record identifiers are 1-based,
+// so $skip=n starts at record n+1.
+func getEventLogEntriesHandler(d devices.Feature, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+	defaultTop := pageSize(cfg)
+
+	return func(c *gin.Context) {
+		systemID := c.Param("id")
+
+		if !validateSystemID(c, systemID) {
+			return
+		}
+
+		top, skip, ok := parsePaging(c, defaultTop, maxLogEntries)
+		if !ok {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		eventLogs, err := d.GetEventLog(ctx, skip+1, top, systemID)
+		if err != nil {
+			eventLogError(c, l, err, systemID)
+
+			return
+		}
+
+		entriesPath := eventLogEntriesPath(linkBase(c), systemID)
+		collection := LogEntryCollection{
+			ODataID:   entriesPath,
+			ODataType: "#LogEntryCollection.LogEntryCollection",
+			Name:      "AMT Event Log Entries",
+			Members:   buildLogEntries(entriesPath, skip, eventLogs.Records),
+		}
+		collection.MembersCount = len(collection.Members)
+
+		if eventLogs.HasMoreRecords && len(eventLogs.Records) >= top {
+			collection.NextLink = fmt.Sprintf("%s?%s=%d&%s=%d", entriesPath, queryTop, top, querySkip, skip+top)
+		}
+
+		SetRedfishHeaders(c)
+		c.JSON(http.StatusOK, collection)
+	}
+}
+
+// getEventLogEntryHandler reads the single AMT event log record an entry id of the Entries
+// collection names. Ids that are not positive numbers, or past the end of the log, are not found.
+func getEventLogEntryHandler(d devices.Feature, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+
+	return func(c *gin.Context) {
+		systemID := c.Param("id")
+		entryID := c.Param("entryId")
+
+		if !validateSystemID(c, systemID) {
+			return
+		}
+
+		index, err := strconv.Atoi(entryID)
+		if err != nil || index < 1 || strconv.Itoa(index) != entryID {
+			ResourceNotFoundError(c, "LogEntry", entryID)
+
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		eventLogs, err := d.GetEventLog(ctx, index, 1, systemID)
+		if err != nil {
+			eventLogError(c, l, err, systemID)
+
+			return
+		}
+
+		if len(eventLogs.Records) == 0 {
+			ResourceNotFoundError(c, "LogEntry", entryID)
+
+			return
+		}
+
+		entries := buildLogEntries(eventLogEntriesPath(linkBase(c), systemID), index-1, eventLogs.Records[:1])
+
+		SetRedfishHeaders(c)
+		c.JSON(http.StatusOK, entries[0])
+	}
+}
+
+// eventLogError answers a failed event log read, with ResourceNotFound for an unknown system
+func eventLogError(c *gin.Context, l logger.Interface, err error, systemID string) {
+	var nfErr sqldb.NotFoundError
+	if errors.As(err, &nfErr) {
+		ResourceNotFoundError(c, "ComputerSystem", systemID)
+
+		return
+	}
+
+	l.Error(err, "http - redfish - EventLog entries for %s [request %s]", systemID, requestID(c))
+	deviceCallError(c, err)
+}
+
+// buildLogEntries converts AMT event records to LogEntry resources numbered by their position in the log
+func buildLogEntries(entriesPath string, skip int, records []dto.EventLog) []LogEntry {
+	entries := make([]LogEntry, 0, len(records))
+
+	for i := range records {
+		record := &records[i]
+		id := strconv.Itoa(skip + i + 1)
+
+		entries = append(entries, LogEntry{
+			ODataID:   entriesPath + "/" + id,
+			ODataType: "#LogEntry.v1_4_0.LogEntry",
+			ID:        id,
+			Name:      "AMT Event " + id,
+			EntryType: "Event",
+			Created:   eventCreated(record.Time),
+			Severity:  eventSeverity(record.EventSeverity),
+			Message:   record.Description,
+		})
+	}
+
+	return entries
+}
+
+// eventSeverity maps an AMT event severity to a Redfish Health value
+func eventSeverity(severity string) string {
+	switch severity {
+	case amtSeverityCritical, amtSeverityNonRecoverable:
+		return healthCritical
+	case amtSeverityNonCritical:
+		return healthWarning
+	default:
+		return healthOK
+	}
+}
+
+// eventCreated converts an AMT event time to RFC 3339, keeping the original text if it cannot be parsed
+func eventCreated(eventTime string) string {
+	parsed, err := time.Parse(amtEventTimeLayout, eventTime)
+	if err != nil {
+		return eventTime
+	}
+
+	return parsed.UTC().Format(time.RFC3339)
+}
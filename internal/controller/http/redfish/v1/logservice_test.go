@@ -0,0 +1,427 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+func TestLogServiceResources(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		path         string
+		expectedBody []string
+	}{
+		{
+			name: "LogServices collection",
+			path: "/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/LogServices",
+			expectedBody: []string{
+				`"#LogServiceCollection.LogServiceCollection"`,
+				`"@odata.id":"/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/LogServices/EventLog"`,
+			},
+		},
+		{
+			name: "EventLog service",
+			path: "/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/LogServices/EventLog",
+			expectedBody: []string{
+				`"#LogService.v1_1_0.LogService"`,
+				`"Entries":{"@odata.id":"/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/LogServices/EventLog/Entries"}`,
+				`"#LogService.ClearLog":{"target":"/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/LogServices/EventLog/Actions/LogService.ClearLog"}`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).Times(1)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			NewLogServiceRoutes(router.Group("/redfish/v1/Systems"), mocks.NewMockDeviceManagementFeature(ctrl), NewTaskStore(), nil, mockLogger)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, tt.path, http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			for _, expected := range tt.expectedBody {
+				assert.Contains(t, w.Body.String(), expected)
+			}
+		})
+	}
+}
+
+func TestGetEventLogEntriesHandler(t *testing.T) {
+	t.Parallel()
+
+	eventTime := time.Date(2024, time.January, 6, 3, 25, 23, 0, time.UTC)
+	records := []dto.EventLog{
+		{EventSeverity: "Critical condition", Time: eventTime.String(), Description: "Authentication failed 10 times. The system may be under attack."},
+		{EventSeverity: "Non-critical condition", Time: eventTime.String(), Description: "Chassis intrusion"},
+		{EventSeverity: "Monitor", Time: eventTime.String(), Description: "PCI resource configuration"},
+	}
+
+	tests := []struct {
+		name             string
+		query            string
+		setupMocks       func(*mocks.MockDeviceManagementFeature, *mocks.MockLogger)
+		expectedStatus   int
+		expectedIDs      []string
+		expectedSeverity []string
+		expectedNextLink string
+		expectedBody     string
+	}{
+		{
+			name: "several entries",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetEventLog(gomock.Any(), 1, DefaultPageSize, testSystemID).
+					Return(dto.EventLogs{Records: records}, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedIDs:      []string{"1", "2", "3"},
+			expectedSeverity: []string{healthCritical, healthWarning, healthOK},
+		},
+		{
+			name: "empty log",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetEventLog(gomock.Any(), 1, DefaultPageSize, testSystemID).
+					Return(dto.EventLogs{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{},
+		},
+		{
+			name:  "paged entries",
+			query: "?$top=2&$skip=2",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetEventLog(gomock.Any(), 3, 2, testSystemID).
+					Return(dto.EventLogs{Records: records[:2], HasMoreRecords: true}, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedIDs:      []string{"3", "4"},
+			expectedSeverity: []string{healthCritical, healthWarning},
+			expectedNextLink: "/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/LogServices/EventLog/Entries?$top=2&$skip=4",
+		},
+		{
+			name:  "top above the default page size",
+			query: "?$top=80",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetEventLog(gomock.Any(), 1, 80, testSystemID).Return(dto.EventLogs{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{},
+		},
+		{
+			name:  "top clamped to the maximum",
+			query: "?$top=500",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetEventLog(gomock.Any(), 1, maxLogEntries, testSystemID).Return(dto.EventLogs{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{},
+		},
+		{
+			name:           "invalid top",
+			query:          "?$top=x",
+			setupMocks:     func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   BaseQueryParameterOutOfRangeID,
+		},
+		{
+			name: "unknown system",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetEventLog(gomock.Any(), 1, DefaultPageSize, testSystemID).
+					Return(dto.EventLogs{}, devices.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   BaseResourceNotFoundID,
+		},
+		{
+			name: "device failure",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().GetEventLog(gomock.Any(), 1, DefaultPageSize, testSystemID).
+					Return(dto.EventLogs{}, fmt.Errorf("wsman failure"))
+				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   BaseErrorMessageID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockLogger := mocks.NewMockLogger(ctrl)
+
+			tt.setupMocks(mockFeature, mockLogger)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/redfish/v1/Systems/:id/LogServices/EventLog/Entries", getEventLogEntriesHandler(mockFeature, nil, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet,
+				"/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/LogServices/EventLog/Entries"+tt.query, http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedBody)
+
+				return
+			}
+
+			var collection LogEntryCollection
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &collection))
+			assert.Equal(t, "#LogEntryCollection.LogEntryCollection", collection.ODataType)
+			assert.Equal(t, len(tt.expectedIDs), collection.MembersCount)
+			assert.Equal(t, tt.expectedNextLink, collection.NextLink)
+			require.Len(t, collection.Members, len(tt.expectedIDs))
+
+			for i, entry := range collection.Members {
+				assert.Equal(t, tt.expectedIDs[i], entry.ID)
+				assert.Equal(t, tt.expectedSeverity[i], entry.Severity)
+				assert.Equal(t, "2024-01-06T03:25:23Z", entry.Created)
+				assert.NotEmpty(t, entry.Message)
+			}
+		})
+	}
+}
+
+func TestGetEventLogEntryHandler(t *testing.T) {
+	t.Parallel()
+
+	eventTime := time.Date(2024, time.January, 6, 3, 25, 23, 0, time.UTC)
+	record := dto.EventLog{EventSeverity: "Non-critical condition", Time: eventTime.String(), Description: "Chassis intrusion"}
+	entriesPath := "/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/LogServices/EventLog/Entries"
+
+	tests := []struct {
+		name           string
+		entryID        string
+		setupMocks     func(*mocks.MockDeviceManagementFeature, *mocks.MockLogger)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:    "existing entry",
+			entryID: "4",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetEventLog(gomock.Any(), 4, 1, testSystemID).
+					Return(dto.EventLogs{Records: []dto.EventLog{record}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "past the end of the log",
+			entryID: "250",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetEventLog(gomock.Any(), 250, 1, testSystemID).Return(dto.EventLogs{}, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   BaseResourceNotFoundID,
+		},
+		{
+			name:           "zero",
+			entryID:        "0",
+			setupMocks:     func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   BaseResourceNotFoundID,
+		},
+		{
+			name:           "not a number",
+			entryID:        "latest",
+			setupMocks:     func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   BaseResourceNotFoundID,
+		},
+		{
+			name:           "leading zero",
+			entryID:        "04",
+			setupMocks:     func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   BaseResourceNotFoundID,
+		},
+		{
+			name:    "unknown system",
+			entryID: "1",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetEventLog(gomock.Any(), 1, 1, testSystemID).Return(dto.EventLogs{}, devices.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   BaseResourceNotFoundID,
+		},
+		{
+			name:    "device failure",
+			entryID: "1",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().GetEventLog(gomock.Any(), 1, 1, testSystemID).Return(dto.EventLogs{}, fmt.Errorf("wsman failure"))
+				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   BaseErrorMessageID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockLogger := mocks.NewMockLogger(ctrl)
+
+			tt.setupMocks(mockFeature, mockLogger)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/redfish/v1/Systems/:id/LogServices/EventLog/Entries/:entryId", getEventLogEntryHandler(mockFeature, nil, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, entriesPath+"/"+tt.entryID, http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedBody)
+
+				return
+			}
+
+			var entry LogEntry
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &entry))
+			assert.Equal(t, entriesPath+"/"+tt.entryID, entry.ODataID)
+			assert.Equal(t, tt.entryID, entry.ID)
+			assert.Equal(t, healthWarning, entry.Severity)
+			assert.Equal(t, "2024-01-06T03:25:23Z", entry.Created)
+			assert.Equal(t, record.Description, entry.Message)
+		})
+	}
+}
+
+func TestPostClearLogHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*mocks.MockDeviceManagementFeature, *mocks.MockLogger)
+		expectedStatus int
+		expectedBody   string
+		expectedState  string
+	}{
+		{
+			name: "successful clear",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().ClearEventLog(gomock.Any(), testSystemID).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"TaskState":"Completed"`,
+			expectedState:  TaskStateCompleted,
+		},
+		{
+			name: "upstream failure maps to 502",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().ClearEventLog(gomock.Any(), testSystemID).Return(fmt.Errorf("dial tcp 10.0.0.5:16993: connection refused"))
+				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+			},
+			expectedStatus: http.StatusBadGateway,
+			expectedBody:   BaseErrorMessageID,
+			expectedState:  TaskStateException,
+		},
+		{
+			name: "unknown system",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().ClearEventLog(gomock.Any(), testSystemID).Return(devices.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   BaseResourceNotFoundID,
+			expectedState:  TaskStateException,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockLogger := mocks.NewMockLogger(ctrl)
+
+			tt.setupMocks(mockFeature, mockLogger)
+
+			store := NewTaskStore()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/redfish/v1/Systems/:id/LogServices/EventLog/Actions/LogService.ClearLog", postClearLogHandler(mockFeature, store, nil, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost,
+				"/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/LogServices/EventLog/Actions/LogService.ClearLog", http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+
+			tasks := store.List()
+			require.Len(t, tasks, 1)
+			assert.Equal(t, tt.expectedState, tasks[0].TaskState)
+
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tasks[0].ODataID, w.Header().Get("Location"))
+			}
+		})
+	}
+}
+
+func TestEventCreated(t *testing.T) {
+	t.Parallel()
+
+	zone := time.FixedZone("PST", -8*60*60)
+
+	assert.Equal(t, "2024-01-06T11:25:23Z", eventCreated(time.Date(2024, time.January, 6, 3, 25, 23, 0, zone).String()))
+	assert.Equal(t, "not a time", eventCreated("not a time"))
+}
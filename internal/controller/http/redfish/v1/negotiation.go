@@ -0,0 +1,176 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements Redfish API v1 content negotiation helpers.
+package v1
+
+import (
+	"mime"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Media range specificity used to pick the most specific Accept entry matching JSON
+const (
+	mediaTypeJSON          = "application/json"
+	specificityNone        = 0
+	specificityAnyType     = 1 // */*
+	specificityAnySubtype  = 2 // application/*
+	specificityExactMedia  = 3 // application/json
+	specificityWithParams  = 4 // application/json;charset=utf-8
+	qualityMaxValue        = 1.0
+	acceptQualityParameter = "q"
+	acceptCharsetParameter = "charset"
+	responseCharset        = "utf-8"
+	odataVersionHeader     = "OData-Version"
+	odataVersion           = "4.0"
+)
+
+// negotiateAccept reports whether a JSON representation satisfies the given Accept header.
+// Following the precedence rules of RFC 7231 section 5.3.2, the most specific media range
+// matching the application/json; charset=utf-8 response decides, where a range with
+// parameters is more specific than the bare media type. Among equally specific ranges the
+// first one listed wins. A q-value of 0 explicitly rejects JSON, and an empty header
+// accepts any representation.
+func negotiateAccept(header string) bool {
+	if strings.TrimSpace(header) == "" {
+		return true
+	}
+
+	bestSpecificity := specificityNone
+	quality := 0.0
+
+	for _, entry := range strings.Split(header, ",") {
+		mediaRange, params, q, ok := parseMediaRange(entry)
+		if !ok {
+			continue
+		}
+
+		if specificity := jsonMatchSpecificity(mediaRange, params); specificity > bestSpecificity {
+			bestSpecificity = specificity
+			quality = q
+		}
+	}
+
+	return bestSpecificity > specificityNone && quality > 0
+}
+
+// parseMediaRange splits a single Accept entry into its lower-cased media range, its
+// media-type parameters and its q-value. Entries with an invalid q-value are dropped.
+func parseMediaRange(entry string) (mediaRange string, params map[string]string, quality float64, ok bool) {
+	parts := strings.Split(entry, ";")
+
+	mediaRange = strings.ToLower(strings.TrimSpace(parts[0]))
+	if !strings.Contains(mediaRange, "/") {
+		return "", nil, 0, false
+	}
+
+	quality = qualityMaxValue
+	params = map[string]string{}
+
+	for _, param := range parts[1:] {
+		key, value, found := strings.Cut(param, "=")
+		if !found {
+			continue
+		}
+
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+
+		if key != acceptQualityParameter {
+			params[key] = strings.ToLower(value)
+
+			continue
+		}
+
+		q, err := strconv.ParseFloat(value, 64)
+		if err != nil || q < 0 || q > qualityMaxValue {
+			return "", nil, 0, false
+		}
+
+		quality = q
+	}
+
+	return mediaRange, params, quality, true
+}
+
+// jsonMatchSpecificity returns how specifically a media range matches the JSON response.
+// Parameters only apply to the exact media type; a range whose parameters the response
+// cannot satisfy (anything other than charset=utf-8) does not match.
+func jsonMatchSpecificity(mediaRange string, params map[string]string) int {
+	switch mediaRange {
+	case mediaTypeJSON:
+		if len(params) == 0 {
+			return specificityExactMedia
+		}
+
+		for key, value := range params {
+			if key != acceptCharsetParameter || value != responseCharset {
+				return specificityNone
+			}
+		}
+
+		return specificityWithParams
+	case "application/*":
+		return specificityAnySubtype
+	case "*/*":
+		return specificityAnyType
+	default:
+		return specificityNone
+	}
+}
+
+// isContentTypeValid reports whether a request Content-Type header declares a JSON body:
+// application/json, optionally with charset=utf-8
+func isContentTypeValid(header string) bool {
+	mediaType, params, err := mime.ParseMediaType(header)
+	if err != nil || mediaType != mediaTypeJSON {
+		return false
+	}
+
+	for key, value := range params {
+		if key != acceptCharsetParameter || !strings.EqualFold(value, responseCharset) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isODataVersionSupported reports whether a request OData-Version header is one the service speaks.
+// An absent header leaves the version to the service; otherwise every listed version must be 4.0.
+func isODataVersionSupported(header string) bool {
+	if strings.TrimSpace(header) == "" {
+		return true
+	}
+
+	for _, version := range strings.Split(header, ",") {
+		if strings.TrimSpace(version) != odataVersion {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RedfishODataVersionMiddleware rejects requests that ask for an OData version other than 4.0 with
+// 412, before any handler runs. The OData-Version response header is set up front so that every
+// response carries it, including those of handlers and middleware that do not set the Redfish headers.
+func RedfishODataVersionMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header(odataVersionHeader, odataVersion)
+
+		if version := c.GetHeader(odataVersionHeader); !isODataVersionSupported(version) {
+			ODataVersionError(c, version)
+			c.Abort()
+
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,182 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiateAccept(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		header   string
+		expected bool
+	}{
+		{name: "empty header", header: "", expected: true},
+		{name: "exact JSON", header: "application/json", expected: true},
+		{name: "wildcard", header: "*/*", expected: true},
+		{name: "application subtype wildcard", header: "application/*", expected: true},
+		{name: "JSON with charset parameter", header: "application/json; charset=utf-8", expected: true},
+		{name: "mixed case media type", header: "Application/JSON", expected: true},
+		{name: "weighted list preferring JSON", header: "application/json;q=0.9, text/xml;q=0.1", expected: true},
+		{name: "weighted list preferring XML", header: "text/xml, application/json;q=0.2", expected: true},
+		{name: "low quality wildcard", header: "text/html, */*;q=0.1", expected: true},
+		{name: "unsupported media type", header: "text/xml", expected: false},
+		{name: "unsupported list", header: "text/html, application/xhtml+xml", expected: false},
+		{name: "explicit JSON rejection", header: "application/json;q=0", expected: false},
+		{name: "JSON rejection overrides wildcard", header: "application/json;q=0, */*", expected: false},
+		{name: "wildcard rejection without JSON", header: "*/*;q=0", expected: false},
+		{name: "invalid q-value drops the entry", header: "application/json;q=abc", expected: false},
+		{name: "invalid q-value with valid fallback", header: "application/json;q=2, */*;q=0.5", expected: true},
+		{name: "malformed entry", header: "json", expected: false},
+		{name: "parameterised rejection outranks bare JSON", header: "application/json;charset=utf-8;q=0, application/json", expected: false},
+		{name: "bare JSON rejection yields to parameterised range", header: "application/json;q=0, application/json;charset=utf-8", expected: true},
+		{name: "quoted charset parameter", header: `application/json;charset="UTF-8"`, expected: true},
+		{name: "unsatisfiable charset", header: "application/json;charset=iso-8859-1", expected: false},
+		{name: "unsatisfiable charset falls back to wildcard", header: "application/json;charset=iso-8859-1, */*;q=0.5", expected: true},
+		{name: "first of equally specific ranges wins", header: "application/json;q=0, application/json", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, negotiateAccept(tt.header))
+		})
+	}
+}
+
+func TestIsContentTypeValid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		header   string
+		expected bool
+	}{
+		{name: "JSON", header: "application/json", expected: true},
+		{name: "JSON with charset", header: "application/json; charset=UTF-8", expected: true},
+		{name: "mixed case media type", header: "Application/JSON", expected: true},
+		{name: "missing header", header: "", expected: false},
+		{name: "plain text", header: "text/plain", expected: false},
+		{name: "form", header: "application/x-www-form-urlencoded", expected: false},
+		{name: "other charset", header: "application/json; charset=iso-8859-1", expected: false},
+		{name: "malformed header", header: "application/json;;", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, isContentTypeValid(tt.header))
+		})
+	}
+}
+
+func TestServiceRootAcceptNegotiation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		acceptHeader   string
+		expectedStatus int
+	}{
+		{name: "weighted list with JSON", acceptHeader: "application/json;q=0.9, text/xml;q=0.1", expectedStatus: http.StatusOK},
+		{name: "JSON with charset", acceptHeader: "application/json; charset=utf-8", expectedStatus: http.StatusOK},
+		{name: "JSON explicitly rejected", acceptHeader: "application/json;q=0, text/xml", expectedStatus: http.StatusNotAcceptable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := createTestRouter(createTestConfig(true))
+
+			req, _ := http.NewRequestWithContext(context.Background(), httpMethodGET, "/redfish/v1/", http.NoBody)
+			req.Header.Set("Accept", tt.acceptHeader)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusNotAcceptable {
+				assert.Contains(t, w.Body.String(), "Base.1.11.0.NotAcceptable")
+			}
+		})
+	}
+}
+
+func TestIsODataVersionSupported(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		header   string
+		expected bool
+	}{
+		{name: "absent", header: "", expected: true},
+		{name: "4.0", header: "4.0", expected: true},
+		{name: "4.0 with whitespace", header: " 4.0 ", expected: true},
+		{name: "older version", header: "3.0", expected: false},
+		{name: "newer minor version", header: "4.01", expected: false},
+		{name: "list with an unsupported version", header: "4.0, 4.01", expected: false},
+		{name: "not a version", header: "latest", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, isODataVersionSupported(tt.header))
+		})
+	}
+}
+
+func TestServiceRootODataVersionNegotiation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		odataVersion   string
+		expectedStatus int
+	}{
+		{name: "matching version", odataVersion: "4.0", expectedStatus: http.StatusOK},
+		{name: "absent header", odataVersion: "", expectedStatus: http.StatusOK},
+		{name: "incompatible version", odataVersion: "3.0", expectedStatus: http.StatusPreconditionFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := createTestRouter(createTestConfig(true))
+
+			req, _ := http.NewRequestWithContext(context.Background(), httpMethodGET, "/redfish/v1/", http.NoBody)
+			if tt.odataVersion != "" {
+				req.Header.Set("OData-Version", tt.odataVersion)
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, "4.0", w.Header().Get("OData-Version"))
+
+			if tt.expectedStatus == http.StatusPreconditionFailed {
+				assert.Contains(t, w.Body.String(), BaseHeaderInvalidID)
+				assert.Contains(t, w.Body.String(), "OData-Version: 3.0")
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements Redfish API v1 OPTIONS request handling.
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// optionsHandler answers OPTIONS with 204 and an Allow header listing the methods the resource supports
+func optionsHandler(allowedMethods string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		SetRedfishHeaders(c)
+		c.Header("Allow", allowedMethods)
+		c.Status(http.StatusNoContent)
+	}
+}
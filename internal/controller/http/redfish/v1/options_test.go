@@ -0,0 +1,67 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestOptionsAllowHeader(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		path          string
+		expectedAllow string
+	}{
+		{name: "service root", path: "/redfish/v1/", expectedAllow: "GET, HEAD"},
+		{name: "systems collection", path: systemsBasePath, expectedAllow: "GET, HEAD"},
+		{name: "system instance", path: systemsInstanceURL, expectedAllow: "GET, HEAD, PATCH"},
+		{name: "reset action", path: resetActionURL, expectedAllow: "POST"},
+		{name: "bulk reset action", path: systemsBasePath + "/Actions/" + bulkResetAction, expectedAllow: "POST"},
+		{name: "firmware collection", path: systemsInstanceURL + "/FirmwareInventory", expectedAllow: "GET, HEAD"},
+		{name: "firmware instance", path: systemsInstanceURL + "/FirmwareInventory/AMT", expectedAllow: "GET, HEAD"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			// The device is never contacted for OPTIONS
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			cfg := createTestConfig(true)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			redfish := router.Group("/redfish/v1")
+			NewServiceRootRoutes(redfish, nil, cfg, testServiceCapabilities, logger.New("test"))
+			NewSystemsRoutes(redfish, mockFeature, cfg, logger.New("test"))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodOptions, tt.path, http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusNoContent, w.Code)
+			assert.Equal(t, tt.expectedAllow, w.Header().Get("Allow"))
+			assert.Equal(t, "4.0", w.Header().Get("OData-Version"))
+			assert.Empty(t, w.Body.String())
+		})
+	}
+}
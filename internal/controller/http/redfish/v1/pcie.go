@@ -0,0 +1,192 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements Redfish API v1 PCIeDevice resources.
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/config"
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// PCIeDevice constants
+const (
+	pcieDeviceTypeSingleFunction = "SingleFunction"
+	pcieDeviceTypeMultiFunction  = "MultiFunction"
+)
+
+// pcieTypes are the PCIeInterface.PCIeType values a CIM_PCIDevice may report
+var pcieTypes = []string{"Gen1", "Gen2", "Gen3", "Gen4", "Gen5"}
+
+// PCIeDevice represents a Redfish PCIeDevice built from a CIM_PCIDevice instance
+type PCIeDevice struct {
+	ODataID       string         `json:"@odata.id"`
+	ODataType     string         `json:"@odata.type"`
+	ID            string         `json:"Id"`
+	Name          string         `json:"Name"`
+	Manufacturer  string         `json:"Manufacturer,omitempty"`
+	DeviceType    string         `json:"DeviceType"`
+	PCIeInterface *PCIeInterface `json:"PCIeInterface,omitempty"`
+}
+
+// PCIeInterface is the PCIeDevice.PCIeInterface property
+type PCIeInterface struct {
+	PCIeType string `json:"PCIeType"`
+}
+
+// NewPCIeDeviceRoutes registers Redfish PCIeDevice routes for Systems
+// It exposes:
+// - GET /redfish/v1/Systems/:id/PCIeDevices
+// - GET /redfish/v1/Systems/:id/PCIeDevices/:deviceId
+func NewPCIeDeviceRoutes(systems *gin.RouterGroup, d devices.Feature, cfg *config.Config, l logger.Interface) {
+	systems.GET(":id/PCIeDevices", getPCIeDevicesHandler(d, cfg, l))
+	systems.GET(":id/PCIeDevices/:deviceId", getPCIeDeviceHandler(d, cfg, l))
+
+	l.Info("Registered Redfish PCIeDevice routes under %s", systems.BasePath())
+}
+
+func pcieDevicesPath(base, systemID string) string {
+	return systemPath(base, systemID) + "/PCIeDevices"
+}
+
+func getPCIeDevicesHandler(d devices.Feature, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+
+	return func(c *gin.Context) {
+		systemID := c.Param("id")
+
+		if !validateSystemID(c, systemID) {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		pcieDevices, err := fetchPCIeDevices(ctx, d, linkBase(c), systemID)
+		if err != nil {
+			pcieHardwareInfoError(c, l, err, systemID)
+
+			return
+		}
+
+		members := make([]any, 0, len(pcieDevices))
+		for i := range pcieDevices {
+			members = append(members, map[string]any{"@odata.id": pcieDevices[i].ODataID})
+		}
+
+		SetRedfishHeaders(c)
+		c.JSON(http.StatusOK, map[string]any{
+			"@odata.type":         "#PCIeDeviceCollection.PCIeDeviceCollection",
+			"@odata.id":           pcieDevicesPath(linkBase(c), systemID),
+			"Name":                "PCIe Device Collection",
+			"Members@odata.count": len(members),
+			"Members":             members,
+		})
+	}
+}
+
+func getPCIeDeviceHandler(d devices.Feature, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+
+	return func(c *gin.Context) {
+		systemID := c.Param("id")
+		deviceID := c.Param("deviceId")
+
+		if !validateSystemID(c, systemID) {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		pcieDevices, err := fetchPCIeDevices(ctx, d, linkBase(c), systemID)
+		if err != nil {
+			pcieHardwareInfoError(c, l, err, systemID)
+
+			return
+		}
+
+		for i := range pcieDevices {
+			if pcieDevices[i].ID == deviceID {
+				SetRedfishHeaders(c)
+				c.JSON(http.StatusOK, pcieDevices[i])
+
+				return
+			}
+		}
+
+		ResourceNotFoundError(c, "PCIeDevice", deviceID)
+	}
+}
+
+// pcieHardwareInfoError writes the Redfish error for a failed hardware info read
+func pcieHardwareInfoError(c *gin.Context, l logger.Interface, err error, systemID string) {
+	var nfErr sqldb.NotFoundError
+	if errors.As(err, &nfErr) {
+		ResourceNotFoundError(c, "ComputerSystem", systemID)
+
+		return
+	}
+
+	l.Error(err, "http - redfish - PCIeDevices for %s [request %s]", systemID, requestID(c))
+	deviceCallError(c, err)
+}
+
+// fetchPCIeDevices reads the hardware info of a system and converts its CIM_PCIDevice instances to
+// PCIeDevice resources linked under base
+func fetchPCIeDevices(ctx context.Context, d devices.Feature, base, systemID string) ([]PCIeDevice, error) {
+	hwInfo, err := d.GetHardwareInfo(ctx, systemID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildPCIeDevices(base, systemID, hwInfo), nil
+}
+
+// buildPCIeDevices numbers the CIM_PCIDevice instances from 1. Properties that are missing or of
+// an unexpected type are left out rather than failing the request.
+func buildPCIeDevices(base, systemID string, hwInfo dto.HardwareInfo) []PCIeDevice {
+	items := cimItems(hwInfo.CIMPCIDevice)
+	pcieDevices := make([]PCIeDevice, 0, len(items))
+
+	for i, item := range items {
+		id := strconv.Itoa(i + 1)
+		device := PCIeDevice{
+			ODataID:      pcieDevicesPath(base, systemID) + "/" + id,
+			ODataType:    "#PCIeDevice.v1_4_0.PCIeDevice",
+			ID:           id,
+			Name:         cimString(item, "ElementName"),
+			Manufacturer: cimString(item, "Manufacturer"),
+			DeviceType:   pcieDeviceTypeSingleFunction,
+		}
+
+		if multiFunction, ok := item["MultiFunction"].(bool); ok && multiFunction {
+			device.DeviceType = pcieDeviceTypeMultiFunction
+		}
+
+		if pcieType := cimString(item, "PCIeType"); slices.Contains(pcieTypes, pcieType) {
+			device.PCIeInterface = &PCIeInterface{PCIeType: pcieType}
+		}
+
+		if device.Name == "" {
+			device.Name = "PCIe Device " + id
+		}
+
+		pcieDevices = append(pcieDevices, device)
+	}
+
+	return pcieDevices
+}
@@ -0,0 +1,191 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+func pcieHardwareInfo() dto.HardwareInfo {
+	return dto.HardwareInfo{CIMPCIDevice: dto.CIMResponse{Responses: []interface{}{
+		map[string]interface{}{"ElementName": "Ethernet Controller I225-LM", "Manufacturer": "Intel Corporation", "PCIeType": "Gen3"},
+		map[string]interface{}{"Manufacturer": "Intel Corporation", "MultiFunction": true, "PCIeType": "Gen9"},
+	}}}
+}
+
+func TestPCIeDevicesCollection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		hwInfo         dto.HardwareInfo
+		hwErr          error
+		expectedStatus int
+		expectedIDs    []string
+		expectedBody   string
+	}{
+		{
+			name:           "populated list",
+			hwInfo:         pcieHardwareInfo(),
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{"1", "2"},
+		},
+		{
+			name:           "no PCIe data",
+			hwInfo:         dto.HardwareInfo{},
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{},
+		},
+		{
+			name:           "unknown system",
+			hwErr:          devices.ErrNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   BaseResourceNotFoundID,
+		},
+		{
+			name:           "device failure",
+			hwErr:          fmt.Errorf("dial tcp 10.0.0.5:16993: connection refused"),
+			expectedStatus: http.StatusBadGateway,
+			expectedBody:   BaseErrorMessageID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemID).Return(tt.hwInfo, tt.hwErr)
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/redfish/v1/Systems/:id/PCIeDevices", getPCIeDevicesHandler(mockFeature, nil, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/PCIeDevices", http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedBody)
+
+				return
+			}
+
+			var body struct {
+				ODataType string `json:"@odata.type"`
+				Count     int    `json:"Members@odata.count"`
+				Members   []struct {
+					ODataID string `json:"@odata.id"`
+				} `json:"Members"`
+			}
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, "#PCIeDeviceCollection.PCIeDeviceCollection", body.ODataType)
+			assert.Equal(t, len(tt.expectedIDs), body.Count)
+			require.Len(t, body.Members, len(tt.expectedIDs))
+
+			for i, id := range tt.expectedIDs {
+				assert.Equal(t, "/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/PCIeDevices/"+id, body.Members[i].ODataID)
+			}
+		})
+	}
+}
+
+func TestPCIeDeviceInstance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		deviceID       string
+		expectedStatus int
+		expectedBody   []string
+		absentBody     []string
+	}{
+		{
+			name:           "single function device",
+			deviceID:       "1",
+			expectedStatus: http.StatusOK,
+			expectedBody: []string{
+				`"#PCIeDevice.v1_4_0.PCIeDevice"`,
+				`"Name":"Ethernet Controller I225-LM"`,
+				`"Manufacturer":"Intel Corporation"`,
+				`"DeviceType":"SingleFunction"`,
+				`"PCIeInterface":{"PCIeType":"Gen3"}`,
+			},
+		},
+		{
+			name:           "multi function device with an unknown PCIe type",
+			deviceID:       "2",
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{`"Name":"PCIe Device 2"`, `"DeviceType":"MultiFunction"`},
+			absentBody:     []string{"PCIeInterface"},
+		},
+		{
+			name:           "unknown device",
+			deviceID:       "3",
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   []string{BaseResourceNotFoundID, "PCIeDevice"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemID).Return(pcieHardwareInfo(), nil)
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).Times(1)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			NewPCIeDeviceRoutes(router.Group("/redfish/v1/Systems"), mockFeature, nil, mockLogger)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet,
+				"/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/PCIeDevices/"+tt.deviceID, http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			for _, expected := range tt.expectedBody {
+				assert.Contains(t, w.Body.String(), expected)
+			}
+
+			for _, absent := range tt.absentBody {
+				assert.NotContains(t, w.Body.String(), absent)
+			}
+		})
+	}
+}
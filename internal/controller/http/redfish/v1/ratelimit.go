@@ -0,0 +1,134 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements rate limiting for Redfish API v1 device actions.
+package v1
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/config"
+)
+
+// Rate limit defaults used when the configuration leaves them unset
+const (
+	DefaultActionRate  = 1.0
+	DefaultActionBurst = 5
+
+	// maxIdleBuckets is the bucket count above which refilled buckets are dropped
+	maxIdleBuckets = 1024
+)
+
+// tokenBucket holds the tokens left for one client key
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter is a token bucket limiter keyed by an arbitrary string
+type rateLimiter struct {
+	mu      sync.Mutex
+	rate    float64
+	burst   float64
+	buckets map[string]*tokenBucket
+	now     func() time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: map[string]*tokenBucket{},
+		now:     time.Now,
+	}
+}
+
+// allow takes a token for key. When none is left it returns false and how long until one is.
+func (r *rateLimiter) allow(key string) (bool, time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+
+	bucket, ok := r.buckets[key]
+	if !ok {
+		r.prune(now)
+
+		bucket = &tokenBucket{tokens: r.burst, last: now}
+		r.buckets[key] = bucket
+	}
+
+	bucket.tokens = math.Min(r.burst, bucket.tokens+now.Sub(bucket.last).Seconds()*r.rate)
+	bucket.last = now
+
+	if bucket.tokens < 1 {
+		return false, time.Duration((1 - bucket.tokens) / r.rate * float64(time.Second))
+	}
+
+	bucket.tokens--
+
+	return true, 0
+}
+
+// prune drops the buckets that have refilled completely once there are too many of them.
+// The caller must hold r.mu.
+func (r *rateLimiter) prune(now time.Time) {
+	if len(r.buckets) < maxIdleBuckets {
+		return
+	}
+
+	for key, bucket := range r.buckets {
+		if bucket.tokens+now.Sub(bucket.last).Seconds()*r.rate >= r.burst {
+			delete(r.buckets, key)
+		}
+	}
+}
+
+// RedfishRateLimitMiddleware limits device actions with a token bucket per client IP, and per
+// device id as well when cfg.Redfish.ActionRateLimitPerDevice is set. Requests over the limit
+// get 503 with a Retry-After header. Each call returns an independent limiter.
+func RedfishRateLimitMiddleware(cfg *config.Config) gin.HandlerFunc {
+	rate, burst, perDevice := actionRateLimit(cfg)
+	limiter := newRateLimiter(rate, burst)
+
+	return func(c *gin.Context) {
+		key := c.ClientIP()
+		if perDevice {
+			key += "|" + c.Param("id")
+		}
+
+		if ok, wait := limiter.allow(key); !ok {
+			ServiceTemporarilyUnavailableRetryError(c, int(math.Ceil(wait.Seconds())))
+			c.Abort()
+
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// actionRateLimit returns the configured action rate limit, falling back to the defaults
+func actionRateLimit(cfg *config.Config) (rate float64, burst int, perDevice bool) {
+	rate, burst = DefaultActionRate, DefaultActionBurst
+
+	if cfg == nil {
+		return rate, burst, false
+	}
+
+	if cfg.Redfish.ActionRate > 0 {
+		rate = cfg.Redfish.ActionRate
+	}
+
+	if cfg.Redfish.ActionBurst > 0 {
+		burst = cfg.Redfish.ActionBurst
+	}
+
+	return rate, burst, cfg.Redfish.ActionRateLimitPerDevice
+}
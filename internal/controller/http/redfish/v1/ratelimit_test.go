@@ -0,0 +1,215 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/mocks"
+)
+
+func TestRateLimiterAllow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newRateLimiter(1, 2)
+	limiter.now = func() time.Time { return now }
+
+	ok, _ := limiter.allow("10.0.0.1")
+	assert.True(t, ok)
+
+	ok, _ = limiter.allow("10.0.0.1")
+	assert.True(t, ok)
+
+	ok, wait := limiter.allow("10.0.0.1")
+	assert.False(t, ok)
+	assert.Equal(t, time.Second, wait)
+
+	ok, _ = limiter.allow("10.0.0.2")
+	assert.True(t, ok, "each key has its own bucket")
+
+	now = now.Add(500 * time.Millisecond)
+	ok, wait = limiter.allow("10.0.0.1")
+	assert.False(t, ok)
+	assert.Equal(t, 500*time.Millisecond, wait)
+
+	now = now.Add(500 * time.Millisecond)
+	ok, _ = limiter.allow("10.0.0.1")
+	assert.True(t, ok)
+
+	now = now.Add(time.Hour)
+	for range 2 {
+		ok, _ = limiter.allow("10.0.0.1")
+		assert.True(t, ok, "the bucket refills up to the burst")
+	}
+
+	ok, _ = limiter.allow("10.0.0.1")
+	assert.False(t, ok)
+}
+
+func TestRateLimiterPrune(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newRateLimiter(1, 1)
+	limiter.now = func() time.Time { return now }
+
+	for i := range maxIdleBuckets {
+		limiter.allow(strings.Repeat("k", i+1))
+	}
+
+	require.Len(t, limiter.buckets, maxIdleBuckets)
+
+	now = now.Add(time.Second)
+	limiter.allow("new client")
+
+	assert.Len(t, limiter.buckets, 1)
+}
+
+func TestRedfishRateLimitMiddleware(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		perDevice bool
+		requests  []string
+		expected  []int
+	}{
+		{
+			name:      "client IP shares one bucket across devices",
+			requests:  []string{"10.0.0.1|system-1", "10.0.0.1|system-2", "10.0.0.1|system-3"},
+			expected:  []int{http.StatusOK, http.StatusOK, http.StatusServiceUnavailable},
+			perDevice: false,
+		},
+		{
+			name:      "clients have separate buckets",
+			requests:  []string{"10.0.0.1|system-1", "10.0.0.1|system-1", "10.0.0.2|system-1"},
+			expected:  []int{http.StatusOK, http.StatusOK, http.StatusOK},
+			perDevice: false,
+		},
+		{
+			name:      "per device keys by client IP and system id",
+			requests:  []string{"10.0.0.1|system-1", "10.0.0.1|system-1", "10.0.0.1|system-2", "10.0.0.1|system-1"},
+			expected:  []int{http.StatusOK, http.StatusOK, http.StatusOK, http.StatusServiceUnavailable},
+			perDevice: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &config.Config{}
+			cfg.Redfish.ActionRate = 0.1
+			cfg.Redfish.ActionBurst = 2
+			cfg.Redfish.ActionRateLimitPerDevice = tt.perDevice
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/redfish/v1/Systems/:id/Actions/ComputerSystem.Reset", RedfishRateLimitMiddleware(cfg), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			for i, request := range tt.requests {
+				remoteIP, systemID, _ := strings.Cut(request, "|")
+
+				w := httptest.NewRecorder()
+				req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost,
+					"/redfish/v1/Systems/"+systemID+"/Actions/ComputerSystem.Reset", http.NoBody)
+				req.RemoteAddr = remoteIP + ":40000"
+
+				router.ServeHTTP(w, req)
+
+				require.Equal(t, tt.expected[i], w.Code, "request %d", i)
+
+				if w.Code == http.StatusServiceUnavailable {
+					assert.Equal(t, "10", w.Header().Get("Retry-After"))
+					assert.Contains(t, w.Body.String(), BaseErrorMessageID)
+				}
+			}
+		})
+	}
+}
+
+func TestResetIsRateLimited(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	cfg := &config.Config{}
+	cfg.Redfish.ActionRate = 1
+	cfg.Redfish.ActionBurst = 3
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().SendPowerAction(gomock.Any(), testSystemGUID, actionPowerUp).
+		Return(power.PowerActionResponse{ReturnValue: 0}, nil).Times(3)
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	NewSystemsRoutes(router.Group("/redfish/v1"), mockFeature, cfg, mockLogger)
+
+	codes := make([]int, 0, 5)
+
+	for range 5 {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, resetActionURL, strings.NewReader(`{"ResetType":"On"}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		codes = append(codes, w.Code)
+
+		if w.Code == http.StatusServiceUnavailable {
+			assert.Equal(t, "1", w.Header().Get("Retry-After"))
+		}
+	}
+
+	assert.Equal(t, []int{
+		http.StatusOK, http.StatusOK, http.StatusOK,
+		http.StatusServiceUnavailable, http.StatusServiceUnavailable,
+	}, codes)
+}
+
+func TestActionRateLimit(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{}
+
+	rate, burst, perDevice := actionRateLimit(nil)
+	assert.InDelta(t, DefaultActionRate, rate, 0)
+	assert.Equal(t, DefaultActionBurst, burst)
+	assert.False(t, perDevice)
+
+	rate, burst, _ = actionRateLimit(cfg)
+	assert.InDelta(t, DefaultActionRate, rate, 0)
+	assert.Equal(t, DefaultActionBurst, burst)
+
+	cfg.Redfish.ActionRate = 0.5
+	cfg.Redfish.ActionBurst = 10
+	cfg.Redfish.ActionRateLimitPerDevice = true
+
+	rate, burst, perDevice = actionRateLimit(cfg)
+	assert.InDelta(t, 0.5, rate, 0)
+	assert.Equal(t, 10, burst)
+	assert.True(t, perDevice)
+}
@@ -0,0 +1,39 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements panic recovery for the Redfish API v1.
+package v1
+
+import (
+	"fmt"
+	"runtime/debug"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// RedfishRecoveryMiddleware turns a panic in a later handler into a Redfish GeneralError (500) and
+// logs the panic with the request id. Install it on a route group before the routes it protects, so
+// that it covers every handler of the group wherever that handler is registered.
+func RedfishRecoveryMiddleware(l logger.Interface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				l.Error(fmt.Errorf("panic: %v", rec), "http - redfish - recovered panic in %s %s [request %s]\n%s",
+					c.Request.Method, c.Request.URL.Path, requestID(c), debug.Stack())
+
+				// Headers and status cannot change once the body has started
+				if !c.Writer.Written() {
+					GeneralError(c)
+				}
+
+				c.Abort()
+			}
+		}()
+
+		c.Next()
+	}
+}
@@ -0,0 +1,98 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/mocks"
+)
+
+func TestRedfishRecoveryMiddleware(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		handler        gin.HandlerFunc
+		expectedStatus int
+		expectGeneral  bool
+	}{
+		{
+			name:           "panic before the response",
+			handler:        func(_ *gin.Context) { panic("nil map write") },
+			expectedStatus: http.StatusInternalServerError,
+			expectGeneral:  true,
+		},
+		{
+			name:           "panic with an error value",
+			handler:        func(_ *gin.Context) { panic(errors.New("index out of range")) },
+			expectedStatus: http.StatusInternalServerError,
+			expectGeneral:  true,
+		},
+		{
+			name: "panic after the response started",
+			handler: func(c *gin.Context) {
+				c.String(http.StatusOK, "partial")
+				panic("late failure")
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			redfish := router.Group("/redfish/v1", RedfishRequestIDMiddleware(), RedfishRecoveryMiddleware(mockLogger))
+
+			// A route group registered later, as the Systems and Chassis routes are, inherits the recovery
+			redfish.Group("/Chassis").GET("/:id", tt.handler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/redfish/v1/Chassis/1", http.NoBody)
+			req.Header.Set(requestIDHeader, "req-7")
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedStatus, w.Code)
+
+			if !tt.expectGeneral {
+				assert.Equal(t, "partial", w.Body.String())
+
+				return
+			}
+
+			assert.Equal(t, "4.0", w.Header().Get("OData-Version"))
+			assert.Contains(t, w.Header().Get("Content-Type"), "application/json")
+
+			var body RedfishError
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			require.Len(t, body.Error.ExtendedInfo, 1)
+			assert.Equal(t, BaseErrorMessageID, body.Error.ExtendedInfo[0].MessageID)
+			assert.Empty(t, body.Error.ExtendedInfo[0].MessageArgs)
+			assert.Contains(t, body.Error.ExtendedInfo[0].Resolution, "req-7")
+		})
+	}
+}
@@ -0,0 +1,272 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements the Redfish API v1 message registries.
+package v1
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// Registries constants
+const (
+	registriesPath   = "/redfish/v1/Registries"
+	baseRegistryID   = "Base.1.11.0"
+	baseRegistryPath = registriesPath + "/" + baseRegistryID
+)
+
+// RegistryMessage is one message of a Redfish MessageRegistry. %1, %2, ... in Message stand for
+// the MessageArgs of an ExtendedInfo entry carrying the message id.
+type RegistryMessage struct {
+	Description  string   `json:"Description"`
+	Message      string   `json:"Message"`
+	Severity     string   `json:"Severity"`
+	NumberOfArgs int      `json:"NumberOfArgs"`
+	ParamTypes   []string `json:"ParamTypes,omitempty"`
+	Resolution   string   `json:"Resolution"`
+}
+
+// MessageRegistry represents a Redfish MessageRegistry resource
+type MessageRegistry struct {
+	ODataID         string                     `json:"@odata.id"`
+	ODataType       string                     `json:"@odata.type"`
+	ID              string                     `json:"Id"`
+	Name            string                     `json:"Name"`
+	Language        string                     `json:"Language"`
+	Description     string                     `json:"Description"`
+	RegistryPrefix  string                     `json:"RegistryPrefix"`
+	RegistryVersion string                     `json:"RegistryVersion"`
+	OwningEntity    string                     `json:"OwningEntity"`
+	Messages        map[string]RegistryMessage `json:"Messages"`
+}
+
+// baseRegistryMessages holds the Base registry messages this service emits, keyed by message id.
+// The templates use the wording of the error helpers, and every response carries exactly
+// NumberOfArgs message arguments. Diagnostics such as the parse detail of MalformedJSON go in the
+// resolution text instead.
+var baseRegistryMessages = map[string]RegistryMessage{
+	BaseSuccessMessageID: {
+		Description:  "Indicates that all conditions of a successful operation have been met.",
+		Message:      "The request completed successfully.",
+		Severity:     "OK",
+		NumberOfArgs: 0,
+		Resolution:   "None.",
+	},
+	BaseErrorMessageID: {
+		Description:  "Indicates that a general error has occurred.",
+		Message:      "A general error has occurred. See ExtendedInfo for more information.",
+		Severity:     "Critical",
+		NumberOfArgs: 0,
+		Resolution:   "None.",
+	},
+	BaseMalformedJSONID: {
+		Description:  "Indicates that the request body was malformed JSON.",
+		Message:      "The request body submitted was malformed JSON and could not be parsed by the receiving service.",
+		Severity:     "Critical",
+		NumberOfArgs: 0,
+		Resolution:   "Ensure that the request body is valid JSON and resubmit the request.",
+	},
+	BasePropertyMissingID: {
+		Description:  "Indicates that a required property was not supplied as part of the request.",
+		Message:      "The property %1 is a required property and must be included in the request.",
+		Severity:     "Warning",
+		NumberOfArgs: 1,
+		ParamTypes:   []string{"string"},
+		Resolution:   "Ensure that the property is in the request body and has a valid value and resubmit the request.",
+	},
+	BasePropertyValueNotInListID: {
+		Description:  "Indicates that a property was given the correct value type but the value of that property was not supported.",
+		Message:      "The value '%1' for the property %2 is not in the list of acceptable values.",
+		Severity:     "Warning",
+		NumberOfArgs: 2,
+		ParamTypes:   []string{"string", "string"},
+		Resolution:   "Choose a value from the enumeration list that the implementation can support and resubmit the request if the operation failed.",
+	},
+	BasePropertyNotWritableID: {
+		Description:  "Indicates that a property was given a value in the request body, but the property is a read-only property.",
+		Message:      "The property %1 is a read-only property and cannot be assigned a value.",
+		Severity:     "Warning",
+		NumberOfArgs: 1,
+		ParamTypes:   []string{"string"},
+		Resolution:   "Remove the property from the request body and resubmit the request if the operation failed.",
+	},
+	BasePropertyValueFormatErrorID: {
+		Description:  "Indicates that a property was given the correct value type but the value of that property was not supported.",
+		Message:      "The value '%1' for the property %2 is of a different format than the property can accept.",
+		Severity:     "Warning",
+		NumberOfArgs: 2,
+		ParamTypes:   []string{"string", "string"},
+		Resolution:   "Correct the value for the property in the request body and resubmit the request if the operation failed.",
+	},
+	BaseArraySizeTooLongID: {
+		Description:  "Indicates that the size of the array exceeded the maximum number of elements.",
+		Message:      "The array provided for property %1 exceeds the size limit %2.",
+		Severity:     "Warning",
+		NumberOfArgs: 2,
+		ParamTypes:   []string{"string", "number"},
+		Resolution:   "Resubmit the request with an appropriate array size.",
+	},
+	BaseResourceAlreadyExistsID: {
+		Description:  "Indicates that a resource change or creation was attempted but that the operation cannot proceed because the resource already exists.",
+		Message:      "The requested resource of type %1 named '%2' already exists.",
+		Severity:     "Critical",
+		NumberOfArgs: 2,
+		ParamTypes:   []string{"string", "string"},
+		Resolution:   "Do not repeat the create operation as the resource has already been created.",
+	},
+	BaseResourceNotFoundID: {
+		Description:  "Indicates that the operation expected a resource identifier that corresponds to an existing resource but one was not found.",
+		Message:      "The requested resource of type %1 named '%2' was not found.",
+		Severity:     "Critical",
+		NumberOfArgs: 2,
+		ParamTypes:   []string{"string", "string"},
+		Resolution:   "Provide a valid resource identifier and resubmit the request.",
+	},
+	BaseOperationNotAllowedID: {
+		Description:  "Indicates that the HTTP method in the request is not allowed on this resource or in its current state.",
+		Message:      "The operation was not successful because the resource is in a state that does not allow this operation.",
+		Severity:     "Critical",
+		NumberOfArgs: 0,
+		Resolution:   "The operation was not successful because the resource is in a state that does not allow this operation.",
+	},
+	BaseActionNotSupportedID: {
+		Description:  "Indicates that the action supplied with the POST operation is not supported by the resource.",
+		Message:      "The action %1 is not supported by the resource.",
+		Severity:     "Critical",
+		NumberOfArgs: 1,
+		ParamTypes:   []string{"string"},
+		Resolution:   "The action supplied cannot be resubmitted to the implementation. Perhaps the action was invalid, the wrong resource was the target or the implementation documentation may be of assistance.",
+	},
+	BaseNoValidSessionID: {
+		Description:  "Indicates that the operation failed because a valid session is required in order to access any resources.",
+		Message:      "There is no valid session established with the implementation.",
+		Severity:     "Critical",
+		NumberOfArgs: 0,
+		Resolution:   "Establish a valid session before attempting any operations.",
+	},
+	BaseInsufficientPrivilegeID: {
+		Description:  "Indicates that the credentials associated with the established session do not have sufficient privileges for the requested operation.",
+		Message:      "There are insufficient privileges for the account or credentials associated with the current session to perform the requested operation.",
+		Severity:     "Critical",
+		NumberOfArgs: 0,
+		Resolution:   "Either abandon the operation or change the associated access rights and resubmit the request if the operation failed for authorization reasons.",
+	},
+	BaseNotAcceptableID: {
+		Description:  "Indicates that none of the media types in the Accept header can be served.",
+		Message:      "The requested media type '%1' is not acceptable. This service only supports 'application/json'.",
+		Severity:     "Warning",
+		NumberOfArgs: 1,
+		ParamTypes:   []string{"string"},
+		Resolution:   "Resubmit the request with a supported media type in the Accept header.",
+	},
+	BaseUnsupportedMediaTypeID: {
+		Description:  "Indicates that the request body is not of a media type the service accepts.",
+		Message:      "The content type '%1' of the request body is not supported. This service only accepts 'application/json'.",
+		Severity:     "Warning",
+		NumberOfArgs: 1,
+		ParamTypes:   []string{"string"},
+		Resolution:   "Resubmit the request with the Content-Type header set to 'application/json'.",
+	},
+	BaseQueryParameterOutOfRangeID: {
+		Description:  "Indicates that a query parameter was supplied that is out of range for the given resource.",
+		Message:      "The value '%1' for the query parameter %2 is out of range.",
+		Severity:     "Warning",
+		NumberOfArgs: 2,
+		ParamTypes:   []string{"string", "string"},
+		Resolution:   "Reduce the value for the query parameter to a value that is within range, such as a start or count value that is within bounds of the number of resources in a collection or a page that is within the range of valid pages.",
+	},
+	BaseQueryParameterUnsupportedID: {
+		Description:  "Indicates that a query parameter is not supported.",
+		Message:      "Query parameter '%1' is not supported.",
+		Severity:     "Warning",
+		NumberOfArgs: 1,
+		ParamTypes:   []string{"string"},
+		Resolution:   "Correct or remove the query parameter and resubmit the request.",
+	},
+	BaseRequestTooLargeID: {
+		Description:  "Indicates that the size of the request body is too large.",
+		Message:      "The request body exceeds the maximum size of %1 bytes accepted by the service.",
+		Severity:     "Critical",
+		NumberOfArgs: 1,
+		ParamTypes:   []string{"string"},
+		Resolution:   "Reduce the size of the request body and resubmit the request.",
+	},
+	BasePreconditionFailedID: {
+		Description:  "Indicates that the ETag supplied did not match the current ETag of the resource.",
+		Message:      "The ETag supplied did not match the ETag required to change this resource.",
+		Severity:     "Critical",
+		NumberOfArgs: 0,
+		Resolution:   "Try the operation again using the appropriate ETag.",
+	},
+	BaseHeaderInvalidID: {
+		Description:  "Indicates that a request header is invalid.",
+		Message:      "The header '%1' is invalid.",
+		Severity:     "Critical",
+		NumberOfArgs: 1,
+		ParamTypes:   []string{"string"},
+		Resolution:   "Resubmit the request with a valid value for the header.",
+	},
+}
+
+// NewRegistriesRoutes registers the Redfish message registry routes.
+// It exposes:
+// - GET /redfish/v1/Registries
+// - GET /redfish/v1/Registries/Base.1.11.0
+func NewRegistriesRoutes(r *gin.RouterGroup, l logger.Interface) {
+	r.GET("/Registries", registriesCollectionHandler)
+	r.GET("/Registries/:registryId", registryInstanceHandler)
+
+	l.Info("Registered Redfish Registries routes under %s", r.BasePath()+"/Registries")
+}
+
+func registriesCollectionHandler(c *gin.Context) {
+	SetRedfishHeaders(c)
+
+	base := linkBase(c)
+	payload := map[string]any{
+		"@odata.type":         "#MessageRegistryFileCollection.MessageRegistryFileCollection",
+		"@odata.id":           rebaseLink(base, registriesPath),
+		"Name":                "Registry File Collection",
+		"Members@odata.count": 1,
+		"Members":             []any{map[string]any{"@odata.id": rebaseLink(base, baseRegistryPath)}},
+	}
+
+	c.JSON(http.StatusOK, payload)
+}
+
+// registryInstanceHandler serves the Base registry, limited to the messages this service emits
+func registryInstanceHandler(c *gin.Context) {
+	id := c.Param("registryId")
+
+	if id != baseRegistryID {
+		ResourceNotFoundError(c, "MessageRegistry", id)
+
+		return
+	}
+
+	messages := make(map[string]RegistryMessage, len(baseRegistryMessages))
+	for messageID, message := range baseRegistryMessages {
+		messages[strings.TrimPrefix(messageID, baseRegistryID+".")] = message
+	}
+
+	SetRedfishHeaders(c)
+	c.JSON(http.StatusOK, MessageRegistry{
+		ODataID:         rebaseLink(linkBase(c), baseRegistryPath),
+		ODataType:       "#MessageRegistry.v1_4_0.MessageRegistry",
+		ID:              baseRegistryID,
+		Name:            "Base Message Registry",
+		Language:        "en",
+		Description:     "The subset of the DMTF Base message registry used by this service.",
+		RegistryPrefix:  "Base",
+		RegistryVersion: "1.11.0",
+		OwningEntity:    "DMTF",
+		Messages:        messages,
+	})
+}
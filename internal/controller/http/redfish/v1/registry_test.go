@@ -0,0 +1,180 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func getRegistry(t *testing.T, path string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	NewRegistriesRoutes(router.Group("/redfish/v1"), logger.New("test"))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, path, http.NoBody)
+
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestRegistriesCollection(t *testing.T) {
+	t.Parallel()
+
+	w := getRegistry(t, "/redfish/v1/Registries")
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{
+		"@odata.type": "#MessageRegistryFileCollection.MessageRegistryFileCollection",
+		"@odata.id": "/redfish/v1/Registries",
+		"Name": "Registry File Collection",
+		"Members@odata.count": 1,
+		"Members": [{"@odata.id": "/redfish/v1/Registries/Base.1.11.0"}]
+	}`, w.Body.String())
+}
+
+func TestRegistryInstance(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Base registry", func(t *testing.T) {
+		t.Parallel()
+
+		w := getRegistry(t, "/redfish/v1/Registries/Base.1.11.0")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "4.0", w.Header().Get("OData-Version"))
+
+		var registry MessageRegistry
+
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &registry))
+		assert.Equal(t, "/redfish/v1/Registries/Base.1.11.0", registry.ODataID)
+		assert.Equal(t, "Base", registry.RegistryPrefix)
+		assert.Equal(t, "1.11.0", registry.RegistryVersion)
+		assert.Len(t, registry.Messages, len(baseRegistryMessages))
+
+		missing, ok := registry.Messages["PropertyMissing"]
+		require.True(t, ok, "messages are keyed without the registry prefix")
+		assert.Equal(t, "The property %1 is a required property and must be included in the request.", missing.Message)
+		assert.Equal(t, 1, missing.NumberOfArgs)
+	})
+
+	t.Run("unknown registry", func(t *testing.T) {
+		t.Parallel()
+
+		w := getRegistry(t, "/redfish/v1/Registries/Task.1.0.0")
+
+		require.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), BaseResourceNotFoundID)
+	})
+}
+
+func TestBaseRegistryMessages(t *testing.T) {
+	t.Parallel()
+
+	for messageID, message := range baseRegistryMessages {
+		assert.True(t, strings.HasPrefix(messageID, baseRegistryID+"."), messageID)
+		assert.Len(t, message.ParamTypes, message.NumberOfArgs, messageID)
+
+		for arg := 1; arg <= message.NumberOfArgs; arg++ {
+			assert.Contains(t, message.Message, "%"+strconv.Itoa(arg), messageID)
+		}
+	}
+
+	// Tasks and events report success without an error helper
+	assert.Contains(t, baseRegistryMessages, BaseSuccessMessageID)
+}
+
+func TestBaseRegistryCoversEmittedMessages(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		errorFunc func(*gin.Context)
+		// fromTemplate is false for helpers whose message is a more specific variant of the template
+		fromTemplate bool
+	}{
+		{name: "MalformedJSONError", errorFunc: func(c *gin.Context) { MalformedJSONError(c, nil) }, fromTemplate: true},
+		{name: "MalformedJSONError with a syntax error", errorFunc: func(c *gin.Context) { MalformedJSONError(c, &json.SyntaxError{Offset: 7}) }, fromTemplate: true},
+		{name: "PropertyMissingError", errorFunc: func(c *gin.Context) { PropertyMissingError(c, "ResetType") }, fromTemplate: true},
+		{name: "PropertyValueNotInListError", errorFunc: func(c *gin.Context) { PropertyValueNotInListError(c, "Sideways", "ResetType") }, fromTemplate: true},
+		{name: "PropertyNotWritableError", errorFunc: func(c *gin.Context) { PropertyNotWritableError(c, "Id") }, fromTemplate: true},
+		{name: "PropertyValueFormatError", errorFunc: func(c *gin.Context) { PropertyValueFormatError(c, "x", "Destination") }, fromTemplate: true},
+		{name: "ArraySizeTooLongError", errorFunc: func(c *gin.Context) { ArraySizeTooLongError(c, "Targets", 100) }, fromTemplate: true},
+		{name: "PasswordPolicyError", errorFunc: func(c *gin.Context) { PasswordPolicyError(c, 8, 32) }, fromTemplate: true},
+		{name: "QueryParameterValueError", errorFunc: func(c *gin.Context) { QueryParameterValueError(c, queryTop, "0") }, fromTemplate: true},
+		{name: "QueryNotSupportedError", errorFunc: func(c *gin.Context) { QueryNotSupportedError(c, queryExpand) }, fromTemplate: true},
+		{name: "ResourceNotFoundError", errorFunc: func(c *gin.Context) { ResourceNotFoundError(c, "Task", "7") }, fromTemplate: true},
+		{name: "ResourceAlreadyExistsError", errorFunc: func(c *gin.Context) { ResourceAlreadyExistsError(c, "ManagerAccount", "admin") }, fromTemplate: true},
+		{name: "OperationNotAllowedError", errorFunc: OperationNotAllowedError, fromTemplate: true},
+		{name: "ActionNotSupportedError", errorFunc: func(c *gin.Context) { ActionNotSupportedError(c, "Reset") }, fromTemplate: true},
+		{name: "NotImplementedError", errorFunc: func(c *gin.Context) { NotImplementedError(c, "Reset") }, fromTemplate: true},
+		{name: "MethodNotAllowedError", errorFunc: func(c *gin.Context) { MethodNotAllowedError(c, "Reset", "POST") }, fromTemplate: true},
+		{name: "HTTPMethodNotAllowedError", errorFunc: func(c *gin.Context) { HTTPMethodNotAllowedError(c, "PUT", "Task", "GET") }},
+		{name: "NoValidSessionError", errorFunc: NoValidSessionError, fromTemplate: true},
+		{name: "InsufficientPrivilegeError", errorFunc: InsufficientPrivilegeError, fromTemplate: true},
+		{name: "RequestEntityTooLargeError", errorFunc: func(c *gin.Context) { RequestEntityTooLargeError(c, 1024) }, fromTemplate: true},
+		{name: "NotAcceptableError", errorFunc: func(c *gin.Context) { NotAcceptableError(c, "text/xml") }, fromTemplate: true},
+		{name: "UnsupportedMediaTypeError", errorFunc: func(c *gin.Context) { UnsupportedMediaTypeError(c, "text/plain") }, fromTemplate: true},
+		{name: "PreconditionFailedError", errorFunc: PreconditionFailedError, fromTemplate: true},
+		{name: "ODataVersionError", errorFunc: func(c *gin.Context) { ODataVersionError(c, "3.0") }, fromTemplate: true},
+		{name: "GeneralError", errorFunc: GeneralError, fromTemplate: true},
+		{name: "BadGatewayError", errorFunc: BadGatewayError},
+		{name: "DeviceAuthenticationError", errorFunc: DeviceAuthenticationError},
+		{name: "GatewayTimeoutError", errorFunc: GatewayTimeoutError},
+		{name: "ServiceTemporarilyUnavailableError", errorFunc: ServiceTemporarilyUnavailableError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequestWithContext(context.Background(), http.MethodGet, "/test", http.NoBody)
+
+			tt.errorFunc(c)
+
+			var body RedfishError
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			require.Len(t, body.Error.ExtendedInfo, 1)
+
+			info := body.Error.ExtendedInfo[0]
+
+			message, ok := baseRegistryMessages[info.MessageID]
+			require.True(t, ok, "%s is missing from the Base registry", info.MessageID)
+			if !tt.fromTemplate {
+				return
+			}
+
+			require.Len(t, info.MessageArgs, message.NumberOfArgs)
+
+			expected := message.Message
+			for arg := message.NumberOfArgs; arg >= 1; arg-- {
+				expected = strings.ReplaceAll(expected, "%"+strconv.Itoa(arg), info.MessageArgs[arg-1])
+			}
+
+			assert.Equal(t, expected, info.Message)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements request id propagation for the Redfish API v1.
+package v1
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Request id propagation
+const (
+	requestIDHeader     = "X-Request-Id"
+	requestIDContextKey = "redfishRequestID"
+	maxRequestIDLength  = 128
+	noRequestID         = "-"
+)
+
+// requestIDKey is the request context key holding the Redfish request id
+type requestIDKey struct{}
+
+// RedfishRequestIDMiddleware reads the X-Request-Id header, or generates an id when it is missing or
+// malformed, stores it in the gin and request contexts and echoes it in the response header so that
+// handler logs can be correlated with client requests and downstream device calls.
+func RedfishRequestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestIDHeader)
+		if !validRequestID(id) {
+			id = uuid.NewString()
+		}
+
+		c.Set(requestIDContextKey, id)
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), requestIDKey{}, id))
+		c.Header(requestIDHeader, id)
+
+		c.Next()
+	}
+}
+
+// validRequestID accepts client ids of printable ASCII without spaces, so they are safe to log
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+
+	for _, r := range id {
+		if r <= ' ' || r > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// requestID returns the id assigned by RedfishRequestIDMiddleware, or "-" when the middleware did not run
+func requestID(c *gin.Context) string {
+	if c.Request == nil {
+		return noRequestID
+	}
+
+	return requestIDFromContext(c.Request.Context())
+}
+
+// requestIDFromContext returns the request id carried by ctx, or "-" when there is none
+func requestIDFromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(requestIDKey{}).(string); ok {
+		return id
+	}
+
+	return noRequestID
+}
@@ -0,0 +1,85 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedfishRequestIDMiddleware(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		requestID  string
+		expectSame bool
+	}{
+		{name: "provided id is preserved", requestID: "client-req-42", expectSame: true},
+		{name: "missing id is generated", requestID: "", expectSame: false},
+		{name: "id with spaces is replaced", requestID: "bad id", expectSame: false},
+		{name: "oversized id is replaced", requestID: strings.Repeat("a", maxRequestIDLength+1), expectSame: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.Use(RedfishRequestIDMiddleware())
+
+			var seenInHandler, seenInContext string
+
+			router.GET("/test", func(c *gin.Context) {
+				seenInHandler = requestID(c)
+				seenInContext = c.GetString(requestIDContextKey)
+
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/test", http.NoBody)
+
+			if tt.requestID != "" {
+				req.Header.Set(requestIDHeader, tt.requestID)
+			}
+
+			router.ServeHTTP(w, req)
+
+			echoed := w.Header().Get(requestIDHeader)
+			require.NotEmpty(t, echoed)
+			assert.Equal(t, echoed, seenInHandler)
+			assert.Equal(t, echoed, seenInContext)
+
+			if tt.expectSame {
+				assert.Equal(t, tt.requestID, echoed)
+			} else {
+				assert.NotEqual(t, tt.requestID, echoed)
+				assert.True(t, validRequestID(echoed))
+			}
+		})
+	}
+}
+
+func TestRequestIDWithoutMiddleware(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	assert.Equal(t, noRequestID, requestID(c))
+
+	c.Request, _ = http.NewRequestWithContext(context.Background(), http.MethodGet, "/test", http.NoBody)
+	assert.Equal(t, noRequestID, requestID(c))
+}
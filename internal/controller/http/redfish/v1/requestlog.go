@@ -0,0 +1,121 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements request lifecycle logging for the Redfish API v1.
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// requestLogMessage is the message of every request lifecycle entry
+const requestLogMessage = "redfish request"
+
+// upstreamErrorContextKey holds how deviceCallError classified a failed device call
+const upstreamErrorContextKey = "redfishUpstreamError"
+
+// Upstream error classifications recorded by deviceCallError
+const (
+	upstreamErrorCircuitOpen    = "circuit_open"
+	upstreamErrorTimeout        = "timeout"
+	upstreamErrorAuthentication = "authentication"
+	upstreamErrorUnreachable    = "unreachable"
+	upstreamErrorOverloaded     = "overloaded"
+	upstreamErrorOther          = "other"
+)
+
+// RedfishRequestLogMiddleware logs one entry per Redfish request once it completes, with the
+// method, path, system id, status, duration, request id and, for failed device calls, the
+// classification deviceCallError chose. Loggers implementing logger.FieldLogger receive these as
+// fields; other loggers get them as sorted key=value pairs in the message. 5xx responses are logged
+// as errors, 4xx as warnings and the rest as info.
+func RedfishRequestLogMiddleware(l logger.Interface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		fields := map[string]any{
+			"method":      c.Request.Method,
+			"path":        c.Request.URL.Path,
+			"status":      c.Writer.Status(),
+			"duration_ms": float64(time.Since(start)) / float64(time.Millisecond),
+			"request_id":  requestID(c),
+		}
+
+		if id := c.Param("id"); id != "" {
+			fields["system_id"] = id
+		}
+
+		if class := c.GetString(upstreamErrorContextKey); class != "" {
+			fields["upstream_error"] = class
+		}
+
+		logRequest(l, c.Writer.Status(), fields)
+	}
+}
+
+// recordUpstreamError stores the classification of a failed device call for the request log
+func recordUpstreamError(c *gin.Context, class string) {
+	c.Set(upstreamErrorContextKey, class)
+}
+
+// logRequest writes a request lifecycle entry at the level matching status
+func logRequest(l logger.Interface, status int, fields map[string]any) {
+	if fl, ok := l.(logger.FieldLogger); ok {
+		switch {
+		case status >= http.StatusInternalServerError:
+			fl.ErrorFields(requestLogMessage, fields)
+		case status >= http.StatusBadRequest:
+			fl.WarnFields(requestLogMessage, fields)
+		default:
+			fl.InfoFields(requestLogMessage, fields)
+		}
+
+		return
+	}
+
+	message := requestLogMessage + " " + formatLogFields(fields)
+
+	switch {
+	case status >= http.StatusInternalServerError:
+		l.Error(message)
+	case status >= http.StatusBadRequest:
+		l.Warn(message)
+	default:
+		l.Info(message)
+	}
+}
+
+// formatLogFields renders fields as key=value pairs sorted by key, quoting values with spaces
+func formatLogFields(fields map[string]any) string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		value := fmt.Sprint(fields[key])
+		if strings.ContainsAny(value, " \t\"=") {
+			value = fmt.Sprintf("%q", value)
+		}
+
+		pairs = append(pairs, key+"="+value)
+	}
+
+	return strings.Join(pairs, " ")
+}
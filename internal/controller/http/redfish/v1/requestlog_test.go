@@ -0,0 +1,234 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/mocks"
+)
+
+// fieldEntry is one entry written through logger.FieldLogger
+type fieldEntry struct {
+	level   string
+	message string
+	fields  map[string]any
+}
+
+// recordingFieldLogger is a logger.Interface that also implements logger.FieldLogger and records
+// the structured entries
+type recordingFieldLogger struct {
+	mu      sync.Mutex
+	entries []fieldEntry
+}
+
+func (r *recordingFieldLogger) Debug(any, ...any)   {}
+func (r *recordingFieldLogger) Info(string, ...any) {}
+func (r *recordingFieldLogger) Warn(string, ...any) {}
+func (r *recordingFieldLogger) Error(any, ...any)   {}
+func (r *recordingFieldLogger) Fatal(any, ...any)   {}
+
+func (r *recordingFieldLogger) InfoFields(message string, fields map[string]any) {
+	r.record("info", message, fields)
+}
+
+func (r *recordingFieldLogger) WarnFields(message string, fields map[string]any) {
+	r.record("warn", message, fields)
+}
+
+func (r *recordingFieldLogger) ErrorFields(message string, fields map[string]any) {
+	r.record("error", message, fields)
+}
+
+func (r *recordingFieldLogger) record(level, message string, fields map[string]any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = append(r.entries, fieldEntry{level: level, message: message, fields: fields})
+}
+
+func TestRedfishRequestLogMiddleware(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		path          string
+		handler       gin.HandlerFunc
+		expectedLevel string
+		expectedField map[string]any
+		absentFields  []string
+	}{
+		{
+			name:          "successful request",
+			path:          "/redfish/v1/Systems/" + testSystemGUID,
+			handler:       func(c *gin.Context) { c.Status(http.StatusOK) },
+			expectedLevel: "info",
+			expectedField: map[string]any{"status": http.StatusOK, "system_id": testSystemGUID},
+			absentFields:  []string{"upstream_error"},
+		},
+		{
+			name:          "unreachable device",
+			path:          "/redfish/v1/Systems/" + testSystemGUID,
+			handler:       func(c *gin.Context) { deviceCallError(c, errors.New("connection refused")) },
+			expectedLevel: "error",
+			expectedField: map[string]any{"status": http.StatusBadGateway, "upstream_error": upstreamErrorUnreachable},
+		},
+		{
+			name:          "device timeout",
+			path:          "/redfish/v1/Systems/" + testSystemGUID,
+			handler:       func(c *gin.Context) { deviceCallError(c, context.DeadlineExceeded) },
+			expectedLevel: "error",
+			expectedField: map[string]any{"status": http.StatusGatewayTimeout, "upstream_error": upstreamErrorTimeout},
+		},
+		{
+			name:          "client error",
+			path:          "/redfish/v1/Systems",
+			handler:       func(c *gin.Context) { QueryParameterValueError(c, queryTop, "abc") },
+			expectedLevel: "warn",
+			expectedField: map[string]any{"status": http.StatusBadRequest},
+			absentFields:  []string{"system_id", "upstream_error"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := &recordingFieldLogger{}
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.Use(RedfishRequestIDMiddleware(), RedfishRequestLogMiddleware(recorder))
+			router.GET("/redfish/v1/Systems", tt.handler)
+			router.GET("/redfish/v1/Systems/:id", tt.handler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, tt.path, http.NoBody)
+			req.Header.Set(requestIDHeader, "req-42")
+
+			router.ServeHTTP(w, req)
+
+			require.Len(t, recorder.entries, 1)
+
+			entry := recorder.entries[0]
+			assert.Equal(t, tt.expectedLevel, entry.level)
+			assert.Equal(t, requestLogMessage, entry.message)
+			assert.Equal(t, http.MethodGet, entry.fields["method"])
+			assert.Equal(t, tt.path, entry.fields["path"])
+			assert.Equal(t, "req-42", entry.fields["request_id"])
+			assert.Contains(t, entry.fields, "duration_ms")
+
+			for key, value := range tt.expectedField {
+				assert.Equal(t, value, entry.fields[key], key)
+			}
+
+			for _, key := range tt.absentFields {
+				assert.NotContains(t, entry.fields, key)
+			}
+		})
+	}
+}
+
+func TestRedfishRequestLogMiddlewarePlainLogger(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	var message string
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Warn(gomock.Any()).DoAndReturn(func(m string, _ ...any) { message = m })
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(RedfishRequestLogMiddleware(mockLogger))
+	router.GET("/redfish/v1/Systems/:id", func(c *gin.Context) { ResourceNotFoundError(c, "ComputerSystem", c.Param("id")) })
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/redfish/v1/Systems/missing", http.NoBody)
+
+	router.ServeHTTP(w, req)
+
+	assert.Contains(t, message, requestLogMessage+" duration_ms=")
+	assert.Contains(t, message, "method=GET path=/redfish/v1/Systems/missing request_id=- status=404 system_id=missing")
+}
+
+func TestStructuredLoggingConfig(t *testing.T) {
+	t.Parallel()
+
+	for _, enabled := range []bool{false, true} {
+		recorder := &recordingFieldLogger{}
+
+		cfg := &config.Config{}
+		cfg.Disabled = true
+		cfg.Redfish.StructuredLogging = enabled
+
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		NewServiceRootRoutes(router.Group("/redfish/v1"), nil, cfg, testServiceCapabilities, recorder)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/redfish/v1/", http.NoBody)
+
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		if enabled {
+			assert.Len(t, recorder.entries, 1)
+		} else {
+			assert.Empty(t, recorder.entries)
+		}
+	}
+}
+
+func TestRecoveredPanicIsLogged(t *testing.T) {
+	t.Parallel()
+
+	recorder := &recordingFieldLogger{}
+
+	cfg := &config.Config{}
+	cfg.Disabled = true
+	cfg.Redfish.StructuredLogging = true
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	redfish := router.Group("/redfish/v1", RedfishRequestIDMiddleware(), RedfishRecoveryMiddleware(recorder))
+	NewServiceRootRoutes(redfish, nil, cfg, testServiceCapabilities, recorder)
+	redfish.GET("/Systems/:id", func(_ *gin.Context) { panic("boom") })
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/redfish/v1/Systems/"+testSystemGUID, http.NoBody)
+
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), BaseErrorMessageID)
+
+	// The recovery inside the request log lets the entry record the 500
+	require.Len(t, recorder.entries, 1)
+	assert.Equal(t, "error", recorder.entries[0].level)
+	assert.Equal(t, http.StatusInternalServerError, recorder.entries[0].fields["status"])
+}
+
+func TestFormatLogFields(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, `a=1 b="two words" c=x`, formatLogFields(map[string]any{"c": "x", "a": 1, "b": "two words"}))
+	assert.Empty(t, formatLogFields(map[string]any{}))
+}
@@ -0,0 +1,69 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements request body size limits for the Redfish API v1.
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/config"
+)
+
+// DefaultMaxRequestBytes caps Redfish write request bodies when no limit is configured.
+const DefaultMaxRequestBytes int64 = 1 << 20
+
+// RedfishRequestSizeMiddleware rejects write requests whose body exceeds maxBytes with 413. The
+// body is read up front, so chunked requests without a Content-Length are limited as well, and
+// handlers see the same body through a fresh reader.
+func RedfishRequestSizeMiddleware(maxBytes int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+
+			return
+		}
+
+		if c.Request.ContentLength > maxBytes {
+			RequestEntityTooLargeError(c, maxBytes)
+			c.Abort()
+
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxBytes))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				RequestEntityTooLargeError(c, maxBytes)
+			} else {
+				MalformedJSONError(c, err)
+			}
+
+			c.Abort()
+
+			return
+		}
+
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		c.Next()
+	}
+}
+
+// maxRequestBytes returns the configured request body limit, falling back to DefaultMaxRequestBytes
+func maxRequestBytes(cfg *config.Config) int64 {
+	if cfg == nil || cfg.Redfish.MaxRequestBytes <= 0 {
+		return DefaultMaxRequestBytes
+	}
+
+	return cfg.Redfish.MaxRequestBytes
+}
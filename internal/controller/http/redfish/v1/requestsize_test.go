@@ -0,0 +1,116 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func TestRedfishRequestSizeMiddleware(t *testing.T) {
+	t.Parallel()
+
+	const limit = 16
+
+	tests := []struct {
+		name           string
+		method         string
+		body           string
+		chunked        bool
+		expectedStatus int
+	}{
+		{name: "just under the limit", method: http.MethodPost, body: strings.Repeat("a", limit-1), expectedStatus: http.StatusOK},
+		{name: "at the limit", method: http.MethodPatch, body: strings.Repeat("a", limit), expectedStatus: http.StatusOK},
+		{name: "just over the limit", method: http.MethodPost, body: strings.Repeat("a", limit+1), expectedStatus: http.StatusRequestEntityTooLarge},
+		{name: "chunked body over the limit", method: http.MethodPut, body: strings.Repeat("a", limit+1), chunked: true, expectedStatus: http.StatusRequestEntityTooLarge},
+		{name: "GET is not limited", method: http.MethodGet, body: strings.Repeat("a", limit+1), expectedStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.Use(RedfishRequestSizeMiddleware(limit))
+
+			var received string
+
+			router.Handle(tt.method, "/test", func(c *gin.Context) {
+				body, _ := io.ReadAll(c.Request.Body)
+				received = string(body)
+
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), tt.method, "/test", strings.NewReader(tt.body))
+
+			if tt.chunked {
+				req.ContentLength = -1
+			}
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tt.body, received, "the handler should see the full body")
+
+				return
+			}
+
+			assert.Contains(t, w.Body.String(), BaseRequestTooLargeID)
+			assert.Empty(t, received, "the handler should not run")
+		})
+	}
+}
+
+func TestMaxRequestBytes(t *testing.T) {
+	t.Parallel()
+
+	configured := &config.Config{}
+	configured.Redfish.MaxRequestBytes = 4096
+
+	assert.Equal(t, DefaultMaxRequestBytes, maxRequestBytes(nil))
+	assert.Equal(t, DefaultMaxRequestBytes, maxRequestBytes(&config.Config{}))
+	assert.Equal(t, int64(4096), maxRequestBytes(configured))
+}
+
+func TestOversizedResetRequest(t *testing.T) {
+	t.Parallel()
+
+	cfg := createTestConfig(true)
+	cfg.Redfish.MaxRequestBytes = 32
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	v1 := router.Group("/redfish/v1")
+	NewServiceRootRoutes(v1, nil, cfg, testServiceCapabilities, logger.New("test"))
+	v1.POST("/Systems/:id/Actions/ComputerSystem.Reset", postSystemResetHandler(nil, nil, NewTaskStore(), newDeviceLocks(), cfg, logger.New("test")))
+
+	body := `{"ResetType":"On","Padding":"` + strings.Repeat("x", 32) + `"}`
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost,
+		"/redfish/v1/Systems/"+testSystemID+"/Actions/ComputerSystem.Reset", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Contains(t, w.Body.String(), BaseRequestTooLargeID)
+}
@@ -0,0 +1,46 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements the Retry-After delay of Redfish API v1 error responses.
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/config"
+)
+
+// DefaultRetryAfterSeconds is the Retry-After delay of 502 and 503 responses when none is configured.
+const DefaultRetryAfterSeconds = 30
+
+// retryAfterContextKey holds the Retry-After seconds configured for 502 and 503 responses
+const retryAfterContextKey = "redfishRetryAfter"
+
+// RedfishRetryAfterMiddleware stores the Retry-After delay that BadGatewayError and
+// ServiceTemporarilyUnavailableError send when the failure gives no better estimate
+func RedfishRetryAfterMiddleware(seconds int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(retryAfterContextKey, seconds)
+		c.Next()
+	}
+}
+
+// retryAfter returns the Retry-After seconds stored by RedfishRetryAfterMiddleware, or DefaultRetryAfterSeconds
+func retryAfter(c *gin.Context) int {
+	if seconds := c.GetInt(retryAfterContextKey); seconds > 0 {
+		return seconds
+	}
+
+	return DefaultRetryAfterSeconds
+}
+
+// retryAfterSeconds returns the configured Retry-After delay, falling back to DefaultRetryAfterSeconds
+func retryAfterSeconds(cfg *config.Config) int {
+	if cfg == nil || cfg.Redfish.RetryAfterSeconds <= 0 {
+		return DefaultRetryAfterSeconds
+	}
+
+	return cfg.Redfish.RetryAfterSeconds
+}
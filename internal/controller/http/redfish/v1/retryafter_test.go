@@ -0,0 +1,71 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/device-management-toolkit/console/config"
+)
+
+func TestRedfishRetryAfterMiddleware(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{}
+	cfg.Redfish.ActionRate = 0.25
+	cfg.Redfish.ActionBurst = 1
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(RedfishRetryAfterMiddleware(7))
+	router.GET("/bad-gateway", BadGatewayError)
+	router.GET("/unavailable", ServiceTemporarilyUnavailableError)
+	router.GET("/limited", RedfishRateLimitMiddleware(cfg), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	tests := []struct {
+		path           string
+		expectedStatus int
+		expectedRetry  string
+	}{
+		{path: "/bad-gateway", expectedStatus: http.StatusBadGateway, expectedRetry: "7"},
+		{path: "/unavailable", expectedStatus: http.StatusServiceUnavailable, expectedRetry: "7"},
+		{path: "/limited", expectedStatus: http.StatusOK},
+		{path: "/limited", expectedStatus: http.StatusServiceUnavailable, expectedRetry: "4"},
+	}
+
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, tt.path, http.NoBody)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, tt.expectedStatus, w.Code, tt.path)
+		assert.Equal(t, tt.expectedRetry, w.Header().Get("Retry-After"), tt.path)
+
+		if tt.expectedRetry != "" {
+			_, err := strconv.Atoi(w.Header().Get("Retry-After"))
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestRetryAfterSeconds(t *testing.T) {
+	t.Parallel()
+
+	configured := &config.Config{}
+	configured.Redfish.RetryAfterSeconds = 5
+
+	assert.Equal(t, DefaultRetryAfterSeconds, retryAfterSeconds(nil))
+	assert.Equal(t, DefaultRetryAfterSeconds, retryAfterSeconds(&config.Config{}))
+	assert.Equal(t, 5, retryAfterSeconds(configured))
+}
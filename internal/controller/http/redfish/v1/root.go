@@ -10,7 +10,6 @@ import (
 	"crypto/rand"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/gin-gonic/gin"
 
@@ -25,6 +24,10 @@ const (
 	uuidVariantTenMask   = 0x80 // Variant 10 mask for UUID
 	uuidVersionClearMask = 0x0f // Clear version bits mask
 	uuidVariantClearMask = 0x3f // Clear variant bits mask
+
+	// Default branding reported by the service root when not configured
+	ServiceProduct = "Device Management Toolkit Console"
+	ServiceVendor  = "Intel Corporation"
 )
 
 // generateServiceUUID generates a UUID for the Redfish service root
@@ -103,15 +106,91 @@ func hasResourceExhaustion() bool {
 	return false // Currently always returns false - no resource monitoring implemented
 }
 
+// serviceBranding returns the product and vendor names for the service root, falling back to the defaults
+func serviceBranding(cfg *config.Config) (product, vendor string) {
+	product, vendor = ServiceProduct, ServiceVendor
+
+	if cfg == nil {
+		return product, vendor
+	}
+
+	if cfg.Redfish.Product != "" {
+		product = cfg.Redfish.Product
+	}
+
+	if cfg.Redfish.Vendor != "" {
+		vendor = cfg.Redfish.Vendor
+	}
+
+	return product, vendor
+}
+
+// Collections advertised by the service root once their routes are registered
+const (
+	chassisCollectionPath  = "/redfish/v1/Chassis"
+	managersCollectionPath = "/redfish/v1/Managers"
+)
+
+// ServiceCapabilities lists the route groups registered next to the service root, so that the
+// service root and the OData service document only link to resources that exist
+type ServiceCapabilities struct {
+	Systems          bool
+	Chassis          bool
+	Managers         bool
+	TaskService      bool
+	UpdateService    bool
+	AccountService   bool
+	EventService     bool
+	Registries       bool
+	TelemetryService bool
+}
+
+// serviceResource is a top-level resource linked from the service root
+type serviceResource struct{ name, url string }
+
+// resources returns the top-level resources of the registered route groups, in a fixed order
+func (sc ServiceCapabilities) resources() []serviceResource {
+	candidates := []struct {
+		registered bool
+		resource   serviceResource
+	}{
+		{sc.Systems, serviceResource{name: "Systems", url: systemsCollectionPath}},
+		{sc.Chassis, serviceResource{name: "Chassis", url: chassisCollectionPath}},
+		{sc.Managers, serviceResource{name: "Managers", url: managersCollectionPath}},
+		{sc.TaskService, serviceResource{name: "TaskService", url: taskServicePath}},
+		{sc.UpdateService, serviceResource{name: "UpdateService", url: updateServicePath}},
+		{sc.AccountService, serviceResource{name: "AccountService", url: accountServicePath}},
+		{sc.EventService, serviceResource{name: "EventService", url: eventServicePath}},
+		{sc.Registries, serviceResource{name: "Registries", url: registriesPath}},
+		{sc.TelemetryService, serviceResource{name: "TelemetryService", url: telemetryServicePath}},
+	}
+
+	resources := make([]serviceResource, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		if candidate.registered {
+			resources = append(resources, candidate.resource)
+		}
+	}
+
+	return resources
+}
+
 // serviceRootHandler handles the main service root endpoint
-func serviceRootHandler(c *gin.Context) {
+func serviceRootHandler(cfg *config.Config, caps ServiceCapabilities) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		handleServiceRoot(c, cfg, caps)
+	}
+}
+
+// handleServiceRoot writes the service root payload after running the service health checks
+func handleServiceRoot(c *gin.Context, cfg *config.Config, caps ServiceCapabilities) {
 	// Set Redfish-compliant headers
 	SetRedfishHeaders(c)
 
 	// Validate Accept header (406 Not Acceptable)
 	acceptHeader := c.GetHeader("Accept")
-	if acceptHeader != "" && acceptHeader != "*/*" && acceptHeader != "application/json" &&
-		!strings.Contains(acceptHeader, "application/json") && !strings.Contains(acceptHeader, "*/*") {
+	if !negotiateAccept(acceptHeader) {
 		NotAcceptableError(c, acceptHeader)
 
 		return
@@ -170,22 +249,34 @@ func serviceRootHandler(c *gin.Context) {
 		return
 	}
 
+	product, vendor := serviceBranding(cfg)
+	base := linkBase(c)
+
 	payload := map[string]any{
 		"@odata.type":    "#ServiceRoot.v1_11_0.ServiceRoot",
-		"@odata.id":      "/redfish/v1/",
+		"@odata.id":      base + "/",
 		"Id":             "RootService",
 		"Name":           "Redfish Root Service",
 		"RedfishVersion": "1.11.0",
 		"UUID":           serviceUUID,
-		"Systems":        map[string]any{"@odata.id": "/redfish/v1/Systems"},
-		"SessionService": map[string]any{"@odata.id": "/redfish/v1/SessionService"},
+		"SessionService": map[string]any{"@odata.id": base + "/SessionService"},
 		// Mandatory Links property with Sessions reference
 		"Links": map[string]any{
-			"Sessions": map[string]any{"@odata.id": "/redfish/v1/SessionService/Sessions"},
+			"Sessions": map[string]any{"@odata.id": base + "/SessionService/Sessions"},
 		},
 		// Optional but recommended properties (supported in v1_11_0)
-		"Product": "Device Management Toolkit Console",
-		"Vendor":  "Intel Corporation",
+		"Product": product,
+		"Vendor":  vendor,
+	}
+
+	// Link only the services whose routes are registered
+	for _, resource := range caps.resources() {
+		payload[resource.name] = map[string]any{"@odata.id": rebaseLink(base, resource.url)}
+	}
+
+	// Optional OEM metadata supplied by the deployment
+	if cfg != nil && len(cfg.Redfish.OEM) > 0 {
+		payload["Oem"] = cfg.Redfish.OEM
 	}
 
 	c.JSON(http.StatusOK, payload)
@@ -245,6 +336,11 @@ func registerSessionServiceRoutes(r *gin.RouterGroup) {
 	// Sessions collection endpoint (read-only, empty list for now)
 	r.GET("/SessionService/Sessions", sessionsCollectionHandler)
 
+	// Session login is advertised by the Allow header below but not implemented yet
+	r.POST("/SessionService/Sessions", func(c *gin.Context) {
+		NotImplementedError(c, "Session creation")
+	})
+
 	// Handle unsupported methods on Sessions collection with proper 405 responses
 	r.PUT("/SessionService/Sessions", func(c *gin.Context) {
 		MethodNotAllowedError(c, "retrieve sessions collection", "GET, POST")
@@ -262,14 +358,15 @@ func sessionServiceHandler(c *gin.Context) {
 	// Set Redfish-compliant headers
 	SetRedfishHeaders(c)
 
+	base := linkBase(c)
 	payload := map[string]any{
 		"@odata.type":    "#SessionService.v1_0_0.SessionService",
-		"@odata.id":      "/redfish/v1/SessionService",
+		"@odata.id":      base + "/SessionService",
 		"Id":             "SessionService",
 		"Name":           "Redfish Session Service",
 		"ServiceEnabled": true,
 		"SessionTimeout": 30,
-		"Sessions":       map[string]any{"@odata.id": "/redfish/v1/SessionService/Sessions"},
+		"Sessions":       map[string]any{"@odata.id": base + "/SessionService/Sessions"},
 	}
 
 	c.JSON(http.StatusOK, payload)
@@ -282,7 +379,7 @@ func sessionsCollectionHandler(c *gin.Context) {
 
 	payload := map[string]any{
 		"@odata.type":         "#SessionCollection.SessionCollection",
-		"@odata.id":           "/redfish/v1/SessionService/Sessions",
+		"@odata.id":           linkBase(c) + "/SessionService/Sessions",
 		"Name":                "Session Collection",
 		"Members@odata.count": 0,
 		"Members":             []any{},
@@ -325,30 +422,127 @@ func metadataHandler(c *gin.Context) {
 				<Property Name="Id" Type="Edm.String" Nullable="false"/>
 				<Property Name="Name" Type="Edm.String"/>
 				<Property Name="PowerState" Type="Edm.String"/>
+				<NavigationProperty Name="FirmwareInventory" Type="Redfish.SoftwareInventoryCollection"/>
+			</EntityType>
+			<EntityType Name="SoftwareInventoryCollection">
+				<Key><PropertyRef Name="Id"/></Key>
+				<Property Name="Id" Type="Edm.String" Nullable="false"/>
+				<Property Name="Name" Type="Edm.String"/>
+				<NavigationProperty Name="Members" Type="Collection(Redfish.SoftwareInventory)"/>
+			</EntityType>
+			<EntityType Name="SoftwareInventory">
+				<Key><PropertyRef Name="Id"/></Key>
+				<Property Name="Id" Type="Edm.String" Nullable="false"/>
+				<Property Name="Name" Type="Edm.String"/>
+				<Property Name="Version" Type="Edm.String"/>
+				<Property Name="SoftwareId" Type="Edm.String"/>
+				<Property Name="Updateable" Type="Edm.Boolean"/>
+				<NavigationProperty Name="RelatedItem" Type="Collection(Redfish.ComputerSystem)"/>
 			</EntityType>
 			<EntityContainer Name="Service">
 				<EntitySet Name="ServiceRoot" EntityType="Redfish.ServiceRoot"/>
 				<EntitySet Name="SessionService" EntityType="Redfish.SessionService"/>
 				<EntitySet Name="Sessions" EntityType="Redfish.Session"/>
 				<EntitySet Name="Systems" EntityType="Redfish.ComputerSystem"/>
+				<EntitySet Name="SoftwareInventoryCollection" EntityType="Redfish.SoftwareInventoryCollection"/>
+				<EntitySet Name="SoftwareInventory" EntityType="Redfish.SoftwareInventory"/>
 			</EntityContainer>
 		</Schema>
 	</edmx:DataServices>
 </edmx:Edmx>`)
 }
 
-// NewServiceRootRoutes registers Redfish API v1 service root routes
-func NewServiceRootRoutes(r *gin.RouterGroup, cfg *config.Config, l logger.Interface) {
-	// Apply Redfish-compliant recovery middleware for 500 errors
-	r.Use(RedfishRecoveryMiddleware())
+// odataServiceResources lists the top-level resources advertised by the OData service document:
+// the service root and session service, which are always registered, and the registered services
+func odataServiceResources(caps ServiceCapabilities) []serviceResource {
+	return append([]serviceResource{
+		{name: "Service", url: "/redfish/v1/"},
+		{name: "SessionService", url: "/redfish/v1/SessionService"},
+		{name: "Sessions", url: "/redfish/v1/SessionService/Sessions"},
+	}, caps.resources()...)
+}
+
+// odataServiceDocumentHandler serves the OData JSON service document listing the top-level resources
+func odataServiceDocumentHandler(caps ServiceCapabilities) gin.HandlerFunc {
+	resources := odataServiceResources(caps)
+
+	return func(c *gin.Context) {
+		base := linkBase(c)
+		value := make([]map[string]string, 0, len(resources))
+
+		for _, resource := range resources {
+			value = append(value, map[string]string{
+				"name": resource.name,
+				"kind": "Singleton",
+				"url":  rebaseLink(base, resource.url),
+			})
+		}
+
+		SetRedfishHeaders(c)
+		c.JSON(http.StatusOK, map[string]any{
+			"@odata.context": base + "/$metadata",
+			"value":          value,
+		})
+	}
+}
+
+// NewRedfishProtocolRoutes registers the Redfish protocol version document on the /redfish group.
+// Clients read it before the service root to discover the supported protocol versions,
+// so it is served without authentication.
+func NewRedfishProtocolRoutes(r *gin.RouterGroup, cfg *config.Config, l logger.Interface) {
+	r.Use(RedfishRecoveryMiddleware(l), RedfishBasePathMiddleware(cfg))
+	r.GET("", redfishProtocolHandler)
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		r.Handle(method, "", func(c *gin.Context) {
+			HTTPMethodNotAllowedError(c, c.Request.Method, "RedfishVersion", http.MethodGet)
+		})
+	}
+
+	l.Info("Registered Redfish protocol version document at %s", r.BasePath())
+}
+
+// redfishProtocolHandler returns the protocol version document
+func redfishProtocolHandler(c *gin.Context) {
+	SetRedfishHeaders(c)
+	c.JSON(http.StatusOK, map[string]string{"v1": linkBase(c) + "/"})
+}
+
+// NewServiceRootRoutes registers Redfish API v1 service root routes. accounts backs Basic
+// authentication when it is enabled, and caps names the other route groups registered on r, which
+// the service root links to.
+func NewServiceRootRoutes(r *gin.RouterGroup, accounts *AccountStore, cfg *config.Config, caps ServiceCapabilities, l logger.Interface) {
+	// Log each request once it completes, outside recovery so that recovered panics log as 500
+	if cfg.Redfish.StructuredLogging {
+		r.Use(RedfishRequestLogMiddleware(l))
+	}
+
+	// Point links at the configured base path
+	r.Use(RedfishBasePathMiddleware(cfg))
+
+	// Recover panics inside the request log as well, so that they log as 500; the router installs
+	// another recovery on the group to cover these middleware themselves
+	r.Use(RedfishRecoveryMiddleware(l))
+
+	// Answer in OData 4.0 and turn away clients that require another version
+	r.Use(RedfishODataVersionMiddleware())
 
 	// Apply Redfish-compliant authentication if auth is enabled
 	if !cfg.Disabled {
-		r.Use(RedfishJWTAuthMiddleware(cfg))
+		r.Use(RedfishJWTAuthMiddleware(cfg, accounts))
 	}
 
+	// Bound the request body of every write route registered on this group
+	r.Use(RedfishRequestSizeMiddleware(maxRequestBytes(cfg)))
+
+	// Tell clients how long to back off after a 502 or 503
+	r.Use(RedfishRetryAfterMiddleware(retryAfterSeconds(cfg)))
+
 	// Redfish Service Root (main entry point)
-	r.GET("/", serviceRootHandler)
+	serviceRoot := serviceRootHandler(cfg, caps)
+	r.GET("/", serviceRoot)
+	r.HEAD("/", headHandler(serviceRoot))
+	r.OPTIONS("/", optionsHandler("GET, HEAD"))
 
 	// Register method handlers for unsupported operations
 	registerServiceRootMethodHandlers(r)
@@ -357,8 +551,9 @@ func NewServiceRootRoutes(r *gin.RouterGroup, cfg *config.Config, l logger.Inter
 	// Register additional service routes
 	registerSessionServiceRoutes(r)
 
-	// OData Service Document
+	// OData metadata and service documents
 	r.GET("/$metadata", metadataHandler)
+	r.GET("/odata", odataServiceDocumentHandler(caps))
 
 	l.Info("Registered Redfish v1 Service Root at %s", r.BasePath())
 }
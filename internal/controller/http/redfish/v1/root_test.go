@@ -2,6 +2,10 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -9,6 +13,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/device-management-toolkit/console/config"
 	"github.com/device-management-toolkit/console/pkg/logger"
@@ -99,6 +104,17 @@ func TestHealthCheckFunctions(t *testing.T) {
 	})
 }
 
+// testServiceCapabilities matches the route groups registered by the console router
+var testServiceCapabilities = ServiceCapabilities{
+	Systems:          true,
+	TaskService:      true,
+	UpdateService:    true,
+	AccountService:   true,
+	EventService:     true,
+	Registries:       true,
+	TelemetryService: true,
+}
+
 // Test helper to create a test router with the service root routes
 func createTestRouter(cfg *config.Config) *gin.Engine {
 	gin.SetMode(gin.TestMode)
@@ -111,7 +127,7 @@ func createTestRouter(cfg *config.Config) *gin.Engine {
 	v1Group := router.Group("/redfish/v1")
 
 	// Register the service root routes
-	NewServiceRootRoutes(v1Group, cfg, l)
+	NewServiceRootRoutes(v1Group, nil, cfg, testServiceCapabilities, l)
 
 	return router
 }
@@ -159,7 +175,11 @@ func TestServiceRootEndpoint(t *testing.T) {
 				assert.Contains(t, body, `"Name":"Redfish Root Service"`)
 				assert.Contains(t, body, `"RedfishVersion":"1.11.0"`)
 				assert.Contains(t, body, `"Systems":{"@odata.id":"/redfish/v1/Systems"}`)
+				assert.Contains(t, body, `"EventService":{"@odata.id":"/redfish/v1/EventService"}`)
 				assert.Contains(t, body, `"SessionService":{"@odata.id":"/redfish/v1/SessionService"}`)
+				assert.Contains(t, body, `"UpdateService":{"@odata.id":"/redfish/v1/UpdateService"}`)
+				assert.Contains(t, body, `"TaskService":{"@odata.id":"/redfish/v1/TaskService"}`)
+				assert.Contains(t, body, `"AccountService":{"@odata.id":"/redfish/v1/AccountService"}`)
 				assert.Contains(t, body, `"Links":{"Sessions":{"@odata.id":"/redfish/v1/SessionService/Sessions"}}`)
 				assert.Contains(t, body, `"Product":"Device Management Toolkit Console"`)
 				assert.Contains(t, body, `"Vendor":"Intel Corporation"`)
@@ -285,6 +305,91 @@ func TestServiceRootEndpoint(t *testing.T) {
 	}
 }
 
+// TestServiceRootBranding tests that configured branding overrides the service root defaults
+func TestServiceRootBranding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		redfish       config.Redfish
+		checkResponse func(t *testing.T, body map[string]any)
+	}{
+		{
+			name:    "defaults apply when config is empty",
+			redfish: config.Redfish{},
+			checkResponse: func(t *testing.T, body map[string]any) {
+				t.Helper()
+				assert.Equal(t, ServiceProduct, body["Product"])
+				assert.Equal(t, ServiceVendor, body["Vendor"])
+				assert.NotContains(t, body, "Oem")
+			},
+		},
+		{
+			name: "product and vendor overrides",
+			redfish: config.Redfish{
+				Product: "Acme Fleet Manager",
+				Vendor:  "Acme Inc.",
+			},
+			checkResponse: func(t *testing.T, body map[string]any) {
+				t.Helper()
+				assert.Equal(t, "Acme Fleet Manager", body["Product"])
+				assert.Equal(t, "Acme Inc.", body["Vendor"])
+			},
+		},
+		{
+			name: "partial override keeps remaining default",
+			redfish: config.Redfish{
+				Vendor: "Acme Inc.",
+			},
+			checkResponse: func(t *testing.T, body map[string]any) {
+				t.Helper()
+				assert.Equal(t, ServiceProduct, body["Product"])
+				assert.Equal(t, "Acme Inc.", body["Vendor"])
+			},
+		},
+		{
+			name: "custom OEM metadata",
+			redfish: config.Redfish{
+				OEM: map[string]map[string]any{
+					"Contoso": {"SupportURL": "https://support.example.com"},
+				},
+			},
+			checkResponse: func(t *testing.T, body map[string]any) {
+				t.Helper()
+
+				oem, ok := body["Oem"].(map[string]any)
+				require.True(t, ok, "Oem should be an object")
+
+				contoso, ok := oem["Contoso"].(map[string]any)
+				require.True(t, ok, "Oem members should be namespaced by vendor")
+				assert.Equal(t, "https://support.example.com", contoso["SupportURL"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := createTestConfig(true)
+			cfg.Redfish = tt.redfish
+
+			router := createTestRouter(cfg)
+
+			req, _ := http.NewRequestWithContext(context.Background(), httpMethodGET, "/redfish/v1/", http.NoBody)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var body map[string]any
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			tt.checkResponse(t, body)
+		})
+	}
+}
+
 // TestSessionServiceEndpoints tests SessionService related endpoints
 func TestSessionServiceEndpoints(t *testing.T) {
 	t.Parallel()
@@ -360,6 +465,17 @@ func TestSessionServiceEndpoints(t *testing.T) {
 				assert.Contains(t, body, "not supported")
 			},
 		},
+		{
+			name:           "Sessions collection POST not implemented",
+			path:           "/redfish/v1/SessionService/Sessions",
+			method:         "POST",
+			expectedStatus: http.StatusNotImplemented,
+			checkResponse: func(t *testing.T, body string, _ http.Header) {
+				t.Helper()
+				assert.Contains(t, body, `"Base.1.11.0.ActionNotSupported"`)
+				assert.Contains(t, body, "Session creation")
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -480,10 +596,205 @@ func TestMetadataEndpoint(t *testing.T) {
 		assert.Contains(t, body, `<EntityType Name="ServiceRoot">`)
 		assert.Contains(t, body, `<EntityType Name="SessionService">`)
 		assert.Contains(t, body, `<EntityType Name="ComputerSystem">`)
+		assert.Contains(t, body, `<EntityType Name="SoftwareInventory">`)
+		assert.Contains(t, body, `<EntityType Name="SoftwareInventoryCollection">`)
+		assert.Contains(t, body, `<EntitySet Name="SoftwareInventory" EntityType="Redfish.SoftwareInventory"/>`)
+
+		// The document must stay well-formed XML
+		decoder := xml.NewDecoder(strings.NewReader(body))
+
+		for {
+			_, err := decoder.Token()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			require.NoError(t, err)
+		}
 		assert.Contains(t, body, `<EntityContainer Name="Service">`)
 	})
 }
 
+// TestODataServiceDocument tests the OData service document endpoint
+func TestODataServiceDocument(t *testing.T) {
+	t.Parallel()
+
+	router := createTestRouter(createTestConfig(true))
+
+	req, _ := http.NewRequestWithContext(context.Background(), "GET", "/redfish/v1/odata", http.NoBody)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "4.0", w.Header().Get("OData-Version"))
+
+	var document struct {
+		Context string `json:"@odata.context"`
+		Value   []struct {
+			Name string `json:"name"`
+			Kind string `json:"kind"`
+			URL  string `json:"url"`
+		} `json:"value"`
+	}
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &document))
+	assert.Equal(t, "/redfish/v1/$metadata", document.Context)
+
+	urls := make(map[string]string, len(document.Value))
+
+	for _, resource := range document.Value {
+		assert.Equal(t, "Singleton", resource.Kind)
+
+		urls[resource.Name] = resource.URL
+	}
+
+	assert.Equal(t, "/redfish/v1/", urls["Service"])
+	assert.Equal(t, "/redfish/v1/Systems", urls["Systems"])
+	assert.Equal(t, "/redfish/v1/SessionService", urls["SessionService"])
+	assert.Equal(t, "/redfish/v1/TaskService", urls["TaskService"])
+}
+
+// TestServiceRootCapabilities tests that the service root and OData service document only link to
+// the registered route groups
+func TestServiceRootCapabilities(t *testing.T) {
+	t.Parallel()
+
+	optional := []string{"Systems", "Chassis", "Managers", "TaskService", "UpdateService", "AccountService", "EventService", "Registries", "TelemetryService"}
+
+	tests := []struct {
+		name     string
+		caps     ServiceCapabilities
+		expected map[string]string
+	}{
+		{
+			name:     "nothing registered",
+			caps:     ServiceCapabilities{},
+			expected: map[string]string{},
+		},
+		{
+			name: "console route groups",
+			caps: testServiceCapabilities,
+			expected: map[string]string{
+				"Systems":          "/redfish/v1/Systems",
+				"TaskService":      "/redfish/v1/TaskService",
+				"UpdateService":    "/redfish/v1/UpdateService",
+				"AccountService":   "/redfish/v1/AccountService",
+				"EventService":     "/redfish/v1/EventService",
+				"Registries":       "/redfish/v1/Registries",
+				"TelemetryService": "/redfish/v1/TelemetryService",
+			},
+		},
+		{
+			name: "chassis and managers",
+			caps: ServiceCapabilities{Systems: true, Chassis: true, Managers: true},
+			expected: map[string]string{
+				"Systems":  "/redfish/v1/Systems",
+				"Chassis":  "/redfish/v1/Chassis",
+				"Managers": "/redfish/v1/Managers",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			NewServiceRootRoutes(router.Group("/redfish/v1"), nil, createTestConfig(true), tt.caps, logger.New("test"))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/redfish/v1/", http.NoBody)
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var root map[string]any
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &root))
+			assert.Equal(t, map[string]any{"@odata.id": "/redfish/v1/SessionService"}, root["SessionService"])
+
+			w = httptest.NewRecorder()
+			req, _ = http.NewRequestWithContext(context.Background(), http.MethodGet, "/redfish/v1/odata", http.NoBody)
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var document struct {
+				Value []struct {
+					Name string `json:"name"`
+					URL  string `json:"url"`
+				} `json:"value"`
+			}
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &document))
+
+			urls := make(map[string]string, len(document.Value))
+			for _, resource := range document.Value {
+				urls[resource.Name] = resource.URL
+			}
+
+			assert.Equal(t, "/redfish/v1/SessionService", urls["SessionService"])
+
+			for _, name := range optional {
+				expected, registered := tt.expected[name]
+				if !registered {
+					assert.NotContains(t, root, name)
+					assert.NotContains(t, urls, name)
+
+					continue
+				}
+
+				assert.Equal(t, map[string]any{"@odata.id": expected}, root[name], name)
+				assert.Equal(t, expected, urls[name], name)
+			}
+		})
+	}
+}
+
+// TestRedfishProtocolRoutes tests the /redfish protocol version document
+func TestRedfishProtocolRoutes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		method         string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{name: "GET returns the version document", method: http.MethodGet, expectedStatus: http.StatusOK, expectedBody: `{"v1":"/redfish/v1/"}`},
+		{name: "POST is not allowed", method: http.MethodPost, expectedStatus: http.StatusMethodNotAllowed, expectedBody: BaseOperationNotAllowedID},
+		{name: "PUT is not allowed", method: http.MethodPut, expectedStatus: http.StatusMethodNotAllowed, expectedBody: BaseOperationNotAllowedID},
+		{name: "PATCH is not allowed", method: http.MethodPatch, expectedStatus: http.StatusMethodNotAllowed, expectedBody: BaseOperationNotAllowedID},
+		{name: "DELETE is not allowed", method: http.MethodDelete, expectedStatus: http.StatusMethodNotAllowed, expectedBody: BaseOperationNotAllowedID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			NewRedfishProtocolRoutes(router.Group("/redfish"), &config.Config{}, logger.New("test"))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), tt.method, "/redfish", http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, "4.0", w.Header().Get("OData-Version"))
+
+			if tt.expectedStatus == http.StatusOK {
+				assert.JSONEq(t, tt.expectedBody, w.Body.String())
+			} else {
+				assert.Contains(t, w.Body.String(), tt.expectedBody)
+				assert.Equal(t, http.MethodGet, w.Header().Get("Allow"))
+			}
+		})
+	}
+}
+
 // TestNewServiceRootRoutes tests the route registration function
 func TestNewServiceRootRoutes(t *testing.T) {
 	t.Parallel()
@@ -516,7 +827,7 @@ func TestNewServiceRootRoutes(t *testing.T) {
 
 			// This should not panic and should register routes successfully
 			assert.NotPanics(t, func() {
-				NewServiceRootRoutes(v1Group, cfg, l)
+				NewServiceRootRoutes(v1Group, nil, cfg, testServiceCapabilities, l)
 			})
 
 			// Test that routes are actually registered by making a simple request
@@ -0,0 +1,80 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements Redfish answers for unmatched routes and methods.
+package v1
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RedfishNoRouteHandler answers unmatched paths in the /redfish tree with a Redfish 404 carrying the
+// requested URI, and passes every other unmatched path to fallback. gin has one NoRoute handler per
+// engine, so it wraps the engine's existing fallback instead of being registered on a group.
+func RedfishNoRouteHandler(fallback gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !isRedfishPath(c.Request.URL.Path) {
+			fallback(c)
+
+			return
+		}
+
+		ResourceNotFoundError(c, "Resource", c.Request.URL.RequestURI())
+	}
+}
+
+// allowedMethodOrder is the order methods are listed in a computed Allow header
+var allowedMethodOrder = []string{
+	http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions,
+}
+
+// RedfishNoMethodHandler answers a /redfish path requested with an unregistered method with a Redfish
+// 405, listing the path's registered methods in the Allow header. It requires the engine's
+// HandleMethodNotAllowed, which computes those methods, and like RedfishNoRouteHandler it passes
+// every other path to fallback, without the Allow header.
+func RedfishNoMethodHandler(fallback gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		allowed := c.Writer.Header().Get("Allow")
+
+		if !isRedfishPath(c.Request.URL.Path) {
+			c.Writer.Header().Del("Allow")
+			fallback(c)
+
+			return
+		}
+
+		HTTPMethodNotAllowedError(c, c.Request.Method, "Resource", sortAllowedMethods(allowed))
+	}
+}
+
+// sortAllowedMethods orders a comma separated method list by allowedMethodOrder, keeping any
+// other methods after them in their original order
+func sortAllowedMethods(allowed string) string {
+	methods := strings.Split(allowed, ",")
+	for i := range methods {
+		methods[i] = strings.TrimSpace(methods[i])
+	}
+
+	rank := func(method string) int {
+		if i := slices.Index(allowedMethodOrder, method); i >= 0 {
+			return i
+		}
+
+		return len(allowedMethodOrder)
+	}
+
+	slices.SortStableFunc(methods, func(a, b string) int { return rank(a) - rank(b) })
+
+	return strings.Join(methods, ", ")
+}
+
+// isRedfishPath reports whether path is in the /redfish tree
+func isRedfishPath(path string) bool {
+	return path == "/redfish" || strings.HasPrefix(path, "/redfish/")
+}
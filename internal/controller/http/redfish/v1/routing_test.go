@@ -0,0 +1,139 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedfishNoRouteHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		path          string
+		expectedURI   string
+		expectRedfish bool
+	}{
+		{name: "unknown Redfish resource", path: "/redfish/v1/Nonexistent", expectedURI: "/redfish/v1/Nonexistent", expectRedfish: true},
+		{name: "query string is kept", path: "/redfish/v1/Nonexistent?$top=1", expectedURI: "/redfish/v1/Nonexistent?$top=1", expectRedfish: true},
+		{name: "unknown Redfish version", path: "/redfish/v2", expectedURI: "/redfish/v2", expectRedfish: true},
+		{name: "console path falls back", path: "/devices/list"},
+		{name: "similar prefix falls back", path: "/redfishy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/redfish/v1/Systems", func(c *gin.Context) { c.Status(http.StatusOK) })
+			router.NoRoute(RedfishNoRouteHandler(func(c *gin.Context) { c.String(http.StatusOK, "index") }))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, tt.path, http.NoBody)
+			router.ServeHTTP(w, req)
+
+			if !tt.expectRedfish {
+				assert.Equal(t, http.StatusOK, w.Code)
+				assert.Equal(t, "index", w.Body.String())
+
+				return
+			}
+
+			assert.Equal(t, http.StatusNotFound, w.Code)
+			assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+			assert.Equal(t, "4.0", w.Header().Get("OData-Version"))
+
+			var body struct {
+				Error struct {
+					Code         string `json:"code"`
+					ExtendedInfo []struct {
+						MessageID   string   `json:"MessageId"`
+						MessageArgs []string `json:"MessageArgs"`
+					} `json:"@Message.ExtendedInfo"`
+				} `json:"error"`
+			}
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, BaseResourceNotFoundID, body.Error.Code)
+			require.Len(t, body.Error.ExtendedInfo, 1)
+			assert.Equal(t, BaseResourceNotFoundID, body.Error.ExtendedInfo[0].MessageID)
+			assert.Contains(t, body.Error.ExtendedInfo[0].MessageArgs, tt.expectedURI)
+		})
+	}
+}
+
+func TestRedfishNoMethodHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		expectedAllow string
+	}{
+		{name: "unregistered method on an instance", method: http.MethodDelete, path: "/redfish/v1/Systems/" + testSystemID, expectedAllow: "GET, HEAD, PATCH"},
+		{name: "unregistered method on an action", method: http.MethodGet, path: "/redfish/v1/Systems/" + testSystemID + "/Actions/ComputerSystem.Reset", expectedAllow: "POST"},
+		{name: "console path falls back", method: http.MethodDelete, path: "/api/v1/devices"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.HandleMethodNotAllowed = true
+			// Registered out of order so the Allow header has to be sorted
+			router.PATCH("/redfish/v1/Systems/:id", ok)
+			router.GET("/redfish/v1/Systems/:id", ok)
+			router.HEAD("/redfish/v1/Systems/:id", ok)
+			router.POST("/redfish/v1/Systems/:id/Actions/ComputerSystem.Reset", ok)
+			router.GET("/api/v1/devices", ok)
+
+			fallback := func(c *gin.Context) { c.String(http.StatusOK, "index") }
+			router.NoMethod(RedfishNoMethodHandler(fallback))
+			router.NoRoute(RedfishNoRouteHandler(fallback))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), tt.method, tt.path, http.NoBody)
+			router.ServeHTTP(w, req)
+
+			if tt.expectedAllow == "" {
+				assert.Equal(t, http.StatusOK, w.Code)
+				assert.Equal(t, "index", w.Body.String())
+				assert.Empty(t, w.Header().Get("Allow"))
+
+				return
+			}
+
+			assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+			assert.Equal(t, tt.expectedAllow, w.Header().Get("Allow"))
+			assert.Contains(t, w.Body.String(), BaseOperationNotAllowedID)
+			assert.Contains(t, w.Body.String(), tt.method)
+		})
+	}
+}
+
+func TestSortAllowedMethods(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "GET, HEAD, PATCH", sortAllowedMethods("PATCH, HEAD,GET"))
+	assert.Equal(t, "GET, DELETE, OPTIONS, PROPFIND", sortAllowedMethods("PROPFIND, OPTIONS, DELETE, GET"))
+	assert.Equal(t, "POST", sortAllowedMethods("POST"))
+}
@@ -0,0 +1,120 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements Redfish API v1 SecureBoot resources.
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/config"
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// SecureBoot constants
+const (
+	secureBootEnabled  = "Enabled"
+	secureBootDisabled = "Disabled"
+	// secureBootProperty is the AMT boot setting that reports Secure Boot enforcement
+	secureBootProperty = "EnforceSecureBoot"
+)
+
+// SecureBoot represents a Redfish SecureBoot resource.
+// SecureBootEnable is null when the platform does not report Secure Boot.
+type SecureBoot struct {
+	ODataID               string `json:"@odata.id"`
+	ODataType             string `json:"@odata.type"`
+	ID                    string `json:"Id"`
+	Name                  string `json:"Name"`
+	SecureBootEnable      *bool  `json:"SecureBootEnable"`
+	SecureBootCurrentBoot string `json:"SecureBootCurrentBoot"`
+}
+
+// NewSecureBootRoutes registers the Redfish SecureBoot route for Systems
+// It exposes:
+// - GET /redfish/v1/Systems/:id/SecureBoot
+// Write methods answer 405 until Secure Boot can be configured.
+func NewSecureBootRoutes(systems *gin.RouterGroup, d devices.Feature, cfg *config.Config, l logger.Interface) {
+	systems.GET(":id/SecureBoot", getSecureBootHandler(d, cfg, l))
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		systems.Handle(method, ":id/SecureBoot", func(c *gin.Context) {
+			HTTPMethodNotAllowedError(c, c.Request.Method, "SecureBoot", http.MethodGet)
+		})
+	}
+
+	l.Info("Registered Redfish SecureBoot routes under %s", systems.BasePath())
+}
+
+func secureBootPath(base, systemID string) string {
+	return systemPath(base, systemID) + "/SecureBoot"
+}
+
+// getSecureBootHandler reports the Secure Boot state from the hardware info. A failed read is
+// logged and reported as Secure Boot disabled, except for unknown systems which yield 404.
+func getSecureBootHandler(d devices.Feature, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+
+	return func(c *gin.Context) {
+		systemID := c.Param("id")
+
+		if !validateSystemID(c, systemID) {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		var enabled *bool
+
+		hwInfo, err := d.GetHardwareInfo(ctx, systemID)
+		if err != nil {
+			var nfErr sqldb.NotFoundError
+			if errors.As(err, &nfErr) {
+				ResourceNotFoundError(c, "ComputerSystem", systemID)
+
+				return
+			}
+
+			l.Warn("redfish v1 - SecureBoot: failed to get hardware info for system %s: %v [request %s]", systemID, err, requestID(c))
+		} else {
+			enabled = secureBootEnforced(hwInfo)
+		}
+
+		current := secureBootDisabled
+		if enabled != nil && *enabled {
+			current = secureBootEnabled
+		}
+
+		SetRedfishHeaders(c)
+		c.JSON(http.StatusOK, SecureBoot{
+			ODataID:               secureBootPath(linkBase(c), systemID),
+			ODataType:             "#SecureBoot.v1_0_0.SecureBoot",
+			ID:                    "SecureBoot",
+			Name:                  "UEFI Secure Boot",
+			SecureBootEnable:      enabled,
+			SecureBootCurrentBoot: current,
+		})
+	}
+}
+
+// secureBootEnforced reads the Secure Boot enforcement flag from the BIOS element of the hardware
+// info, or returns nil when the platform does not report it
+func secureBootEnforced(hwInfo dto.HardwareInfo) *bool {
+	for _, item := range cimItems(hwInfo.CIMBIOSElement) {
+		if value, ok := item[secureBootProperty].(bool); ok {
+			return &value
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+func biosHardwareInfo(bios map[string]any) dto.HardwareInfo {
+	return dto.HardwareInfo{CIMBIOSElement: dto.CIMResponse{Response: bios}}
+}
+
+func TestGetSecureBootHandler(t *testing.T) {
+	t.Parallel()
+
+	enabled, disabled := true, false
+
+	tests := []struct {
+		name            string
+		systemID        string
+		setupMocks      func(*mocks.MockDeviceManagementFeature, *mocks.MockLogger)
+		expectedStatus  int
+		expectedEnable  *bool
+		expectedCurrent string
+		expectedBody    string
+	}{
+		{
+			name:     "enabled",
+			systemID: testSystemID,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemID).
+					Return(biosHardwareInfo(map[string]any{"Version": "1.0", secureBootProperty: true}), nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedEnable:  &enabled,
+			expectedCurrent: secureBootEnabled,
+		},
+		{
+			name:     "disabled",
+			systemID: testSystemID,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemID).
+					Return(biosHardwareInfo(map[string]any{"Version": "1.0", secureBootProperty: false}), nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedEnable:  &disabled,
+			expectedCurrent: secureBootDisabled,
+		},
+		{
+			name:     "not reported by the platform",
+			systemID: testSystemID,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemID).
+					Return(biosHardwareInfo(map[string]any{"Version": "1.0"}), nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedCurrent: secureBootDisabled,
+		},
+		{
+			name:     "hardware info unavailable",
+			systemID: testSystemID,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemID).
+					Return(dto.HardwareInfo{}, fmt.Errorf("wsman failure"))
+				mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).Times(1)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedCurrent: secureBootDisabled,
+		},
+		{
+			name:     "unknown system",
+			systemID: testSystemID,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemID).
+					Return(dto.HardwareInfo{}, devices.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   BaseResourceNotFoundID,
+		},
+		{
+			name:           "malformed id",
+			systemID:       "not-a-guid",
+			setupMocks:     func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   BaseResourceNotFoundID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockLogger := mocks.NewMockLogger(ctrl)
+
+			tt.setupMocks(mockFeature, mockLogger)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/redfish/v1/Systems/:id/SecureBoot", getSecureBootHandler(mockFeature, nil, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, secureBootPath(DefaultRedfishBasePath, tt.systemID), http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedBody)
+
+				return
+			}
+
+			var secureBoot SecureBoot
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &secureBoot))
+			assert.Equal(t, "#SecureBoot.v1_0_0.SecureBoot", secureBoot.ODataType)
+			assert.Equal(t, secureBootPath(DefaultRedfishBasePath, tt.systemID), secureBoot.ODataID)
+			assert.Equal(t, tt.expectedEnable, secureBoot.SecureBootEnable)
+			assert.Equal(t, tt.expectedCurrent, secureBoot.SecureBootCurrentBoot)
+		})
+	}
+}
+
+func TestSecureBootWriteMethods(t *testing.T) {
+	t.Parallel()
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		t.Run(method, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).Times(1)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			NewSecureBootRoutes(router.Group("/redfish/v1/Systems"), mocks.NewMockDeviceManagementFeature(ctrl), nil, mockLogger)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), method, secureBootPath(DefaultRedfishBasePath, testSystemID), http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+			assert.Equal(t, http.MethodGet, w.Header().Get("Allow"))
+			assert.Contains(t, w.Body.String(), BaseOperationNotAllowedID)
+		})
+	}
+}
@@ -0,0 +1,303 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements Redfish API v1 Storage resources.
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/config"
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// Storage constants
+const (
+	// storageID identifies the single storage subsystem AMT reports for a system
+	storageID        = "1"
+	mediaTypeSSD     = "SSD"
+	bytesPerKibibyte = 1024
+)
+
+// Drive represents a Redfish Drive built from a CIM_MediaAccessDevice and its CIM_PhysicalPackage.
+// Status.Health follows the device's OperationalStatus, where AMT reports a SMART predicted failure.
+type Drive struct {
+	ODataID       string `json:"@odata.id"`
+	ODataType     string `json:"@odata.type"`
+	ID            string `json:"Id"`
+	Name          string `json:"Name"`
+	CapacityBytes int64  `json:"CapacityBytes,omitempty"`
+	MediaType     string `json:"MediaType,omitempty"`
+	Model         string `json:"Model,omitempty"`
+	SerialNumber  string `json:"SerialNumber,omitempty"`
+	Status        Status `json:"Status"`
+}
+
+// NewStorageRoutes registers Redfish Storage routes for Systems
+// It exposes:
+// - GET /redfish/v1/Systems/:id/Storage
+// - GET /redfish/v1/Systems/:id/Storage/:storageId
+// - GET /redfish/v1/Systems/:id/Storage/:storageId/Drives/:driveId
+func NewStorageRoutes(systems *gin.RouterGroup, d devices.Feature, cfg *config.Config, l logger.Interface) {
+	systems.GET(":id/Storage", getStorageCollectionHandler(d, cfg, l))
+	systems.GET(":id/Storage/:storageId", getStorageHandler(d, cfg, l))
+	systems.GET(":id/Storage/:storageId/Drives/:driveId", getDriveHandler(d, cfg, l))
+
+	l.Info("Registered Redfish Storage routes under %s", systems.BasePath())
+}
+
+func storagePath(base, systemID string) string {
+	return systemPath(base, systemID) + "/Storage"
+}
+
+// getStorageCollectionHandler lists the storage subsystem, or nothing when the system reports no drives
+func getStorageCollectionHandler(d devices.Feature, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+
+	return func(c *gin.Context) {
+		systemID := c.Param("id")
+
+		if !validateSystemID(c, systemID) {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		drives, err := fetchDrives(ctx, d, linkBase(c), systemID)
+		if err != nil {
+			diskInfoError(c, l, err, systemID)
+
+			return
+		}
+
+		members := []any{}
+		if len(drives) > 0 {
+			members = append(members, map[string]any{"@odata.id": storagePath(linkBase(c), systemID) + "/" + storageID})
+		}
+
+		SetRedfishHeaders(c)
+		c.JSON(http.StatusOK, map[string]any{
+			"@odata.type":         "#StorageCollection.StorageCollection",
+			"@odata.id":           storagePath(linkBase(c), systemID),
+			"Name":                "Storage Collection",
+			"Members@odata.count": len(members),
+			"Members":             members,
+		})
+	}
+}
+
+func getStorageHandler(d devices.Feature, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+
+	return func(c *gin.Context) {
+		systemID := c.Param("id")
+
+		if !validateSystemID(c, systemID) {
+			return
+		}
+
+		if c.Param("storageId") != storageID {
+			ResourceNotFoundError(c, "Storage", c.Param("storageId"))
+
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		drives, err := fetchDrives(ctx, d, linkBase(c), systemID)
+		if err != nil {
+			diskInfoError(c, l, err, systemID)
+
+			return
+		}
+
+		links := make([]any, 0, len(drives))
+		for i := range drives {
+			links = append(links, map[string]any{"@odata.id": drives[i].ODataID})
+		}
+
+		SetRedfishHeaders(c)
+		c.JSON(http.StatusOK, map[string]any{
+			"@odata.type":        "#Storage.v1_7_1.Storage",
+			"@odata.id":          storagePath(linkBase(c), systemID) + "/" + storageID,
+			"Id":                 storageID,
+			"Name":               "Local Storage",
+			"Drives@odata.count": len(links),
+			"Drives":             links,
+		})
+	}
+}
+
+func getDriveHandler(d devices.Feature, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+
+	return func(c *gin.Context) {
+		systemID := c.Param("id")
+		driveID := c.Param("driveId")
+
+		if !validateSystemID(c, systemID) {
+			return
+		}
+
+		if c.Param("storageId") != storageID {
+			ResourceNotFoundError(c, "Storage", c.Param("storageId"))
+
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		drives, err := fetchDrives(ctx, d, linkBase(c), systemID)
+		if err != nil {
+			diskInfoError(c, l, err, systemID)
+
+			return
+		}
+
+		for i := range drives {
+			if drives[i].ID == driveID {
+				SetRedfishHeaders(c)
+				c.JSON(http.StatusOK, drives[i])
+
+				return
+			}
+		}
+
+		ResourceNotFoundError(c, "Drive", driveID)
+	}
+}
+
+// diskInfoError writes the Redfish error for a failed disk info read
+func diskInfoError(c *gin.Context, l logger.Interface, err error, systemID string) {
+	var nfErr sqldb.NotFoundError
+	if errors.As(err, &nfErr) {
+		ResourceNotFoundError(c, "ComputerSystem", systemID)
+
+		return
+	}
+
+	l.Error(err, "http - redfish - Storage for %s [request %s]", systemID, requestID(c))
+	deviceCallError(c, err)
+}
+
+// fetchDrives reads the disk info of a system and converts it to Drive resources linked under base
+func fetchDrives(ctx context.Context, d devices.Feature, base, systemID string) ([]Drive, error) {
+	diskInfo, err := d.GetDiskInfo(ctx, systemID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildDrives(base, systemID, diskInfo), nil
+}
+
+// buildDrives numbers the media access devices from 1 and pairs each with the physical package
+// at the same position, which carries the model and serial number
+func buildDrives(base, systemID string, diskInfo dto.DiskInfo) []Drive {
+	devicesInfo := cimItems(diskInfo.CIMMediaAccessDevice)
+	packages := cimItems(diskInfo.CIMPhysicalPackage)
+	drives := make([]Drive, 0, len(devicesInfo))
+
+	for i, device := range devicesInfo {
+		id := strconv.Itoa(i + 1)
+		drive := Drive{
+			ODataID:   storagePath(base, systemID) + "/" + storageID + "/Drives/" + id,
+			ODataType: "#Drive.v1_5_0.Drive",
+			ID:        id,
+			Name:      cimString(device, "ElementName"),
+			Status:    Status{State: systemStateEnabled, Health: cimItemHealth(device)},
+		}
+
+		if size, ok := device["MaxMediaSize"].(float64); ok {
+			drive.CapacityBytes = int64(size) * bytesPerKibibyte
+		}
+
+		if i < len(packages) {
+			drive.Model = cimString(packages[i], "Model")
+			drive.SerialNumber = cimString(packages[i], "SerialNumber")
+		}
+
+		drive.MediaType = driveMediaType(drive.Name, drive.Model)
+
+		if drive.Name == "" {
+			drive.Name = "Drive " + id
+		}
+
+		drives = append(drives, drive)
+	}
+
+	return drives
+}
+
+// driveMediaType infers the Redfish MediaType from the device description. CIM does not report
+// whether a drive is rotational, so only solid state drives are recognised.
+func driveMediaType(descriptions ...string) string {
+	for _, description := range descriptions {
+		upper := strings.ToUpper(description)
+		if strings.Contains(upper, "SSD") || strings.Contains(upper, "NVME") || strings.Contains(upper, "SOLID STATE") {
+			return mediaTypeSSD
+		}
+	}
+
+	return ""
+}
+
+// cimItems flattens a CIM response into its instances. AMT returns an instance either as a single
+// object or as a slice of objects, and the devices feature may hand over typed structs or decoded
+// JSON, so each value is normalized through JSON.
+func cimItems(response dto.CIMResponse) []map[string]any {
+	values := make([]any, 0, len(response.Responses)+1)
+	if response.Response != nil {
+		values = append(values, response.Response)
+	}
+
+	values = append(values, response.Responses...)
+
+	items := []map[string]any{}
+
+	for _, value := range values {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			continue
+		}
+
+		var decoded any
+		if err := json.Unmarshal(raw, &decoded); err != nil {
+			continue
+		}
+
+		switch v := decoded.(type) {
+		case map[string]any:
+			items = append(items, v)
+		case []any:
+			for _, element := range v {
+				if item, ok := element.(map[string]any); ok {
+					items = append(items, item)
+				}
+			}
+		}
+	}
+
+	return items
+}
+
+// cimString returns a string property of a CIM instance, or "" when absent
+func cimString(item map[string]any, property string) string {
+	value, _ := item[property].(string)
+
+	return value
+}
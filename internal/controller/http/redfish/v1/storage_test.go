@@ -0,0 +1,268 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/mediaaccess"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/physical"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+// populatedDiskInfo mirrors the shape GetDiskInfo returns: each CIM class holds one slice of instances
+func populatedDiskInfo() dto.DiskInfo {
+	return dto.DiskInfo{
+		CIMMediaAccessDevice: dto.CIMResponse{Responses: []interface{}{[]mediaaccess.MediaAccessDevice{
+			{DeviceID: "MEDIA DEV 0", ElementName: "Managed System NVMe SSD", MaxMediaSize: 500107608},
+			{DeviceID: "MEDIA DEV 1", ElementName: "Managed System Hard Disk", MaxMediaSize: 1000204886},
+		}}},
+		CIMPhysicalPackage: dto.CIMResponse{Responses: []interface{}{[]physical.PhysicalPackage{
+			{Model: "Samsung 980", SerialNumber: "S64DNX0R123456"},
+			{Model: "WDC WD10EZEX", SerialNumber: "WD-WCC6Y0123456"},
+		}}},
+	}
+}
+
+func TestStorageHandlers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		path           string
+		diskInfo       dto.DiskInfo
+		diskErr        error
+		expectedStatus int
+		expectedBody   []string
+	}{
+		{
+			name:           "collection with drives",
+			path:           "/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/Storage",
+			diskInfo:       populatedDiskInfo(),
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{`"Members@odata.count":1`, `"@odata.id":"/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/Storage/1"`},
+		},
+		{
+			name:           "collection without storage info",
+			path:           "/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/Storage",
+			diskInfo:       dto.DiskInfo{},
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{`"Members@odata.count":0`, `"Members":[]`},
+		},
+		{
+			name:           "storage lists drives",
+			path:           "/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/Storage/1",
+			diskInfo:       populatedDiskInfo(),
+			expectedStatus: http.StatusOK,
+			expectedBody: []string{
+				`"#Storage.v1_7_1.Storage"`,
+				`"Drives@odata.count":2`,
+				`"@odata.id":"/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/Storage/1/Drives/2"`,
+			},
+		},
+		{
+			name:           "storage without storage info",
+			path:           "/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/Storage/1",
+			diskInfo:       dto.DiskInfo{},
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{`"Drives@odata.count":0`, `"Drives":[]`},
+		},
+		{
+			name:           "unknown drive",
+			path:           "/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/Storage/1/Drives/3",
+			diskInfo:       populatedDiskInfo(),
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   []string{BaseResourceNotFoundID, "Drive"},
+		},
+		{
+			name:           "unknown system",
+			path:           "/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/Storage",
+			diskErr:        devices.ErrNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   []string{BaseResourceNotFoundID, "ComputerSystem"},
+		},
+		{
+			name:           "device failure",
+			path:           "/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/Storage/1",
+			diskErr:        fmt.Errorf("dial tcp 10.0.0.5:16993: connection refused"),
+			expectedStatus: http.StatusBadGateway,
+			expectedBody:   []string{BaseErrorMessageID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().GetDiskInfo(gomock.Any(), testSystemID).Return(tt.diskInfo, tt.diskErr)
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).Times(1)
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			NewStorageRoutes(router.Group("/redfish/v1/Systems"), mockFeature, nil, mockLogger)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, tt.path, http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			for _, expected := range tt.expectedBody {
+				assert.Contains(t, w.Body.String(), expected)
+			}
+		})
+	}
+}
+
+func TestGetDriveHandler(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().GetDiskInfo(gomock.Any(), testSystemID).Return(populatedDiskInfo(), nil).Times(2)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/redfish/v1/Systems/:id/Storage/:storageId/Drives/:driveId", getDriveHandler(mockFeature, nil, mocks.NewMockLogger(ctrl)))
+
+	tests := []struct {
+		driveID  string
+		expected Drive
+	}{
+		{
+			driveID: "1",
+			expected: Drive{
+				ODataID:       "/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/Storage/1/Drives/1",
+				ODataType:     "#Drive.v1_5_0.Drive",
+				ID:            "1",
+				Name:          "Managed System NVMe SSD",
+				CapacityBytes: 500107608 * bytesPerKibibyte,
+				MediaType:     mediaTypeSSD,
+				Model:         "Samsung 980",
+				SerialNumber:  "S64DNX0R123456",
+				Status:        Status{State: systemStateEnabled, Health: healthOK},
+			},
+		},
+		{
+			driveID: "2",
+			expected: Drive{
+				ODataID:       "/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/Storage/1/Drives/2",
+				ODataType:     "#Drive.v1_5_0.Drive",
+				ID:            "2",
+				Name:          "Managed System Hard Disk",
+				CapacityBytes: 1000204886 * bytesPerKibibyte,
+				Model:         "WDC WD10EZEX",
+				SerialNumber:  "WD-WCC6Y0123456",
+				Status:        Status{State: systemStateEnabled, Health: healthOK},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet,
+			"/redfish/v1/Systems/3c9d5e2a-7b41-4f08-a6d2-5e8c1f0b9a47/Storage/1/Drives/"+tt.driveID, http.NoBody)
+
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var drive Drive
+
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &drive))
+		assert.Equal(t, tt.expected, drive)
+	}
+}
+
+func TestDriveHealth(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		device   mediaaccess.MediaAccessDevice
+		expected string
+	}{
+		{
+			name:     "healthy drive",
+			device:   mediaaccess.MediaAccessDevice{OperationalStatus: []mediaaccess.OperationalStatus{2}},
+			expected: healthOK,
+		},
+		{
+			name:     "predicted failure",
+			device:   mediaaccess.MediaAccessDevice{OperationalStatus: []mediaaccess.OperationalStatus{2, cimStatusPredictiveFailure}},
+			expected: healthWarning,
+		},
+		{
+			name:     "drive error",
+			device:   mediaaccess.MediaAccessDevice{OperationalStatus: []mediaaccess.OperationalStatus{cimStatusError}},
+			expected: healthCritical,
+		},
+		{
+			name:     "no health data",
+			device:   mediaaccess.MediaAccessDevice{ElementName: "Managed System Hard Disk"},
+			expected: healthOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			drives := buildDrives(DefaultRedfishBasePath, testSystemID, dto.DiskInfo{
+				CIMMediaAccessDevice: dto.CIMResponse{Responses: []interface{}{[]mediaaccess.MediaAccessDevice{tt.device}}},
+			})
+
+			require.Len(t, drives, 1)
+			assert.Equal(t, Status{State: systemStateEnabled, Health: tt.expected}, drives[0].Status)
+		})
+	}
+}
+
+func TestCIMItems(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		response dto.CIMResponse
+		expected int
+	}{
+		{name: "empty", response: dto.CIMResponse{}, expected: 0},
+		{name: "single object", response: dto.CIMResponse{Response: map[string]interface{}{"ElementName": "a"}}, expected: 1},
+		{name: "slice of objects", response: dto.CIMResponse{Responses: []interface{}{map[string]interface{}{}, map[string]interface{}{}}}, expected: 2},
+		{name: "nested slice", response: dto.CIMResponse{Responses: []interface{}{[]interface{}{map[string]interface{}{}, map[string]interface{}{}}}}, expected: 2},
+		{name: "empty nested slice", response: dto.CIMResponse{Responses: []interface{}{[]mediaaccess.MediaAccessDevice(nil)}}, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Len(t, cimItems(tt.response), tt.expected)
+		})
+	}
+}
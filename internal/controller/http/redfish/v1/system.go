@@ -7,20 +7,64 @@
 package v1
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
 	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+
+	"github.com/device-management-toolkit/console/config"
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
+// DefaultDeviceTimeout bounds each device call made by the Systems handlers when no timeout is configured.
+const DefaultDeviceTimeout = 30 * time.Second
+
+// DefaultExpandWorkers bounds the concurrent power state queries of an expanded collection when none is configured.
+const DefaultExpandWorkers = 8
+
+// DefaultPageSize is the number of members a paged collection returns without $top when no page size is configured.
+const DefaultPageSize = 50
+
 // Lint constants
 const (
 	maxSystemsList        = 100
+	systemsCollectionPath = "/redfish/v1/Systems"
+	queryTop              = "$top"
+	querySkip             = "$skip"
+	queryExpand           = "$expand"
+	querySelect           = "$select"
+	queryCountOnly        = "count-only"
+	queryHostname         = "hostname"
+	queryDryRun           = "dry-run"
+	dryRunHeader          = "X-Redfish-Dry-Run"
+	// bytesPerGiB converts CIM_PhysicalMemory Capacity to MemorySummary.TotalSystemMemoryGiB
+	bytesPerGiB = 1 << 30
+	// uuidStringLength is the length of a UUID in canonical 8-4-4-4-12 form
+	uuidStringLength = 36
+	// maxExpandedSystems caps the page size when members are inlined, since each costs a power state query
+	maxExpandedSystems    = 25
 	powerStateUnknown     = "Unknown"
 	powerStateOn          = "On"
 	powerStateOff         = "Off"
+	powerStatePoweringOn  = "PoweringOn"
+	powerStatePoweringOff = "PoweringOff"
 	resetTypeOn           = "On"
 	resetTypeForceOff     = "ForceOff"
 	resetTypeForceRestart = "ForceRestart"
@@ -35,136 +79,1386 @@ const (
 	cimPowerStandby = 4
 	cimPowerSoftOff = 7
 	cimPowerHardOff = 8
+	// Transitional CIM PowerState values: a power cycle or bus reset brings the host back up,
+	// a graceful shutdown is still taking the host down
+	cimPowerCycleSoft         = 5
+	cimPowerCycleHard         = 9
+	cimPowerMasterBusReset    = 10
+	cimPowerSoftOffGraceful   = 12
+	cimPowerHardOffGraceful   = 13
+	cimPowerBusResetGraceful  = 14
+	cimPowerCycleSoftGraceful = 15
+	cimPowerCycleHardGraceful = 16
+	// CIM HealthState thresholds: Degraded/Minor failure and Major/Critical/Non-recoverable failure
+	cimHealthDegraded     = 10
+	cimHealthMajorFailure = 20
+	// CIM OperationalStatus values that degrade the system health
+	cimStatusDegraded            = 3
+	cimStatusStressed            = 4
+	cimStatusPredictiveFailure   = 5
+	cimStatusError               = 6
+	cimStatusNonRecoverableError = 7
+	// Boot source override values (ComputerSystem.Boot)
+	bootSourceOverrideEnabledDisabled = "Disabled"
+	bootSourceOverrideEnabledOnce     = "Once"
+	bootSourceOverrideTargetNone      = "None"
+	bootSourceOverrideTargetPxe       = "Pxe"
+	bootSourceOverrideTargetHdd       = "Hdd"
+	bootSourceOverrideTargetCd        = "Cd"
+	bootSourceOverrideTargetBiosSetup = "BiosSetup"
+	propertyBoot                      = "Boot"
+	propertyResetType                 = "ResetType"
+	propertyBootSourceOverrideEnabled = "BootSourceOverrideEnabled"
+	propertyBootSourceOverrideTarget  = "BootSourceOverrideTarget"
+	// ComputerSystem Status values
+	systemStateEnabled            = "Enabled"
+	systemStateStarting           = "Starting"
+	systemStateStandbyOffline     = "StandbyOffline"
+	systemStateUnavailableOffline = "UnavailableOffline"
 )
 
+// bootTargetActions maps Redfish BootSourceOverrideTarget values to AMT boot actions.
+// Cd is an IDE-redirection (IDER) boot from remote media, so it only succeeds while a
+// redirection session with a mounted image is active; it does not boot a local drive.
+var bootTargetActions = map[string]int{
+	bootSourceOverrideTargetNone:      devices.BootActionNone,
+	bootSourceOverrideTargetPxe:       devices.BootActionResetToPXE,
+	bootSourceOverrideTargetHdd:       devices.BootActionResetToHDD,
+	bootSourceOverrideTargetCd:        devices.BootActionResetToIDERCDROM,
+	bootSourceOverrideTargetBiosSetup: devices.BootActionResetToBIOS,
+}
+
 // NewSystemsRoutes registers minimal Redfish ComputerSystem routes.
 // It exposes:
-// - GET /redfish/v1/Systems
-// - GET /redfish/v1/Systems/:id
+// - GET and HEAD /redfish/v1/Systems
+// - GET and HEAD /redfish/v1/Systems/:id
+// - PATCH /redfish/v1/Systems/:id
 // - POST /redfish/v1/Systems/:id/Actions/ComputerSystem.Reset
+// - POST /redfish/v1/Systems/Actions/Oem.BulkReset
+// - GET and HEAD /redfish/v1/Systems/:id/ResetActionInfo
+// - OPTIONS on the collection, the instance and the Reset actions
 // - GET /redfish/v1/Systems/:id/FirmwareInventory
 // - GET /redfish/v1/Systems/:id/FirmwareInventory/:firmwareId
+// - GET /redfish/v1/Systems/:id/LogServices, .../LogServices/EventLog, .../LogServices/EventLog/Entries and .../Entries/:entryId
+// - POST /redfish/v1/Systems/:id/LogServices/EventLog/Actions/LogService.ClearLog
+// - GET /redfish/v1/Systems/:id/Storage, .../Storage/:storageId and .../Storage/:storageId/Drives/:driveId
+// - GET /redfish/v1/Systems/:id/EthernetInterfaces and .../EthernetInterfaces/:nicId
+// - GET /redfish/v1/Systems/:id/SecureBoot
+// - GET /redfish/v1/Systems/:id/Bios
+// - GET /redfish/v1/Systems/:id/PCIeDevices and .../PCIeDevices/:deviceId
 // The :id is expected to be the device GUID and will be mapped directly to SendPowerAction.
-func NewSystemsRoutes(r *gin.RouterGroup, d devices.Feature, l logger.Interface) {
-	systems := r.Group("/Systems")
-	systems.GET("", getSystemsCollectionHandler(d, l))
-	systems.GET(":id", getSystemInstanceHandler(d, l))
-	systems.POST(":id/Actions/ComputerSystem.Reset", postSystemResetHandler(d, l))
+// PATCH and the Reset actions share one action rate limiter. All handlers share one hardware info cache and
+// one set of per-device circuit breakers; cached hardware info is served even while a breaker is open.
+func NewSystemsRoutes(r *gin.RouterGroup, d devices.Feature, cfg *config.Config, l logger.Interface) {
+	d = newHardwareInfoCache(newCircuitBreaker(d, cfg), cfg)
+	// Classify device errors with the built-in and configured patterns for every Systems handler
+	systems := r.Group("/Systems", RedfishErrorClassifierMiddleware(cfg))
+	collection := getSystemsCollectionHandler(d, cfg, l)
+	instance := getSystemInstanceHandler(d, cfg, l)
+	actions := RedfishRateLimitMiddleware(cfg)
+	events := NewEventPublisher(DefaultSubscriptionStore, cfg, l)
+	// Single and bulk resets share the device locks so that they never overlap on one system
+	resetLocks := newDeviceLocks()
+
+	systems.GET("", collection)
+	systems.HEAD("", headHandler(collection))
+	systems.GET(":id", instance)
+	systems.HEAD(":id", headHandler(instance))
+	systems.PATCH(":id", RequireRole(roleOperator), actions, patchSystemInstanceHandler(d, cfg, l))
+	systems.POST(":id/Actions/ComputerSystem.Reset", RequireRole(roleOperator), actions, postSystemResetHandler(d, events, DefaultTaskStore, resetLocks, cfg, l))
+	systems.POST("Actions/"+bulkResetAction, RequireRole(roleOperator), actions, postBulkResetHandler(d, events, DefaultTaskStore, resetLocks, cfg, l))
+	systems.GET(":id/"+resetActionInfoID, getResetActionInfoHandler)
+	systems.HEAD(":id/"+resetActionInfoID, headHandler(getResetActionInfoHandler))
+	systems.OPTIONS("", optionsHandler("GET, HEAD"))
+	systems.OPTIONS(":id", optionsHandler("GET, HEAD, PATCH"))
+	systems.OPTIONS(":id/Actions/ComputerSystem.Reset", optionsHandler("POST"))
+	systems.OPTIONS("Actions/"+bulkResetAction, optionsHandler("POST"))
 
 	// Add firmware inventory routes
-	NewFirmwareRoutes(systems, d, l)
+	NewFirmwareRoutes(systems, d, cfg, l)
+
+	// Add event log routes
+	NewLogServiceRoutes(systems, d, DefaultTaskStore, cfg, l)
+
+	// Add storage routes
+	NewStorageRoutes(systems, d, cfg, l)
+
+	// Add network interface routes
+	NewEthernetInterfaceRoutes(systems, d, cfg, l)
+
+	// Add secure boot routes
+	NewSecureBootRoutes(systems, d, cfg, l)
+
+	// Add BIOS routes
+	NewBiosRoutes(systems, d, cfg, l)
+
+	// Add PCIe device routes
+	NewPCIeDeviceRoutes(systems, d, cfg, l)
 
 	l.Info("Registered Redfish Systems routes under %s", r.BasePath()+"/Systems")
 }
 
-func getSystemsCollectionHandler(d devices.Feature, l logger.Interface) gin.HandlerFunc {
+// validateSystemID reports whether id is a device GUID in canonical UUID form. Any other id cannot
+// name a device, so a ResourceNotFound error is written without contacting the backend.
+func validateSystemID(c *gin.Context, id string) bool {
+	if isSystemID(id) {
+		return true
+	}
+
+	ResourceNotFoundError(c, "ComputerSystem", id)
+
+	return false
+}
+
+// isSystemID reports whether id is a device GUID in canonical UUID form
+func isSystemID(id string) bool {
+	if len(id) != uuidStringLength {
+		return false
+	}
+
+	_, err := uuid.Parse(id)
+
+	return err == nil
+}
+
+// deviceTimeout returns the configured per-call device timeout, falling back to DefaultDeviceTimeout
+func deviceTimeout(cfg *config.Config) time.Duration {
+	if cfg == nil || cfg.Redfish.DeviceTimeout <= 0 {
+		return DefaultDeviceTimeout
+	}
+
+	return cfg.Redfish.DeviceTimeout
+}
+
+// expandWorkers returns the configured expand concurrency, falling back to DefaultExpandWorkers
+func expandWorkers(cfg *config.Config) int {
+	if cfg == nil || cfg.Redfish.ExpandWorkers <= 0 {
+		return DefaultExpandWorkers
+	}
+
+	return cfg.Redfish.ExpandWorkers
+}
+
+// pageSize returns the configured default page size of paged collections, or DefaultPageSize
+func pageSize(cfg *config.Config) int {
+	if cfg == nil || cfg.Redfish.DefaultPageSize <= 0 {
+		return DefaultPageSize
+	}
+
+	return cfg.Redfish.DefaultPageSize
+}
+
+// deviceCallError writes the Redfish error for a failed device call: 503 when the device's circuit
+// breaker is open, 504 when the device did not respond in time, 502 when it rejected the stored
+// credentials or could not be reached, 503 when the call was refused as overloaded, 500 otherwise
+func deviceCallError(c *gin.Context, err error) {
+	class := deviceErrorClass(errorClassifier(c), err)
+	recordUpstreamError(c, class)
+
+	switch class {
+	case upstreamErrorCircuitOpen:
+		openErr, _ := isCircuitOpenError(err)
+		ServiceTemporarilyUnavailableRetryError(c, int(math.Ceil(openErr.retryAfter.Seconds())))
+	case upstreamErrorTimeout:
+		GatewayTimeoutError(c)
+	case upstreamErrorAuthentication:
+		DeviceAuthenticationError(c)
+	case upstreamErrorUnreachable:
+		BadGatewayError(c)
+	case upstreamErrorOverloaded:
+		ServiceTemporarilyUnavailableError(c)
+	default:
+		GeneralError(c)
+	}
+}
+
+// deviceErrorClass returns the upstream error classification of a failed device call
+func deviceErrorClass(classifier deviceErrorClassifier, err error) string {
+	if _, ok := isCircuitOpenError(err); ok {
+		return upstreamErrorCircuitOpen
+	}
+
+	switch {
+	case classifier.isTimeout(err):
+		return upstreamErrorTimeout
+	case classifier.isDeviceAuth(err):
+		return upstreamErrorAuthentication
+	case classifier.isUpstreamCommunication(err):
+		return upstreamErrorUnreachable
+	case classifier.isServiceOverloaded(err):
+		return upstreamErrorOverloaded
+	default:
+		return upstreamErrorOther
+	}
+}
+
+func getSystemsCollectionHandler(d devices.Feature, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+	workers := expandWorkers(cfg)
+	defaultTop := pageSize(cfg)
+
 	return func(c *gin.Context) {
-		items, err := d.Get(c.Request.Context(), maxSystemsList, 0, "")
+		expand, ok := parseExpand(c)
+		if !ok {
+			return
+		}
+
+		hostname, ok := parseHostname(c)
+		if !ok {
+			return
+		}
+
+		countOnly, ok := parseCountOnly(c)
+		if !ok {
+			return
+		}
+
+		if countOnly {
+			writeSystemsCount(c, d, hostname, timeout, l)
+
+			return
+		}
+
+		pageLimit := maxSystemsList
+		if expand {
+			pageLimit = maxExpandedSystems
+		}
+
+		top, skip, ok := parsePaging(c, defaultTop, pageLimit)
+		if !ok {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		var (
+			guids     []string
+			truncated bool
+			err       error
+		)
+
+		if hostname != "" {
+			guids, err = systemsByHostname(ctx, d, hostname)
+			guids, truncated = pageGUIDs(guids, top, skip)
+		} else {
+			guids, truncated, err = systemsPage(ctx, d, top, skip)
+		}
+
 		if err != nil {
-			l.Error(err, "http - redfish - Systems collection")
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			l.Error(err, "http - redfish - Systems collection [request %s]", requestID(c))
+			deviceCallError(c, err)
 
 			return
 		}
 
-		members := make([]any, 0, len(items))
+		base := linkBase(c)
+
+		var members []any
+		if expand {
+			powerStates := fetchPowerStatesConcurrently(c.Request.Context(), d, guids, workers, timeout, l)
+
+			members = make([]any, 0, len(guids))
+			for _, guid := range guids {
+				// Expanded members skip the hardware info read, so their Status carries no Health
+				members = append(members, computerSystemPayload(base, guid, powerStates[guid], "", systemInventory{}))
+			}
+		} else {
+			members = make([]any, 0, len(guids))
+			for _, guid := range guids {
+				members = append(members, map[string]any{
+					"@odata.id": systemPath(base, guid),
+				})
+			}
+		}
+
+		payload := systemsCollectionPayload(base, members, len(members))
+
+		if truncated {
+			nextLink := fmt.Sprintf("%s?%s=%d&%s=%d", rebaseLink(base, systemsCollectionPath), queryTop, top, querySkip, skip+top)
+			if expand {
+				nextLink += "&" + queryExpand + "=" + url.QueryEscape(c.Query(queryExpand))
+			}
+
+			if hostname != "" {
+				nextLink += "&" + queryHostname + "=" + url.QueryEscape(hostname)
+			}
+
+			payload["Members@odata.nextLink"] = nextLink
+		}
+
+		SetRedfishHeaders(c)
+		c.JSON(http.StatusOK, payload)
+	}
+}
+
+// systemsCollectionPayload builds the ComputerSystemCollection body with the given members and count
+func systemsCollectionPayload(base string, members []any, count int) map[string]any {
+	return map[string]any{
+		"@odata.type":         "#ComputerSystemCollection.ComputerSystemCollection",
+		"@odata.id":           rebaseLink(base, systemsCollectionPath),
+		"Name":                "Computer System Collection",
+		"Members@odata.count": count,
+		"Members":             members,
+	}
+}
+
+// parseCountOnly reports whether the request asks only for the number of systems, with $top=0 or
+// count-only=true. It writes a QueryParameterValueError and returns ok=false when count-only is not
+// a boolean.
+func parseCountOnly(c *gin.Context) (countOnly, ok bool) {
+	if c.Query(queryTop) == "0" {
+		return true, true
+	}
+
+	raw, present := c.GetQuery(queryCountOnly)
+	if !present {
+		return false, true
+	}
+
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		QueryParameterValueError(c, queryCountOnly, raw)
+
+		return false, false
+	}
+
+	return value, true
+}
+
+// parseDryRun reports whether the request asks to validate an action without performing it, with
+// dry-run=true or the X-Redfish-Dry-Run header. It writes a 400 and returns ok=false when either
+// is not a boolean.
+func parseDryRun(c *gin.Context) (dryRun, ok bool) {
+	if raw, present := c.GetQuery(queryDryRun); present {
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			QueryParameterValueError(c, queryDryRun, raw)
+
+			return false, false
+		}
+
+		dryRun = value
+	}
+
+	if raw := c.GetHeader(dryRunHeader); raw != "" {
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			PropertyValueFormatError(c, raw, dryRunHeader)
+
+			return false, false
+		}
+
+		dryRun = dryRun || value
+	}
+
+	return dryRun, true
+}
+
+// writeSystemsCount answers a count-only collection request with the total number of systems, or
+// of the systems with the given hostname, and an empty Members array. Without a hostname the
+// devices are counted without being listed.
+func writeSystemsCount(c *gin.Context, d devices.Feature, hostname string, timeout time.Duration, l logger.Interface) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+	defer cancel()
+
+	var (
+		count int
+		err   error
+	)
+
+	if hostname != "" {
+		var guids []string
+
+		guids, err = systemsByHostname(ctx, d, hostname)
+		count = len(guids)
+	} else {
+		count, err = d.GetCount(ctx, "")
+	}
+
+	if err != nil {
+		l.Error(err, "http - redfish - Systems collection count [request %s]", requestID(c))
+		deviceCallError(c, err)
+
+		return
+	}
+
+	SetRedfishHeaders(c)
+	c.JSON(http.StatusOK, systemsCollectionPayload(linkBase(c), []any{}, count))
+}
+
+// systemsPage reads one page of the device list and returns its system GUIDs in GUID order, and
+// whether another page follows
+func systemsPage(ctx context.Context, d devices.Feature, top, skip int) (guids []string, truncated bool, err error) {
+	// Fetch one extra device to learn whether another page follows
+	items, err := d.Get(ctx, top+1, skip, "")
+	if err != nil {
+		return nil, false, err
+	}
+
+	truncated = len(items) > top
+	if truncated {
+		items = items[:top]
+	}
+
+	guids = make([]string, 0, len(items))
+	for i := range items { // avoid value copy
+		if items[i].GUID != "" {
+			guids = append(guids, items[i].GUID)
+		}
+	}
+
+	// The device store pages in GUID order; sort the page too so the member order does not
+	// depend on the order a store returns rows in
+	sort.Strings(guids)
+
+	return guids, truncated, nil
+}
+
+// systemsByHostname reads the whole device list, maxSystemsList devices at a time, and returns the
+// GUIDs of the systems whose hostname equals hostname ignoring case, in GUID order
+func systemsByHostname(ctx context.Context, d devices.Feature, hostname string) ([]string, error) {
+	guids := []string{}
+
+	for skip := 0; ; skip += maxSystemsList {
+		items, err := d.Get(ctx, maxSystemsList, skip, "")
+		if err != nil {
+			return nil, err
+		}
+
 		for i := range items { // avoid value copy
-			it := &items[i]
-			if it.GUID == "" {
-				continue
+			if items[i].GUID != "" && strings.EqualFold(items[i].Hostname, hostname) {
+				guids = append(guids, items[i].GUID)
 			}
+		}
+
+		if len(items) < maxSystemsList {
+			break
+		}
+	}
+
+	sort.Strings(guids)
+
+	return guids, nil
+}
+
+// pageGUIDs returns the page of guids selected by top and skip, and whether another page follows
+func pageGUIDs(guids []string, top, skip int) (page []string, truncated bool) {
+	if skip >= len(guids) {
+		return []string{}, false
+	}
+
+	page = guids[skip:]
+	if len(page) > top {
+		return page[:top], true
+	}
+
+	return page, false
+}
+
+// parseHostname reads the hostname query parameter, which limits the Systems collection to the
+// systems with that hostname. It writes a QueryParameterValueError and returns ok=false when the
+// parameter is present but empty.
+func parseHostname(c *gin.Context) (hostname string, ok bool) {
+	raw, present := c.GetQuery(queryHostname)
+	if !present {
+		return "", true
+	}
+
+	if strings.TrimSpace(raw) == "" {
+		QueryParameterValueError(c, queryHostname, raw)
+
+		return "", false
+	}
+
+	return raw, true
+}
+
+// expandExpressions lists the $expand values that inline the collection members one level deep
+var expandExpressions = map[string]bool{
+	".":            true,
+	".($levels=1)": true,
+	"*":            true,
+	"*($levels=1)": true,
+	"Members":      true,
+}
+
+// parseExpand reports whether the request asks for the collection members to be inlined.
+// It writes a QueryNotSupportedError and returns ok=false for unrecognized $expand expressions.
+func parseExpand(c *gin.Context) (expand, ok bool) {
+	raw, present := c.GetQuery(queryExpand)
+	if !present {
+		return false, true
+	}
+
+	if !expandExpressions[raw] {
+		QueryNotSupportedError(c, queryExpand+"="+raw)
+
+		return false, false
+	}
+
+	return true, true
+}
+
+// fetchPowerStatesConcurrently resolves the Redfish PowerState of each GUID with at most maxWorkers
+// concurrent GetPowerState calls, each bounded by timeout. A device whose power state cannot be read
+// maps to Unknown so that one failing device does not fail the whole request.
+func fetchPowerStatesConcurrently(ctx context.Context, d devices.Feature, guids []string, maxWorkers int, timeout time.Duration, l logger.Interface) map[string]string {
+	states := make([]string, len(guids))
+	slots := make(chan struct{}, max(maxWorkers, 1))
+
+	var wg sync.WaitGroup
+
+	for i, guid := range guids {
+		wg.Add(1)
+
+		slots <- struct{}{}
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			callCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			ps, err := d.GetPowerState(callCtx, guid)
+			if err != nil {
+				l.Warn("redfish - Systems collection: failed to get power state for %s: %v [request %s]", guid, err, requestIDFromContext(ctx))
+
+				states[i] = powerStateUnknown
+
+				return
+			}
+
+			states[i] = mapPowerState(ps.PowerState)
+		}()
+	}
+
+	wg.Wait()
 
-			members = append(members, map[string]any{
-				"@odata.id": "/redfish/v1/Systems/" + it.GUID,
-			})
+	result := make(map[string]string, len(guids))
+	for i, guid := range guids {
+		result[guid] = states[i]
+	}
+
+	return result
+}
+
+// parsePaging reads the $top and $skip query parameters, clamping $top to maxTop. Without $top a
+// page holds defaultTop members, or maxTop when that is smaller. It writes a
+// QueryParameterValueError and returns ok=false when $top is not a positive integer or $skip is
+// not a non-negative integer. $top=0 is handled earlier by parseCountOnly.
+func parsePaging(c *gin.Context, defaultTop, maxTop int) (top, skip int, ok bool) {
+	top, skip = min(defaultTop, maxTop), 0
+
+	if raw, present := c.GetQuery(queryTop); present {
+		value, err := strconv.Atoi(raw)
+		if err != nil || value < 1 {
+			QueryParameterValueError(c, queryTop, raw)
+
+			return 0, 0, false
 		}
 
-		payload := map[string]any{
-			"@odata.type":         "#ComputerSystemCollection.ComputerSystemCollection",
-			"@odata.id":           "/redfish/v1/Systems",
-			"Name":                "Computer System Collection",
-			"Members@odata.count": len(members),
-			"Members":             members,
+		top = min(value, maxTop)
+	}
+
+	if raw, present := c.GetQuery(querySkip); present {
+		value, err := strconv.Atoi(raw)
+		if err != nil || value < 0 {
+			QueryParameterValueError(c, querySkip, raw)
+
+			return 0, 0, false
 		}
-		c.JSON(http.StatusOK, payload)
+
+		skip = value
 	}
+
+	return top, skip, true
 }
 
-func getSystemInstanceHandler(d devices.Feature, l logger.Interface) gin.HandlerFunc {
+func getSystemInstanceHandler(d devices.Feature, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+	tracker := newLastModifiedTracker()
+
 	return func(c *gin.Context) {
 		id := c.Param("id")
 		powerState := powerStateUnknown
 
-		if ps, err := d.GetPowerState(c.Request.Context(), id); err != nil {
-			l.Warn("redfish - Systems instance: failed to get power state for %s: %v", id, err)
+		if !validateSystemID(c, id) {
+			return
+		}
+
+		selected, ok := parseSelect(c, systemSelectableProperties)
+		if !ok {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		if ps, err := d.GetPowerState(ctx, id); err != nil {
+			if _, ok := isCircuitOpenError(err); ok {
+				l.Warn("redfish - Systems instance: %v [request %s]", err, requestID(c))
+				deviceCallError(c, err)
+
+				return
+			}
+
+			if errorClassifier(c).isTimeout(err) {
+				l.Error(err, "http - redfish - Systems instance: power state timed out for %s [request %s]", id, requestID(c))
+				recordUpstreamError(c, upstreamErrorTimeout)
+				GatewayTimeoutError(c)
+
+				return
+			}
+
+			l.Warn("redfish - Systems instance: failed to get power state for %s: %v [request %s]", id, err, requestID(c))
 		} else {
-			switch ps.PowerState { // CIM PowerState values
-			case actionPowerUp: // 2 (On)
-				powerState = powerStateOn
-			case cimPowerSleep, cimPowerStandby: // Sleep/Standby -> treat as On
-				powerState = powerStateOn
-			case cimPowerSoftOff, cimPowerHardOff: // Soft Off / Hard Off
-				powerState = powerStateOff
-			default:
-				powerState = powerStateUnknown
+			powerState = mapPowerState(ps.PowerState)
+		}
+
+		health := healthOK
+
+		var inventory systemInventory
+
+		if hwInfo, err := d.GetHardwareInfo(ctx, id); err != nil {
+			l.Warn("redfish - Systems instance: failed to get hardware info for %s: %v [request %s]", id, err, requestID(c))
+		} else {
+			health = systemHealth(hwInfo)
+			inventory = systemInventoryFromHardware(hwInfo)
+		}
+
+		etag := systemETag(id, powerState, health)
+		if ifNoneMatch(c, etag) {
+			return
+		}
+
+		SetRedfishHeaders(c)
+		c.Header("ETag", etag)
+
+		if ifModifiedSince(c, tracker.touch(id, etag)) {
+			return
+		}
+
+		c.JSON(http.StatusOK, selectProperties(computerSystemPayload(linkBase(c), id, powerState, health, inventory), selected))
+	}
+}
+
+// alwaysSelectedProperties are returned whatever $select asks for
+var alwaysSelectedProperties = []string{"@odata.id", "@odata.type"}
+
+// systemSelectableProperties are the top-level ComputerSystem properties $select may name
+var systemSelectableProperties = func() map[string]bool {
+	properties := map[string]bool{
+		"@odata.id": true, "@odata.type": true, "@odata.etag": true,
+		"Id": true, "Name": true, "PowerState": true, "Status": true, "Boot": true, "Actions": true,
+		"Manufacturer": true, "Model": true, "SerialNumber": true, "SKU": true,
+		"ProcessorSummary": true, "MemorySummary": true,
+	}
+
+	for property := range systemNavigationLinks {
+		properties[property] = true
+	}
+
+	return properties
+}()
+
+// parseSelect reads the comma-separated $select property list. It returns nil when $select is
+// absent. It writes a QueryParameterValueError and returns ok=false when the list is empty or
+// names a property outside known.
+func parseSelect(c *gin.Context, known map[string]bool) (selected []string, ok bool) {
+	raw, present := c.GetQuery(querySelect)
+	if !present {
+		return nil, true
+	}
+
+	for _, property := range strings.Split(raw, ",") {
+		property = strings.TrimSpace(property)
+		if !known[property] {
+			QueryParameterValueError(c, querySelect, property)
+
+			return nil, false
+		}
+
+		selected = append(selected, property)
+	}
+
+	return selected, true
+}
+
+// selectProperties keeps the selected top-level properties of payload plus the
+// alwaysSelectedProperties. A nil selection keeps everything.
+func selectProperties(payload map[string]any, selected []string) map[string]any {
+	if selected == nil {
+		return payload
+	}
+
+	out := make(map[string]any, len(selected)+len(alwaysSelectedProperties))
+
+	for _, property := range append(selected, alwaysSelectedProperties...) {
+		if value, ok := payload[property]; ok {
+			out[property] = value
+		}
+	}
+
+	return out
+}
+
+// currentSystemETag reads the power state and hardware info of a system to derive its ETag the
+// way the instance handler does. Read failures fall back to the same defaults.
+func currentSystemETag(ctx context.Context, d devices.Feature, id string, timeout time.Duration) string {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	powerState, health := powerStateUnknown, healthOK
+
+	if ps, err := d.GetPowerState(ctx, id); err == nil {
+		powerState = mapPowerState(ps.PowerState)
+	}
+
+	if hwInfo, err := d.GetHardwareInfo(ctx, id); err == nil {
+		health = systemHealth(hwInfo)
+	}
+
+	return systemETag(id, powerState, health)
+}
+
+// mapPowerState converts a CIM PowerState value to a Redfish PowerState
+func mapPowerState(cimPowerState int) string {
+	switch cimPowerState {
+	case actionPowerUp: // 2 (On)
+		return powerStateOn
+	case cimPowerSleep, cimPowerStandby: // Sleep/Standby -> treat as On
+		return powerStateOn
+	case cimPowerSoftOff, cimPowerHardOff: // Soft Off / Hard Off
+		return powerStateOff
+	case cimPowerCycleSoft, cimPowerCycleHard, cimPowerMasterBusReset,
+		cimPowerBusResetGraceful, cimPowerCycleSoftGraceful, cimPowerCycleHardGraceful: // reset in progress
+		return powerStatePoweringOn
+	case cimPowerSoftOffGraceful, cimPowerHardOffGraceful: // graceful shutdown in progress
+		return powerStatePoweringOff
+	default:
+		return powerStateUnknown
+	}
+}
+
+// systemETag derives a ComputerSystem ETag from the id, the resolved power state and the health,
+// the only values of the resource that vary between requests. The tag is strong because the
+// system is writable and PATCH checks If-Match with strong comparison.
+func systemETag(id, powerState, health string) string {
+	return formatStrongETag("ComputerSystem-" + id + "-" + powerState + "-" + health)
+}
+
+// systemState maps a Redfish PowerState to the ComputerSystem Status.State
+func systemState(powerState string) string {
+	switch powerState {
+	case powerStateOn, powerStatePoweringOff:
+		return systemStateEnabled
+	case powerStatePoweringOn:
+		return systemStateStarting
+	case powerStateOff:
+		return systemStateStandbyOffline
+	default:
+		return systemStateUnavailableOffline
+	}
+}
+
+// systemStatus builds the ComputerSystem Status object. Health is left out when it is not known.
+func systemStatus(powerState, health string) map[string]any {
+	status := map[string]any{"State": systemState(powerState)}
+	if health != "" {
+		status["Health"] = health
+	}
+
+	return status
+}
+
+// systemInventory holds the ComputerSystem properties read from the hardware info
+type systemInventory struct {
+	Manufacturer   string
+	Model          string
+	SerialNumber   string
+	SKU            string
+	ProcessorCount int
+	ProcessorModel string
+	MemoryGiB      float64
+}
+
+// systemInventoryFromHardware reads the identifying properties and the processor and memory
+// summaries from the hardware info
+func systemInventoryFromHardware(hwInfo dto.HardwareInfo) systemInventory {
+	inventory := systemIdentityFromHardware(hwInfo)
+	inventory.ProcessorCount, inventory.ProcessorModel = processorSummary(hwInfo)
+	inventory.MemoryGiB = totalMemoryGiB(hwInfo)
+
+	return inventory
+}
+
+// processorSummary counts the CIM_Processor instances and takes the model from the first
+// CIM_Chip that reports a Version, which is where AMT puts the processor brand string
+func processorSummary(hwInfo dto.HardwareInfo) (count int, model string) {
+	count = len(cimItems(hwInfo.CIMProcessor))
+
+	for _, chip := range cimItems(hwInfo.CIMChip) {
+		if model = strings.TrimSpace(cimString(chip, "Version")); model != "" {
+			break
+		}
+	}
+
+	return count, model
+}
+
+// totalMemoryGiB sums the Capacity, in bytes, of the CIM_PhysicalMemory instances and returns it
+// in GiB rounded to two decimals. Instances without a numeric Capacity are skipped.
+func totalMemoryGiB(hwInfo dto.HardwareInfo) float64 {
+	total := 0.0
+
+	for _, module := range cimItems(hwInfo.CIMPhysicalMemory) {
+		if capacity, ok := module["Capacity"].(float64); ok && capacity > 0 {
+			total += capacity
+		}
+	}
+
+	return math.Round(total/bytesPerGiB*100) / 100
+}
+
+// systemIdentityFromHardware reads the identifying properties from the CIM_ComputerSystemPackage
+// and CIM_Chassis instances. Each property takes the first non-empty value found.
+func systemIdentityFromHardware(hwInfo dto.HardwareInfo) systemInventory {
+	items := append(cimItems(hwInfo.CIMComputerSystemPackage), cimItems(hwInfo.CIMChassis)...)
+
+	first := func(property string) string {
+		for _, item := range items {
+			if value := strings.TrimSpace(cimString(item, property)); value != "" {
+				return value
 			}
 		}
 
-		payload := map[string]any{
-			"@odata.type": "#ComputerSystem.v1_0_0.ComputerSystem",
-			"@odata.id":   "/redfish/v1/Systems/" + id,
-			"Id":          id,
-			"Name":        "Computer System " + id,
-			"PowerState":  powerState,
-			"Actions": map[string]any{
-				"#ComputerSystem.Reset": map[string]any{
-					"target":                            "/redfish/v1/Systems/" + id + "/Actions/ComputerSystem.Reset",
-					"ResetType@Redfish.AllowableValues": []string{resetTypeOn, resetTypeForceOff, resetTypeForceRestart, resetTypePowerCycle},
-				},
+		return ""
+	}
+
+	return systemInventory{
+		Manufacturer: first("Manufacturer"),
+		Model:        first("Model"),
+		SerialNumber: first("SerialNumber"),
+		SKU:          first("SKU"),
+	}
+}
+
+// systemHealthComponents returns the hardware info elements that roll up into the system health
+func systemHealthComponents(hwInfo dto.HardwareInfo) []dto.CIMResponse {
+	return []dto.CIMResponse{
+		hwInfo.CIMChassis,
+		hwInfo.CIMCard,
+		hwInfo.CIMChip,
+		hwInfo.CIMProcessor,
+		hwInfo.CIMPhysicalMemory,
+		hwInfo.CIMBIOSElement,
+	}
+}
+
+// systemHealth rolls the CIM HealthState and OperationalStatus of the hardware components up into
+// a Redfish Health: the worst component wins, and components that report neither count as OK.
+func systemHealth(hwInfo dto.HardwareInfo) string {
+	health := healthOK
+
+	for _, component := range systemHealthComponents(hwInfo) {
+		for _, item := range cimItems(component) {
+			health = worseHealth(health, cimItemHealth(item))
+		}
+	}
+
+	return health
+}
+
+// cimItemHealth maps the CIM HealthState and OperationalStatus of one instance to the worse of the
+// Redfish Health values they indicate, or OK when it reports neither
+func cimItemHealth(item map[string]any) string {
+	health := cimHealthState(item["HealthState"])
+
+	statuses, _ := item["OperationalStatus"].([]any)
+	for _, status := range statuses {
+		health = worseHealth(health, cimOperationalStatusHealth(status))
+	}
+
+	return health
+}
+
+// cimHealthState maps a CIM HealthState to a Redfish Health
+func cimHealthState(value any) string {
+	state, ok := value.(float64)
+	if !ok {
+		return healthOK
+	}
+
+	switch {
+	case state >= cimHealthMajorFailure:
+		return healthCritical
+	case state >= cimHealthDegraded:
+		return healthWarning
+	default:
+		return healthOK
+	}
+}
+
+// cimOperationalStatusHealth maps one CIM OperationalStatus value to a Redfish Health
+func cimOperationalStatusHealth(value any) string {
+	status, ok := value.(float64)
+	if !ok {
+		return healthOK
+	}
+
+	switch status {
+	case cimStatusDegraded, cimStatusStressed, cimStatusPredictiveFailure:
+		return healthWarning
+	case cimStatusError, cimStatusNonRecoverableError:
+		return healthCritical
+	default:
+		return healthOK
+	}
+}
+
+// worseHealth returns the more severe of two Redfish Health values
+func worseHealth(a, b string) string {
+	if a == healthCritical || b == healthCritical {
+		return healthCritical
+	}
+
+	if a == healthWarning || b == healthWarning {
+		return healthWarning
+	}
+
+	return healthOK
+}
+
+// systemNavigationLinks maps the ComputerSystem navigation properties to the paths of the
+// sub-resources NewSystemsRoutes registers. Processors, Memory and the Chassis and ManagedBy
+// links are left out because this service does not expose those resources.
+var systemNavigationLinks = map[string]func(base, id string) string{
+	"LogServices":        logServicesPath,
+	"Storage":            storagePath,
+	"EthernetInterfaces": ethernetInterfacesPath,
+	"SecureBoot":         secureBootPath,
+	"Bios":               biosPath,
+	"PCIeDevices":        pcieDevicesPath,
+}
+
+// systemPath returns the link of the ComputerSystem id under base
+func systemPath(base, id string) string {
+	return base + "/Systems/" + id
+}
+
+// computerSystemPayload builds the ComputerSystem resource for a device, with its links under base
+func computerSystemPayload(base, id, powerState, health string, inventory systemInventory) map[string]any {
+	payload := map[string]any{
+		"@odata.type": "#ComputerSystem.v1_0_0.ComputerSystem",
+		"@odata.id":   systemPath(base, id),
+		"@odata.etag": systemETag(id, powerState, health),
+		"Id":          id,
+		"Name":        "Computer System " + id,
+		"PowerState":  powerState,
+		"Status":      systemStatus(powerState, health),
+		// AMT does not report a pending one-time override, so the default state is advertised
+		"Boot": map[string]any{
+			"BootSourceOverrideEnabled":                         bootSourceOverrideEnabledDisabled,
+			"BootSourceOverrideEnabled@Redfish.AllowableValues": []string{bootSourceOverrideEnabledDisabled, bootSourceOverrideEnabledOnce},
+			"BootSourceOverrideTarget":                          bootSourceOverrideTargetNone,
+			"BootSourceOverrideTarget@Redfish.AllowableValues":  bootTargetAllowableValues(),
+		},
+		"Actions": map[string]any{
+			"#ComputerSystem.Reset": map[string]any{
+				"target":                            systemPath(base, id) + "/Actions/ComputerSystem.Reset",
+				"@Redfish.ActionInfo":               resetActionInfoPath(base, id),
+				"ResetType@Redfish.AllowableValues": resetTypeAllowableValues,
 			},
+		},
+	}
+
+	for property, path := range systemNavigationLinks {
+		payload[property] = map[string]any{"@odata.id": path(base, id)}
+	}
+
+	// Properties the device did not report are omitted
+	for property, value := range map[string]string{
+		"Manufacturer": inventory.Manufacturer,
+		"Model":        inventory.Model,
+		"SerialNumber": inventory.SerialNumber,
+		"SKU":          inventory.SKU,
+	} {
+		if value != "" {
+			payload[property] = value
 		}
-		c.JSON(http.StatusOK, payload)
 	}
+
+	if inventory.ProcessorCount > 0 {
+		summary := map[string]any{"Count": inventory.ProcessorCount}
+		if inventory.ProcessorModel != "" {
+			summary["Model"] = inventory.ProcessorModel
+		}
+
+		payload["ProcessorSummary"] = summary
+	}
+
+	if inventory.MemoryGiB > 0 {
+		payload["MemorySummary"] = map[string]any{"TotalSystemMemoryGiB": inventory.MemoryGiB}
+	}
+
+	return payload
 }
 
-func postSystemResetHandler(d devices.Feature, l logger.Interface) gin.HandlerFunc {
+// bootTargetAllowableValues returns the supported BootSourceOverrideTarget values in a stable order.
+func bootTargetAllowableValues() []string {
+	return []string{
+		bootSourceOverrideTargetNone,
+		bootSourceOverrideTargetPxe,
+		bootSourceOverrideTargetHdd,
+		bootSourceOverrideTargetCd,
+		bootSourceOverrideTargetBiosSetup,
+	}
+}
+
+// patchSystemInstanceHandler applies a boot source override to the system. The override is
+// only written to the boot configuration and takes effect on the next boot; restarting the
+// system is left to ComputerSystem.Reset. Only the Boot object is writable. An If-Match header
+// is checked against the current ETag of the system, the one GET returns.
+func patchSystemInstanceHandler(d devices.Feature, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+
 	return func(c *gin.Context) {
 		id := c.Param("id")
 
+		if !validateSystemID(c, id) {
+			return
+		}
+
+		if !ifMatch(c, func() string { return currentSystemETag(c.Request.Context(), d, id, timeout) }) {
+			return
+		}
+
+		var body map[string]json.RawMessage
+		if err := c.ShouldBindJSON(&body); err != nil {
+			MalformedJSONError(c, err)
+
+			return
+		}
+
+		if property := firstUnexpectedProperty(body, propertyBoot); property != "" {
+			PropertyNotWritableError(c, property)
+
+			return
+		}
+
+		rawBoot, ok := body[propertyBoot]
+		if !ok {
+			PropertyMissingError(c, propertyBoot)
+
+			return
+		}
+
+		action, ok := parseBootOverride(c, rawBoot)
+		if !ok {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		if err := d.ConfigureBootOptions(ctx, id, dto.BootSetting{Action: action}); err != nil {
+			var nfErr sqldb.NotFoundError
+			if errors.As(err, &nfErr) {
+				ResourceNotFoundError(c, "ComputerSystem", id)
+
+				return
+			}
+
+			l.Error(err, "http - redfish - ComputerSystem boot override [request %s]", requestID(c))
+			deviceCallError(c, err)
+
+			return
+		}
+
+		SetRedfishHeaders(c)
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// parseBootOverride validates the Boot object of a PATCH request and returns the matching
+// AMT boot action. Disabled, or a None target, clears the override. On failure the Redfish
+// error is written and ok is false.
+func parseBootOverride(c *gin.Context, rawBoot json.RawMessage) (action int, ok bool) {
+	var boot map[string]json.RawMessage
+	if err := json.Unmarshal(rawBoot, &boot); err != nil {
+		MalformedJSONError(c, err)
+
+		return 0, false
+	}
+
+	if property := firstUnexpectedProperty(boot, propertyBootSourceOverrideEnabled, propertyBootSourceOverrideTarget); property != "" {
+		PropertyNotWritableError(c, propertyBoot+"/"+property)
+
+		return 0, false
+	}
+
+	enabled := bootSourceOverrideEnabledOnce
+
+	if rawEnabled, present := boot[propertyBootSourceOverrideEnabled]; present {
+		enabled = jsonStringValue(rawEnabled)
+		if enabled != bootSourceOverrideEnabledOnce && enabled != bootSourceOverrideEnabledDisabled {
+			PropertyValueNotInListError(c, enabled, propertyBootSourceOverrideEnabled)
+
+			return 0, false
+		}
+	}
+
+	rawTarget, present := boot[propertyBootSourceOverrideTarget]
+	if !present {
+		if enabled == bootSourceOverrideEnabledDisabled {
+			return devices.BootActionNone, true
+		}
+
+		PropertyMissingError(c, propertyBootSourceOverrideTarget)
+
+		return 0, false
+	}
+
+	target := jsonStringValue(rawTarget)
+
+	action, ok = bootTargetActions[target]
+	if !ok {
+		PropertyValueNotInListError(c, target, propertyBootSourceOverrideTarget)
+
+		return 0, false
+	}
+
+	if enabled == bootSourceOverrideEnabledDisabled {
+		return devices.BootActionNone, true
+	}
+
+	return action, true
+}
+
+// firstUnexpectedProperty returns the alphabetically first property of body that is not in allowed.
+func firstUnexpectedProperty(body map[string]json.RawMessage, allowed ...string) string {
+	unexpected := make([]string, 0, len(body))
+
+	for property := range body {
+		isAllowed := false
+
+		for _, name := range allowed {
+			if property == name {
+				isAllowed = true
+
+				break
+			}
+		}
+
+		if !isAllowed {
+			unexpected = append(unexpected, property)
+		}
+	}
+
+	if len(unexpected) == 0 {
+		return ""
+	}
+
+	sort.Strings(unexpected)
+
+	return unexpected[0]
+}
+
+// jsonStringValue returns the decoded string for a JSON string, or the raw JSON text otherwise.
+func jsonStringValue(raw json.RawMessage) string {
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return string(raw)
+	}
+
+	return value
+}
+
+// postSystemResetHandler sends the requested power action and, on success, publishes the
+// resulting power state to the EventService subscribers. events may be nil. Each action is
+// recorded as a Task in store; a successful reset answers with the finished Task and points
+// the Location header to it.
+// With cfg.Redfish.AsyncResets the action runs in the background instead: the handler answers
+// 202 with the Running task at once and the task completes when the device answers.
+// A dry run validates the request and waits for conflicting resets like a real one, then
+// answers 200 with a completed Task in store instead of calling the device.
+func postSystemResetHandler(d devices.Feature, events *EventPublisher, store *TaskStore, locks *deviceLocks, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+	lockTimeout := deviceLockTimeout(cfg)
+	async := cfg != nil && cfg.Redfish.AsyncResets
+
+	return func(c *gin.Context) {
+		id := c.Param("id")
+
+		if !validateSystemID(c, id) {
+			return
+		}
+
+		// An empty body is reported below as a missing ResetType, whatever its Content-Type
+		if c.Request.ContentLength != 0 && !isContentTypeValid(c.GetHeader("Content-Type")) {
+			UnsupportedMediaTypeError(c, c.GetHeader("Content-Type"))
+
+			return
+		}
+
 		var body struct {
 			ResetType string `json:"ResetType"`
 		}
-		if err := c.ShouldBindJSON(&body); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if err := c.ShouldBindJSON(&body); err != nil && !errors.Is(err, io.EOF) {
+			MalformedJSONError(c, err)
 
 			return
 		}
 
-		var action int
+		if body.ResetType == "" {
+			PropertyMissingError(c, propertyResetType)
+
+			return
+		}
 
-		switch body.ResetType {
-		case resetTypeOn:
-			action = actionPowerUp
-		case resetTypeForceOff:
-			action = actionPowerDown
-		case resetTypeForceRestart:
-			action = actionReset
-		case resetTypePowerCycle:
-			action = actionPowerCycle
-		default:
-			c.JSON(http.StatusBadRequest, gin.H{"error": "unsupported ResetType"})
+		reset, ok := newSystemReset(body.ResetType)
+		if !ok {
+			PropertyValueNotInListError(c, body.ResetType, propertyResetType)
 
 			return
 		}
 
-		res, err := d.SendPowerAction(c.Request.Context(), id, action)
-		if err != nil {
-			l.Error(err, "http - redfish - ComputerSystem.Reset")
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		dryRun, ok := parseDryRun(c)
+		if !ok {
+			return
+		}
+
+		// Overlapping resets of one device confuse AMT, so they run one at a time
+		release, ok := locks.acquire(c.Request.Context(), id, lockTimeout)
+		if !ok {
+			l.Warn("redfish - ComputerSystem.Reset: %s is busy with another reset [request %s]", id, requestID(c))
+			ServiceTemporarilyUnavailableRetryError(c, int(math.Ceil(lockTimeout.Seconds())))
+
+			return
+		}
+
+		if dryRun {
+			defer release()
+
+			task := store.Start("Reset system " + id + " (dry run)")
+			task, _ = store.Finish(task.ID, TaskStateCompleted, TaskStatusOK,
+				taskMessage(BaseSuccessMessageID, "Dry run: ResetType "+body.ResetType+" is valid for system "+id+"; no action was taken."))
+
+			// Nothing is left to run, so the finished Task is answered with 200 rather than 202
+			linked := task.linkedUnder(linkBase(c))
+
+			SetRedfishHeaders(c)
+			c.Header("Location", linked.ODataID)
+			c.JSON(http.StatusOK, linked)
 
 			return
 		}
 
-		c.JSON(http.StatusOK, res)
+		task := store.Start("Reset system " + id)
+		base := linkBase(c)
+
+		// send performs the action and finishes the task with its outcome
+		send := func(ctx context.Context) error {
+			_, err := reset.send(ctx, d, events, store, base, task.ID, id)
+
+			return err
+		}
+
+		if async {
+			// The action outlives the request, so it keeps the request values but not its cancellation,
+			// and holds the device lock until it finishes
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(c.Request.Context()), timeout)
+			reqID := requestID(c)
+
+			go func() {
+				defer release()
+				defer cancel()
+
+				if err := send(ctx); err != nil {
+					l.Error(err, "http - redfish - ComputerSystem.Reset task %s [request %s]", task.ID, reqID)
+				}
+			}()
+
+			linked := task.linkedUnder(linkBase(c))
+
+			SetRedfishHeaders(c)
+			c.Header("Location", linked.ODataID)
+			c.JSON(http.StatusAccepted, linked)
+
+			return
+		}
+
+		defer release()
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		if err := send(ctx); err != nil {
+			l.Error(err, "http - redfish - ComputerSystem.Reset [request %s]", requestID(c))
+			deviceCallError(c, err)
+
+			return
+		}
+
+		// The action has completed by now, so the response is 200 with the finished Task, the
+		// same resource Location points to
+		if finished, ok := store.Get(task.ID); ok {
+			task = finished
+		}
+
+		linked := task.linkedUnder(linkBase(c))
+
+		SetRedfishHeaders(c)
+		c.Header("Location", linked.ODataID)
+		c.JSON(http.StatusOK, linked)
 	}
 }
+
+// systemReset is a validated ResetType with the AMT power action it maps to and the power state
+// the system ends in
+type systemReset struct {
+	resetType  string
+	action     int
+	powerState string
+}
+
+// resetTypeToAction is the one list of the ResetType values ComputerSystem.Reset accepts. It maps
+// each to the AMT power action it sends and the power state the system ends in; the advertised
+// allowable values and the reset dispatch are both derived from it.
+var resetTypeToAction = map[string]systemReset{
+	resetTypeOn:           {resetType: resetTypeOn, action: actionPowerUp, powerState: powerStateOn},
+	resetTypeForceOff:     {resetType: resetTypeForceOff, action: actionPowerDown, powerState: powerStateOff},
+	resetTypeForceRestart: {resetType: resetTypeForceRestart, action: actionReset, powerState: powerStateOn},
+	resetTypePowerCycle:   {resetType: resetTypePowerCycle, action: actionPowerCycle, powerState: powerStateOn},
+}
+
+// newSystemReset maps resetType to its power action; ok is false for unsupported ResetTypes
+func newSystemReset(resetType string) (reset systemReset, ok bool) {
+	reset, ok = resetTypeToAction[resetType]
+
+	return reset, ok
+}
+
+// send performs the reset of system id and finishes task taskID in store with its outcome. On
+// success the resulting power state is published to events, which may be nil, linking the system
+// under base.
+func (r systemReset) send(ctx context.Context, d devices.Feature, events *EventPublisher, store *TaskStore, base, taskID, id string) (power.PowerActionResponse, error) {
+	res, err := d.SendPowerAction(ctx, id, r.action)
+	if err != nil {
+		store.Finish(taskID, TaskStateException, TaskStatusCritical,
+			taskMessage(BaseErrorMessageID, "ResetType "+r.resetType+" of system "+id+" failed."))
+
+		return res, err
+	}
+
+	store.Finish(taskID, TaskStateCompleted, TaskStatusOK,
+		taskMessage(BaseSuccessMessageID, "ResetType "+r.resetType+" was sent to system "+id+"."))
+
+	if events != nil {
+		events.PublishPowerStateChange(base, id, r.powerState)
+	}
+
+	return res, nil
+}
@@ -10,10 +10,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"slices"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -22,14 +27,17 @@ import (
 
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
 
+	"github.com/device-management-toolkit/console/config"
 	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
 	dtov2 "github.com/device-management-toolkit/console/internal/entity/dto/v2"
 	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
 )
 
 const (
-	testSystemGUID     = "test-system-guid-123"
-	testInvalidGUID    = "invalid-system-guid"
+	testSystemGUID     = "8f14e45f-ceea-467a-9575-f3a1d2b1c6a0"
+	testInvalidGUID    = "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"
 	systemsBasePath    = "/redfish/v1/Systems"
 	systemsInstanceURL = systemsBasePath + "/" + testSystemGUID
 	resetActionURL     = systemsInstanceURL + "/Actions/ComputerSystem.Reset"
@@ -48,14 +56,14 @@ func TestNewSystemsRoutes(t *testing.T) {
 		mockLogger := mocks.NewMockLogger(ctrl)
 
 		// Expect logging calls for route registration
-		mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).Times(2) // Systems + Firmware routes
+		mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).Times(8) // Systems + Firmware + LogService + Storage + EthernetInterface + SecureBoot + Bios + PCIeDevice routes
 
 		gin.SetMode(gin.TestMode)
 		router := gin.New()
 
 		// Test route registration
 		redfishGroup := router.Group("/redfish/v1")
-		NewSystemsRoutes(redfishGroup, mockFeature, mockLogger)
+		NewSystemsRoutes(redfishGroup, mockFeature, nil, mockLogger)
 
 		// Verify routes exist by testing them
 		routes := router.Routes()
@@ -64,9 +72,29 @@ func TestNewSystemsRoutes(t *testing.T) {
 		expectedRoutes := []string{
 			"GET /redfish/v1/Systems",
 			"GET /redfish/v1/Systems/:id",
+			"PATCH /redfish/v1/Systems/:id",
 			"POST /redfish/v1/Systems/:id/Actions/ComputerSystem.Reset",
+			"OPTIONS /redfish/v1/Systems",
+			"OPTIONS /redfish/v1/Systems/:id",
+			"OPTIONS /redfish/v1/Systems/:id/Actions/ComputerSystem.Reset",
+			"POST /redfish/v1/Systems/Actions/Oem.BulkReset",
+			"GET /redfish/v1/Systems/:id/ResetActionInfo",
 			"GET /redfish/v1/Systems/:id/FirmwareInventory",
 			"GET /redfish/v1/Systems/:id/FirmwareInventory/:firmwareId",
+			"GET /redfish/v1/Systems/:id/LogServices",
+			"GET /redfish/v1/Systems/:id/LogServices/EventLog",
+			"GET /redfish/v1/Systems/:id/LogServices/EventLog/Entries",
+			"GET /redfish/v1/Systems/:id/LogServices/EventLog/Entries/:entryId",
+			"POST /redfish/v1/Systems/:id/LogServices/EventLog/Actions/LogService.ClearLog",
+			"GET /redfish/v1/Systems/:id/Storage",
+			"GET /redfish/v1/Systems/:id/Storage/:storageId",
+			"GET /redfish/v1/Systems/:id/Storage/:storageId/Drives/:driveId",
+			"GET /redfish/v1/Systems/:id/EthernetInterfaces",
+			"GET /redfish/v1/Systems/:id/EthernetInterfaces/:nicId",
+			"GET /redfish/v1/Systems/:id/SecureBoot",
+			"GET /redfish/v1/Systems/:id/Bios",
+			"GET /redfish/v1/Systems/:id/PCIeDevices",
+			"GET /redfish/v1/Systems/:id/PCIeDevices/:deviceId",
 		}
 
 		routeMap := make(map[string]bool)
@@ -91,7 +119,7 @@ func TestNewSystemsRoutes(t *testing.T) {
 		// This will panic due to firmware routes accessing nil logger
 		// Testing that routes can be set up, but will fail on actual usage
 		require.Panics(t, func() {
-			NewSystemsRoutes(redfishGroup, nil, nil)
+			NewSystemsRoutes(redfishGroup, nil, nil, nil)
 		})
 	})
 }
@@ -110,7 +138,7 @@ func TestGetSystemsCollectionHandler(t *testing.T) {
 			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
 				devices := []dto.Device{
 					{
-						GUID:            "system-1",
+						GUID:            "4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61",
 						Hostname:        "host1",
 						Tags:            []string{"tag1"},
 						DNSSuffix:       "example.com",
@@ -120,7 +148,7 @@ func TestGetSystemsCollectionHandler(t *testing.T) {
 						AllowSelfSigned: false,
 					},
 					{
-						GUID:            "system-2",
+						GUID:            "4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a62",
 						Hostname:        "host2",
 						Tags:            []string{"tag2"},
 						DNSSuffix:       "example.com",
@@ -142,7 +170,7 @@ func TestGetSystemsCollectionHandler(t *testing.T) {
 				}
 
 				mockFeature.EXPECT().
-					Get(gomock.Any(), maxSystemsList, 0, "").
+					Get(gomock.Any(), DefaultPageSize+1, 0, "").
 					Return(devices, nil)
 
 				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
@@ -171,19 +199,19 @@ func TestGetSystemsCollectionHandler(t *testing.T) {
 				// Check first member
 				member1, ok := members[0].(map[string]interface{})
 				require.True(t, ok, "First member should be a map")
-				assert.Equal(t, "/redfish/v1/Systems/system-1", member1["@odata.id"])
+				assert.Equal(t, "/redfish/v1/Systems/4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61", member1["@odata.id"])
 
 				// Check second member
 				member2, ok := members[1].(map[string]interface{})
 				require.True(t, ok, "Second member should be a map")
-				assert.Equal(t, "/redfish/v1/Systems/system-2", member2["@odata.id"])
+				assert.Equal(t, "/redfish/v1/Systems/4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a62", member2["@odata.id"])
 			},
 		},
 		{
 			name: "empty collection",
 			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
 				mockFeature.EXPECT().
-					Get(gomock.Any(), maxSystemsList, 0, "").
+					Get(gomock.Any(), DefaultPageSize+1, 0, "").
 					Return([]dto.Device{}, nil)
 
 				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
@@ -210,7 +238,7 @@ func TestGetSystemsCollectionHandler(t *testing.T) {
 			name: "backend error",
 			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
 				mockFeature.EXPECT().
-					Get(gomock.Any(), maxSystemsList, 0, "").
+					Get(gomock.Any(), DefaultPageSize+1, 0, "").
 					Return(nil, fmt.Errorf("backend connection failed"))
 
 				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
@@ -218,7 +246,8 @@ func TestGetSystemsCollectionHandler(t *testing.T) {
 			expectedStatus: http.StatusInternalServerError,
 			validateResponse: func(t *testing.T, body string) {
 				t.Helper()
-				assert.Contains(t, body, "backend connection failed")
+				assert.Contains(t, body, BaseErrorMessageID)
+				assert.NotContains(t, body, "backend connection failed")
 			},
 		},
 	}
@@ -238,7 +267,7 @@ func TestGetSystemsCollectionHandler(t *testing.T) {
 			gin.SetMode(gin.TestMode)
 			router := gin.New()
 			systems := router.Group("/redfish/v1/Systems")
-			systems.GET("", getSystemsCollectionHandler(mockFeature, mockLogger))
+			systems.GET("", getSystemsCollectionHandler(mockFeature, nil, mockLogger))
 
 			w := httptest.NewRecorder()
 			req, _ := http.NewRequestWithContext(
@@ -256,154 +285,405 @@ func TestGetSystemsCollectionHandler(t *testing.T) {
 	}
 }
 
-func TestGetSystemInstanceHandler(t *testing.T) {
+func TestSystemsHandlerLogsRequestID(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	mockFeature.EXPECT().Get(gomock.Any(), DefaultPageSize+1, 0, "").Return(nil, fmt.Errorf("backend connection failed"))
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).
+		Do(func(_ interface{}, args ...interface{}) {
+			assert.Contains(t, args, "trace-123")
+		}).Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(RedfishRequestIDMiddleware())
+	router.GET("/redfish/v1/Systems", getSystemsCollectionHandler(mockFeature, nil, mockLogger))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/redfish/v1/Systems", http.NoBody)
+	req.Header.Set("X-Request-Id", "trace-123")
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, "trace-123", w.Header().Get("X-Request-Id"))
+}
+
+func TestGetSystemsCollectionPagination(t *testing.T) {
 	t.Parallel()
 
+	pageOf := func(guids ...string) []dto.Device {
+		page := make([]dto.Device, 0, len(guids))
+		for _, guid := range guids {
+			page = append(page, dto.Device{GUID: guid})
+		}
+
+		return page
+	}
+
+	// numbered returns n GUIDs that sort in numeric order
+	numbered := func(n int) []string {
+		guids := make([]string, 0, n)
+		for i := range n {
+			guids = append(guids, fmt.Sprintf("s%03d", i))
+		}
+
+		return guids
+	}
+
 	tests := []struct {
 		name             string
-		systemID         string
-		setupMocks       func(*mocks.MockDeviceManagementFeature, *mocks.MockLogger)
+		query            string
+		setupMocks       func(*mocks.MockDeviceManagementFeature)
 		expectedStatus   int
-		validateResponse func(t *testing.T, body string)
+		expectedMembers  []string
+		expectedNextLink string
+		expectedArgs     []string
 	}{
 		{
-			name:     "successful system retrieval with power on",
-			systemID: testSystemGUID,
-			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
-				powerState := dto.PowerState{
-					PowerState: actionPowerUp, // 2 = On
-				}
-				mockFeature.EXPECT().
-					GetPowerState(gomock.Any(), testSystemGUID).
-					Return(powerState, nil)
-
-				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			name:  "first page is truncated",
+			query: "?$top=2",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature) {
+				mockFeature.EXPECT().Get(gomock.Any(), 3, 0, "").Return(pageOf("s1", "s2", "s3"), nil)
 			},
-			expectedStatus: http.StatusOK,
-			validateResponse: func(t *testing.T, body string) {
-				t.Helper()
+			expectedStatus:   http.StatusOK,
+			expectedMembers:  []string{"s1", "s2"},
+			expectedNextLink: "/redfish/v1/Systems?$top=2&$skip=2",
+		},
+		{
+			name:  "second page",
+			query: "?$top=2&$skip=2",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature) {
+				mockFeature.EXPECT().Get(gomock.Any(), 3, 2, "").Return(pageOf("s3", "s4", "s5"), nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedMembers:  []string{"s3", "s4"},
+			expectedNextLink: "/redfish/v1/Systems?$top=2&$skip=4",
+		},
+		{
+			name:  "last page has no nextLink",
+			query: "?$top=2&$skip=4",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature) {
+				mockFeature.EXPECT().Get(gomock.Any(), 3, 4, "").Return(pageOf("s5"), nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedMembers: []string{"s5"},
+		},
+		{
+			name:  "default page size without top",
+			query: "",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature) {
+				mockFeature.EXPECT().Get(gomock.Any(), DefaultPageSize+1, 0, "").Return(pageOf(numbered(DefaultPageSize+1)...), nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedMembers:  numbered(DefaultPageSize),
+			expectedNextLink: fmt.Sprintf("/redfish/v1/Systems?$top=%d&$skip=%d", DefaultPageSize, DefaultPageSize),
+		},
+		{
+			name:  "top above the default page size",
+			query: "?$top=80",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature) {
+				mockFeature.EXPECT().Get(gomock.Any(), 81, 0, "").Return(pageOf(numbered(80)...), nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedMembers: numbered(80),
+		},
+		{
+			name:  "oversized top is clamped",
+			query: "?$top=5000",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature) {
+				mockFeature.EXPECT().Get(gomock.Any(), maxSystemsList+1, 0, "").Return(pageOf("s1"), nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedMembers: []string{"s1"},
+		},
+		{
+			name:           "non-numeric top",
+			query:          "?$top=abc",
+			setupMocks:     func(_ *mocks.MockDeviceManagementFeature) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedArgs:   []string{"abc", "$top"},
+		},
+		{
+			name:           "negative top",
+			query:          "?$top=-1",
+			setupMocks:     func(_ *mocks.MockDeviceManagementFeature) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedArgs:   []string{"-1", "$top"},
+		},
+		{
+			name:           "negative skip",
+			query:          "?$skip=-1",
+			setupMocks:     func(_ *mocks.MockDeviceManagementFeature) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedArgs:   []string{"-1", "$skip"},
+		},
+	}
 
-				var system map[string]interface{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
 
-				err := json.Unmarshal([]byte(body), &system)
-				require.NoError(t, err)
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
 
-				assert.Equal(t, "#ComputerSystem.v1_0_0.ComputerSystem", system["@odata.type"])
-				assert.Equal(t, "/redfish/v1/Systems/"+testSystemGUID, system["@odata.id"])
-				assert.Equal(t, testSystemGUID, system["Id"])
-				assert.Equal(t, "Computer System "+testSystemGUID, system["Name"])
-				assert.Equal(t, powerStateOn, system["PowerState"])
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockLogger := mocks.NewMockLogger(ctrl)
 
-				// Check Actions
-				actions, ok := system["Actions"].(map[string]interface{})
-				require.True(t, ok, "Actions should be a map")
-				resetAction, ok := actions["#ComputerSystem.Reset"].(map[string]interface{})
-				require.True(t, ok, "Reset action should be a map")
-				assert.Equal(t, "/redfish/v1/Systems/"+testSystemGUID+"/Actions/ComputerSystem.Reset", resetAction["target"])
+			tt.setupMocks(mockFeature)
 
-				allowedValues, ok := resetAction["ResetType@Redfish.AllowableValues"].([]interface{})
-				require.True(t, ok, "AllowableValues should be a slice of interfaces")
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/redfish/v1/Systems", getSystemsCollectionHandler(mockFeature, nil, mockLogger))
 
-				expectedValues := []string{resetTypeOn, resetTypeForceOff, resetTypeForceRestart, resetTypePowerCycle}
-				assert.Equal(t, len(expectedValues), len(allowedValues))
-			},
-		},
-		{
-			name:     "system with power off state",
-			systemID: testSystemGUID,
-			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
-				powerState := dto.PowerState{
-					PowerState: cimPowerSoftOff, // 7 = Soft Off
-				}
-				mockFeature.EXPECT().
-					GetPowerState(gomock.Any(), testSystemGUID).
-					Return(powerState, nil)
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/redfish/v1/Systems"+tt.query, http.NoBody)
 
-				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
-			},
-			expectedStatus: http.StatusOK,
-			validateResponse: func(t *testing.T, body string) {
-				t.Helper()
+			router.ServeHTTP(w, req)
 
-				var system map[string]interface{}
+			require.Equal(t, tt.expectedStatus, w.Code)
 
-				err := json.Unmarshal([]byte(body), &system)
-				require.NoError(t, err)
+			var body map[string]any
 
-				assert.Equal(t, powerStateOff, system["PowerState"])
-			},
-		},
-		{
-			name:     "system with sleep state (treated as on)",
-			systemID: testSystemGUID,
-			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
-				powerState := dto.PowerState{
-					PowerState: cimPowerSleep, // 3 = Sleep
-				}
-				mockFeature.EXPECT().
-					GetPowerState(gomock.Any(), testSystemGUID).
-					Return(powerState, nil)
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
 
-				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
-			},
-			expectedStatus: http.StatusOK,
-			validateResponse: func(t *testing.T, body string) {
-				t.Helper()
+			if tt.expectedArgs != nil {
+				errorBody, ok := body["error"].(map[string]any)
+				require.True(t, ok)
 
-				var system map[string]interface{}
+				extended, ok := errorBody["@Message.ExtendedInfo"].([]any)
+				require.True(t, ok)
+				require.Len(t, extended, 1)
 
-				err := json.Unmarshal([]byte(body), &system)
-				require.NoError(t, err)
+				info, ok := extended[0].(map[string]any)
+				require.True(t, ok)
+				assert.Equal(t, BaseQueryParameterOutOfRangeID, info["MessageId"])
+				assert.ElementsMatch(t, tt.expectedArgs, info["MessageArgs"])
 
-				assert.Equal(t, powerStateOn, system["PowerState"]) // Sleep treated as On
+				return
+			}
+
+			members, ok := body["Members"].([]any)
+			require.True(t, ok)
+			require.Len(t, members, len(tt.expectedMembers))
+
+			for i, guid := range tt.expectedMembers {
+				member, ok := members[i].(map[string]any)
+				require.True(t, ok)
+				assert.Equal(t, "/redfish/v1/Systems/"+guid, member["@odata.id"])
+			}
+
+			if tt.expectedNextLink == "" {
+				assert.NotContains(t, body, "Members@odata.nextLink")
+			} else {
+				assert.Equal(t, tt.expectedNextLink, body["Members@odata.nextLink"])
+			}
+		})
+	}
+}
+
+func TestGetSystemsCollectionHostname(t *testing.T) {
+	t.Parallel()
+
+	fleet := []dto.Device{
+		{GUID: "c3", Hostname: "server-1"},
+		{GUID: "a1", Hostname: "Server-1"},
+		{GUID: "b2", Hostname: "server-2"},
+		{GUID: "", Hostname: "server-1"},
+	}
+
+	// fullBatch fills a whole scan batch, so the scan reads the next one as well
+	fullBatch := make([]dto.Device, 0, maxSystemsList)
+	for i := range maxSystemsList {
+		fullBatch = append(fullBatch, dto.Device{GUID: fmt.Sprintf("x%03d", i), Hostname: "other"})
+	}
+
+	fullBatch[7].Hostname = "server-1"
+
+	tests := []struct {
+		name             string
+		query            string
+		setupMocks       func(*mocks.MockDeviceManagementFeature)
+		expectedStatus   int
+		expectedMembers  []string
+		expectedCount    int
+		expectedNextLink string
+	}{
+		{
+			name:  "matching hostname",
+			query: "?hostname=server-2",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature) {
+				mockFeature.EXPECT().Get(gomock.Any(), maxSystemsList, 0, "").Return(fleet, nil)
 			},
+			expectedStatus:  http.StatusOK,
+			expectedMembers: []string{"b2"},
+			expectedCount:   1,
 		},
 		{
-			name:     "power state retrieval failure",
-			systemID: testSystemGUID,
-			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
-				mockFeature.EXPECT().
-					GetPowerState(gomock.Any(), testSystemGUID).
-					Return(dto.PowerState{}, fmt.Errorf("power state not available"))
-
-				mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+			name:  "case-insensitive match",
+			query: "?hostname=SERVER-1",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature) {
+				mockFeature.EXPECT().Get(gomock.Any(), maxSystemsList, 0, "").Return(fleet, nil)
 			},
-			expectedStatus: http.StatusOK,
-			validateResponse: func(t *testing.T, body string) {
-				t.Helper()
+			expectedStatus:  http.StatusOK,
+			expectedMembers: []string{"a1", "c3"},
+			expectedCount:   2,
+		},
+		{
+			name:  "no match",
+			query: "?hostname=server-9",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature) {
+				mockFeature.EXPECT().Get(gomock.Any(), maxSystemsList, 0, "").Return(fleet, nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedMembers: []string{},
+		},
+		{
+			name:  "matches across scan batches",
+			query: "?hostname=server-1",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature) {
+				mockFeature.EXPECT().Get(gomock.Any(), maxSystemsList, 0, "").Return(fullBatch, nil)
+				mockFeature.EXPECT().Get(gomock.Any(), maxSystemsList, maxSystemsList, "").Return(fleet, nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedMembers: []string{"a1", "c3", "x007"},
+			expectedCount:   3,
+		},
+		{
+			name:  "paged matches keep the hostname",
+			query: "?hostname=server-1&$top=1",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature) {
+				mockFeature.EXPECT().Get(gomock.Any(), maxSystemsList, 0, "").Return(fleet, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedMembers:  []string{"a1"},
+			expectedCount:    1,
+			expectedNextLink: "/redfish/v1/Systems?$top=1&$skip=1&hostname=server-1",
+		},
+		{
+			name:  "count of matches",
+			query: "?hostname=server-1&count-only=true",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature) {
+				mockFeature.EXPECT().Get(gomock.Any(), maxSystemsList, 0, "").Return(fleet, nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedMembers: []string{},
+			expectedCount:   2,
+		},
+		{
+			name:           "empty hostname",
+			query:          "?hostname=",
+			setupMocks:     func(_ *mocks.MockDeviceManagementFeature) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
 
-				var system map[string]interface{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
 
-				err := json.Unmarshal([]byte(body), &system)
-				require.NoError(t, err)
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
 
-				assert.Equal(t, powerStateUnknown, system["PowerState"]) // Default to Unknown
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			tt.setupMocks(mockFeature)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/redfish/v1/Systems", getSystemsCollectionHandler(mockFeature, nil, mocks.NewMockLogger(ctrl)))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/redfish/v1/Systems"+tt.query, http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus != http.StatusOK {
+				assert.Contains(t, w.Body.String(), BaseQueryParameterOutOfRangeID)
+
+				return
+			}
+
+			var body struct {
+				Count    int    `json:"Members@odata.count"`
+				NextLink string `json:"Members@odata.nextLink"`
+				Members  []struct {
+					ODataID string `json:"@odata.id"`
+				} `json:"Members"`
+			}
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, tt.expectedCount, body.Count)
+			assert.Equal(t, tt.expectedNextLink, body.NextLink)
+			require.Len(t, body.Members, len(tt.expectedMembers))
+
+			for i, guid := range tt.expectedMembers {
+				assert.Equal(t, "/redfish/v1/Systems/"+guid, body.Members[i].ODataID)
+			}
+		})
+	}
+}
+
+func TestGetSystemsCollectionCountOnly(t *testing.T) {
+	t.Parallel()
+
+	errStore := fmt.Errorf("database unavailable")
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMocks     func(*mocks.MockDeviceManagementFeature, *mocks.MockLogger)
+		expectedStatus int
+		expectedCount  float64
+	}{
+		{
+			name:  "top zero",
+			query: "?$top=0",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetCount(gomock.Any(), "").Return(1234, nil)
 			},
+			expectedStatus: http.StatusOK,
+			expectedCount:  1234,
 		},
 		{
-			name:     "unknown power state value",
-			systemID: testSystemGUID,
-			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
-				powerState := dto.PowerState{
-					PowerState: 999, // Unknown value
-				}
-				mockFeature.EXPECT().
-					GetPowerState(gomock.Any(), testSystemGUID).
-					Return(powerState, nil)
-
-				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			name:  "count-only flag",
+			query: "?count-only=true",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetCount(gomock.Any(), "").Return(7, nil)
 			},
 			expectedStatus: http.StatusOK,
-			validateResponse: func(t *testing.T, body string) {
-				t.Helper()
-
-				var system map[string]interface{}
-
-				err := json.Unmarshal([]byte(body), &system)
-				require.NoError(t, err)
-
-				assert.Equal(t, powerStateUnknown, system["PowerState"])
+			expectedCount:  7,
+		},
+		{
+			name:  "count-only ignores paging",
+			query: "?count-only=1&$top=5&$skip=10",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().GetCount(gomock.Any(), "").Return(0, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedCount:  0,
+		},
+		{
+			name:           "malformed count-only",
+			query:          "?count-only=maybe",
+			setupMocks:     func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "count fails",
+			query: "?$top=0",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().GetCount(gomock.Any(), "").Return(0, errStore)
+				mockLogger.EXPECT().Error(errStore, gomock.Any(), gomock.Any())
 			},
+			expectedStatus: http.StatusInternalServerError,
 		},
 	}
 
@@ -414,6 +694,7 @@ func TestGetSystemInstanceHandler(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			t.Cleanup(ctrl.Finish)
 
+			// Get is never expected: count-only requests must not list the devices
 			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
 			mockLogger := mocks.NewMockLogger(ctrl)
 
@@ -421,166 +702,2397 @@ func TestGetSystemInstanceHandler(t *testing.T) {
 
 			gin.SetMode(gin.TestMode)
 			router := gin.New()
-			systems := router.Group("/redfish/v1/Systems")
-			systems.GET(":id", getSystemInstanceHandler(mockFeature, mockLogger))
+			router.GET("/redfish/v1/Systems", getSystemsCollectionHandler(mockFeature, nil, mockLogger))
 
 			w := httptest.NewRecorder()
-			req, _ := http.NewRequestWithContext(
-				context.Background(),
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/redfish/v1/Systems"+tt.query, http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var body map[string]any
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+
+			assert.Equal(t, "#ComputerSystemCollection.ComputerSystemCollection", body["@odata.type"])
+			assert.Equal(t, tt.expectedCount, body["Members@odata.count"])
+			assert.Equal(t, []any{}, body["Members"])
+			assert.NotContains(t, body, "Members@odata.nextLink")
+		})
+	}
+}
+
+func TestGetSystemsCollectionMemberOrder(t *testing.T) {
+	t.Parallel()
+
+	shuffled := []dto.Device{{GUID: "c3"}, {GUID: "a1"}, {GUID: ""}, {GUID: "d4"}, {GUID: "b2"}}
+	sorted := []string{"a1", "b2", "c3", "d4"}
+
+	tests := []struct {
+		name  string
+		query string
+		top   int
+	}{
+		{name: "references", query: "", top: DefaultPageSize},
+		{name: "expanded", query: "?$expand=.", top: maxExpandedSystems},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().Get(gomock.Any(), tt.top+1, 0, "").Return(shuffled, nil)
+			mockFeature.EXPECT().GetPowerState(gomock.Any(), gomock.Any()).
+				Return(dto.PowerState{PowerState: cimPowerOn}, nil).AnyTimes()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/redfish/v1/Systems", getSystemsCollectionHandler(mockFeature, nil, mocks.NewMockLogger(ctrl)))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/redfish/v1/Systems"+tt.query, http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var body struct {
+				Members []struct {
+					ODataID string `json:"@odata.id"`
+				} `json:"Members"`
+			}
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+
+			ids := make([]string, 0, len(body.Members))
+			for _, member := range body.Members {
+				ids = append(ids, strings.TrimPrefix(member.ODataID, "/redfish/v1/Systems/"))
+			}
+
+			assert.Equal(t, sorted, ids)
+		})
+	}
+}
+
+func TestGetSystemsCollectionExpand(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		query            string
+		setupMocks       func(*mocks.MockDeviceManagementFeature, *mocks.MockLogger)
+		expectedStatus   int
+		expectedStates   map[string]string
+		expectedNextLink string
+	}{
+		{
+			name:  "levels expression inlines members",
+			query: "?$expand=.($levels=1)",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().Get(gomock.Any(), maxExpandedSystems+1, 0, "").
+					Return([]dto.Device{{GUID: "s1"}, {GUID: ""}, {GUID: "s2"}}, nil)
+				mockFeature.EXPECT().GetPowerState(gomock.Any(), "s1").Return(dto.PowerState{PowerState: cimPowerOn}, nil)
+				mockFeature.EXPECT().GetPowerState(gomock.Any(), "s2").Return(dto.PowerState{}, fmt.Errorf("unreachable"))
+				mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).Times(1)
+			},
+			expectedStatus: http.StatusOK,
+			expectedStates: map[string]string{"s1": powerStateOn, "s2": powerStateUnknown},
+		},
+		{
+			name:  "Members expression is accepted",
+			query: "?$expand=Members",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().Get(gomock.Any(), maxExpandedSystems+1, 0, "").Return([]dto.Device{{GUID: "s1"}}, nil)
+				mockFeature.EXPECT().GetPowerState(gomock.Any(), "s1").Return(dto.PowerState{PowerState: cimPowerSoftOff}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedStates: map[string]string{"s1": powerStateOff},
+		},
+		{
+			name:  "expanded page size is capped",
+			query: "?$expand=*&$top=1000",
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				page := make([]dto.Device, 0, maxExpandedSystems+1)
+				for i := 0; i <= maxExpandedSystems; i++ {
+					page = append(page, dto.Device{GUID: fmt.Sprintf("s%d", i)})
+				}
+
+				mockFeature.EXPECT().Get(gomock.Any(), maxExpandedSystems+1, 0, "").Return(page, nil)
+				mockFeature.EXPECT().GetPowerState(gomock.Any(), gomock.Any()).
+					Return(dto.PowerState{PowerState: cimPowerOn}, nil).Times(maxExpandedSystems)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedNextLink: fmt.Sprintf("/redfish/v1/Systems?$top=%d&$skip=%d&$expand=%%2A", maxExpandedSystems, maxExpandedSystems),
+		},
+		{
+			name:           "unrecognized expand expression",
+			query:          "?$expand=.($levels=2)",
+			setupMocks:     func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockLogger := mocks.NewMockLogger(ctrl)
+
+			tt.setupMocks(mockFeature, mockLogger)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/redfish/v1/Systems", getSystemsCollectionHandler(mockFeature, nil, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/redfish/v1/Systems"+tt.query, http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus != http.StatusOK {
+				assert.Contains(t, w.Body.String(), BaseQueryParameterUnsupportedID)
+
+				return
+			}
+
+			var body struct {
+				Members []struct {
+					ODataID    string `json:"@odata.id"`
+					ODataType  string `json:"@odata.type"`
+					ID         string `json:"Id"`
+					PowerState string `json:"PowerState"`
+				} `json:"Members"`
+				NextLink string `json:"Members@odata.nextLink"`
+			}
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, tt.expectedNextLink, body.NextLink)
+
+			if tt.expectedStates == nil {
+				assert.Len(t, body.Members, maxExpandedSystems)
+
+				return
+			}
+
+			require.Len(t, body.Members, len(tt.expectedStates))
+
+			for _, member := range body.Members {
+				assert.Equal(t, "#ComputerSystem.v1_0_0.ComputerSystem", member.ODataType)
+				assert.Equal(t, "/redfish/v1/Systems/"+member.ID, member.ODataID)
+				assert.Equal(t, tt.expectedStates[member.ID], member.PowerState)
+			}
+		})
+	}
+}
+
+func TestGetSystemInstanceHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		systemID         string
+		setupMocks       func(*mocks.MockDeviceManagementFeature, *mocks.MockLogger)
+		expectedStatus   int
+		validateResponse func(t *testing.T, body string)
+	}{
+		{
+			name:     "successful system retrieval with power on",
+			systemID: testSystemGUID,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				powerState := dto.PowerState{
+					PowerState: actionPowerUp, // 2 = On
+				}
+				mockFeature.EXPECT().
+					GetPowerState(gomock.Any(), testSystemGUID).
+					Return(powerState, nil)
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(dto.HardwareInfo{}, nil)
+
+				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectedStatus: http.StatusOK,
+			validateResponse: func(t *testing.T, body string) {
+				t.Helper()
+
+				var system map[string]interface{}
+
+				err := json.Unmarshal([]byte(body), &system)
+				require.NoError(t, err)
+
+				assert.Equal(t, "#ComputerSystem.v1_0_0.ComputerSystem", system["@odata.type"])
+				assert.Equal(t, "/redfish/v1/Systems/"+testSystemGUID, system["@odata.id"])
+				assert.Equal(t, testSystemGUID, system["Id"])
+				assert.Equal(t, "Computer System "+testSystemGUID, system["Name"])
+				assert.Equal(t, powerStateOn, system["PowerState"])
+
+				// Check Actions
+				actions, ok := system["Actions"].(map[string]interface{})
+				require.True(t, ok, "Actions should be a map")
+				resetAction, ok := actions["#ComputerSystem.Reset"].(map[string]interface{})
+				require.True(t, ok, "Reset action should be a map")
+				assert.Equal(t, "/redfish/v1/Systems/"+testSystemGUID+"/Actions/ComputerSystem.Reset", resetAction["target"])
+				assert.Equal(t, "/redfish/v1/Systems/"+testSystemGUID+"/ResetActionInfo", resetAction["@Redfish.ActionInfo"])
+
+				allowedValues, ok := resetAction["ResetType@Redfish.AllowableValues"].([]interface{})
+				require.True(t, ok, "AllowableValues should be a slice of interfaces")
+
+				expectedValues := []string{resetTypeOn, resetTypeForceOff, resetTypeForceRestart, resetTypePowerCycle}
+				assert.Equal(t, len(expectedValues), len(allowedValues))
+
+				// Check Boot override
+				bootObj, ok := system["Boot"].(map[string]interface{})
+				require.True(t, ok, "Boot should be a map")
+				assert.Equal(t, "Disabled", bootObj["BootSourceOverrideEnabled"])
+				assert.Equal(t, "None", bootObj["BootSourceOverrideTarget"])
+				assert.Equal(t, []interface{}{"Disabled", "Once"}, bootObj["BootSourceOverrideEnabled@Redfish.AllowableValues"])
+				assert.Equal(t, []interface{}{"None", "Pxe", "Hdd", "Cd", "BiosSetup"}, bootObj["BootSourceOverrideTarget@Redfish.AllowableValues"])
+			},
+		},
+		{
+			name:     "system with power off state",
+			systemID: testSystemGUID,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				powerState := dto.PowerState{
+					PowerState: cimPowerSoftOff, // 7 = Soft Off
+				}
+				mockFeature.EXPECT().
+					GetPowerState(gomock.Any(), testSystemGUID).
+					Return(powerState, nil)
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(dto.HardwareInfo{}, nil)
+
+				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectedStatus: http.StatusOK,
+			validateResponse: func(t *testing.T, body string) {
+				t.Helper()
+
+				var system map[string]interface{}
+
+				err := json.Unmarshal([]byte(body), &system)
+				require.NoError(t, err)
+
+				assert.Equal(t, powerStateOff, system["PowerState"])
+			},
+		},
+		{
+			name:     "system with sleep state (treated as on)",
+			systemID: testSystemGUID,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				powerState := dto.PowerState{
+					PowerState: cimPowerSleep, // 3 = Sleep
+				}
+				mockFeature.EXPECT().
+					GetPowerState(gomock.Any(), testSystemGUID).
+					Return(powerState, nil)
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(dto.HardwareInfo{}, nil)
+
+				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectedStatus: http.StatusOK,
+			validateResponse: func(t *testing.T, body string) {
+				t.Helper()
+
+				var system map[string]interface{}
+
+				err := json.Unmarshal([]byte(body), &system)
+				require.NoError(t, err)
+
+				assert.Equal(t, powerStateOn, system["PowerState"]) // Sleep treated as On
+			},
+		},
+		{
+			name:     "power state retrieval failure",
+			systemID: testSystemGUID,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().
+					GetPowerState(gomock.Any(), testSystemGUID).
+					Return(dto.PowerState{}, fmt.Errorf("power state not available"))
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(dto.HardwareInfo{}, nil)
+
+				mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).Times(1)
+			},
+			expectedStatus: http.StatusOK,
+			validateResponse: func(t *testing.T, body string) {
+				t.Helper()
+
+				var system map[string]interface{}
+
+				err := json.Unmarshal([]byte(body), &system)
+				require.NoError(t, err)
+
+				assert.Equal(t, powerStateUnknown, system["PowerState"]) // Default to Unknown
+			},
+		},
+		{
+			name:     "unknown power state value",
+			systemID: testSystemGUID,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				powerState := dto.PowerState{
+					PowerState: 999, // Unknown value
+				}
+				mockFeature.EXPECT().
+					GetPowerState(gomock.Any(), testSystemGUID).
+					Return(powerState, nil)
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(dto.HardwareInfo{}, nil)
+
+				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectedStatus: http.StatusOK,
+			validateResponse: func(t *testing.T, body string) {
+				t.Helper()
+
+				var system map[string]interface{}
+
+				err := json.Unmarshal([]byte(body), &system)
+				require.NoError(t, err)
+
+				assert.Equal(t, powerStateUnknown, system["PowerState"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockLogger := mocks.NewMockLogger(ctrl)
+
+			tt.setupMocks(mockFeature, mockLogger)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			systems := router.Group("/redfish/v1/Systems")
+			systems.GET(":id", getSystemInstanceHandler(mockFeature, nil, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(
+				context.Background(),
+				"GET",
+				"/redfish/v1/Systems/"+tt.systemID,
+				http.NoBody,
+			)
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			tt.validateResponse(t, w.Body.String())
+		})
+	}
+}
+
+func TestGetSystemInstanceIfModifiedSince(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		ifModifiedSince string
+		ifNoneMatch     string
+		expectedStatus  int
+	}{
+		{
+			name:           "Last-Modified is emitted",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:            "future timestamp yields 304",
+			ifModifiedSince: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat),
+			expectedStatus:  http.StatusNotModified,
+		},
+		{
+			name:            "past timestamp yields 200",
+			ifModifiedSince: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat),
+			expectedStatus:  http.StatusOK,
+		},
+		{
+			name:            "If-None-Match takes precedence",
+			ifModifiedSince: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat),
+			ifNoneMatch:     `W/"stale"`,
+			expectedStatus:  http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockLogger := mocks.NewMockLogger(ctrl)
+
+			mockFeature.EXPECT().GetPowerState(gomock.Any(), "4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61").
+				Return(dto.PowerState{PowerState: cimPowerOn}, nil)
+			mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), "4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61").Return(dto.HardwareInfo{}, nil)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/redfish/v1/Systems/:id", getSystemInstanceHandler(mockFeature, nil, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/redfish/v1/Systems/4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61", http.NoBody)
+
+			if tt.ifModifiedSince != "" {
+				req.Header.Set("If-Modified-Since", tt.ifModifiedSince)
+			}
+
+			if tt.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedStatus, w.Code)
+
+			lastModified, err := http.ParseTime(w.Header().Get("Last-Modified"))
+			require.NoError(t, err)
+			assert.WithinDuration(t, time.Now(), lastModified, time.Minute)
+
+			if tt.expectedStatus == http.StatusNotModified {
+				assert.Empty(t, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestValidateSystemID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		id       string
+		expected bool
+	}{
+		{name: "valid GUID", id: "4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61", expected: true},
+		{name: "upper case GUID", id: "4F0E9C1A-8D2B-4E6F-A7C3-1B5D9E2F4A61", expected: true},
+		{name: "empty id", id: "", expected: false},
+		{name: "malformed id", id: "not-a-guid", expected: false},
+		{name: "GUID in braces", id: "{4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61}", expected: false},
+		{name: "GUID without dashes", id: "4f0e9c1a8d2b4e6fa7c31b5d9e2f4a61", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequestWithContext(context.Background(), http.MethodGet, "/test", http.NoBody)
+
+			assert.Equal(t, tt.expected, validateSystemID(c, tt.id))
+
+			if tt.expected {
+				assert.False(t, c.Writer.Written())
+
+				return
+			}
+
+			assert.Equal(t, http.StatusNotFound, w.Code)
+			assert.Contains(t, w.Body.String(), BaseResourceNotFoundID)
+		})
+	}
+}
+
+func TestMalformedSystemIDSkipsBackend(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		route  string
+		body   string
+	}{
+		{name: "Systems instance", method: http.MethodGet, path: "/redfish/v1/Systems/not-a-guid", route: "/redfish/v1/Systems/:id"},
+		{
+			name:   "Reset action",
+			method: http.MethodPost,
+			path:   "/redfish/v1/Systems/not-a-guid/Actions/ComputerSystem.Reset",
+			route:  "/redfish/v1/Systems/:id/Actions/ComputerSystem.Reset",
+			body:   `{"ResetType":"On"}`,
+		},
+		{
+			name:   "FirmwareInventory collection",
+			method: http.MethodGet,
+			path:   "/redfish/v1/Systems/not-a-guid/FirmwareInventory",
+			route:  "/redfish/v1/Systems/:id/FirmwareInventory",
+		},
+		{
+			name:   "FirmwareInventory instance",
+			method: http.MethodGet,
+			path:   "/redfish/v1/Systems/not-a-guid/FirmwareInventory/AMT",
+			route:  "/redfish/v1/Systems/:id/FirmwareInventory/:firmwareId",
+		},
+		{name: "PCIeDevices collection", method: http.MethodGet, path: "/redfish/v1/Systems/not-a-guid/PCIeDevices", route: "/redfish/v1/Systems/:id/PCIeDevices"},
+		{name: "PCIeDevice instance", method: http.MethodGet, path: "/redfish/v1/Systems/not-a-guid/PCIeDevices/1", route: "/redfish/v1/Systems/:id/PCIeDevices/:deviceId"},
+		{name: "Storage collection", method: http.MethodGet, path: "/redfish/v1/Systems/not-a-guid/Storage", route: "/redfish/v1/Systems/:id/Storage"},
+		{name: "Storage instance", method: http.MethodGet, path: "/redfish/v1/Systems/not-a-guid/Storage/1", route: "/redfish/v1/Systems/:id/Storage/:storageId"},
+		{
+			name:   "Drive instance",
+			method: http.MethodGet,
+			path:   "/redfish/v1/Systems/not-a-guid/Storage/1/Drives/1",
+			route:  "/redfish/v1/Systems/:id/Storage/:storageId/Drives/:driveId",
+		},
+		{
+			name:   "EthernetInterfaces collection",
+			method: http.MethodGet,
+			path:   "/redfish/v1/Systems/not-a-guid/EthernetInterfaces",
+			route:  "/redfish/v1/Systems/:id/EthernetInterfaces",
+		},
+		{
+			name:   "EthernetInterface instance",
+			method: http.MethodGet,
+			path:   "/redfish/v1/Systems/not-a-guid/EthernetInterfaces/1",
+			route:  "/redfish/v1/Systems/:id/EthernetInterfaces/:nicId",
+		},
+		{name: "LogServices collection", method: http.MethodGet, path: "/redfish/v1/Systems/not-a-guid/LogServices", route: "/redfish/v1/Systems/:id/LogServices"},
+		{
+			name:   "EventLog entries",
+			method: http.MethodGet,
+			path:   "/redfish/v1/Systems/not-a-guid/LogServices/EventLog/Entries",
+			route:  "/redfish/v1/Systems/:id/LogServices/EventLog/Entries",
+		},
+		{
+			name:   "ClearLog action",
+			method: http.MethodPost,
+			path:   "/redfish/v1/Systems/not-a-guid/LogServices/EventLog/Actions/LogService.ClearLog",
+			route:  "/redfish/v1/Systems/:id/LogServices/EventLog/Actions/LogService.ClearLog",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			// No expectations: any backend call fails the test
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockLogger := mocks.NewMockLogger(ctrl)
+
+			handlers := map[string]gin.HandlerFunc{
+				"/redfish/v1/Systems/:id":                                                  getSystemInstanceHandler(mockFeature, nil, mockLogger),
+				"/redfish/v1/Systems/:id/Actions/ComputerSystem.Reset":                     postSystemResetHandler(mockFeature, nil, NewTaskStore(), newDeviceLocks(), nil, mockLogger),
+				"/redfish/v1/Systems/:id/FirmwareInventory":                                getFirmwareInventoryCollectionHandler(mockFeature, newFirmwareOptions(nil), mockLogger),
+				"/redfish/v1/Systems/:id/FirmwareInventory/:firmwareId":                    getFirmwareInventoryInstanceHandler(mockFeature, newFirmwareOptions(nil), mockLogger),
+				"/redfish/v1/Systems/:id/PCIeDevices":                                      getPCIeDevicesHandler(mockFeature, nil, mockLogger),
+				"/redfish/v1/Systems/:id/PCIeDevices/:deviceId":                            getPCIeDeviceHandler(mockFeature, nil, mockLogger),
+				"/redfish/v1/Systems/:id/Storage":                                          getStorageCollectionHandler(mockFeature, nil, mockLogger),
+				"/redfish/v1/Systems/:id/Storage/:storageId":                               getStorageHandler(mockFeature, nil, mockLogger),
+				"/redfish/v1/Systems/:id/Storage/:storageId/Drives/:driveId":               getDriveHandler(mockFeature, nil, mockLogger),
+				"/redfish/v1/Systems/:id/EthernetInterfaces":                               getEthernetInterfacesHandler(mockFeature, nil, mockLogger),
+				"/redfish/v1/Systems/:id/EthernetInterfaces/:nicId":                        getEthernetInterfaceHandler(mockFeature, nil, mockLogger),
+				"/redfish/v1/Systems/:id/LogServices":                                      getLogServicesCollectionHandler,
+				"/redfish/v1/Systems/:id/LogServices/EventLog/Entries":                     getEventLogEntriesHandler(mockFeature, nil, mockLogger),
+				"/redfish/v1/Systems/:id/LogServices/EventLog/Actions/LogService.ClearLog": postClearLogHandler(mockFeature, NewTaskStore(), nil, mockLogger),
+			}
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.Handle(tt.method, tt.route, handlers[tt.route])
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusNotFound, w.Code)
+			assert.Contains(t, w.Body.String(), BaseResourceNotFoundID)
+		})
+	}
+}
+
+func TestGetSystemInstanceETag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		cimPowerState  int
+		ifNoneMatch    string
+		expectedStatus int
+		expectedETag   string
+	}{
+		{
+			name:           "ETag is emitted",
+			cimPowerState:  cimPowerOn,
+			expectedStatus: http.StatusOK,
+			expectedETag:   systemETag("4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61", powerStateOn, healthOK),
+		},
+		{
+			name:           "matching If-None-Match yields 304",
+			cimPowerState:  cimPowerOn,
+			ifNoneMatch:    systemETag("4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61", powerStateOn, healthOK),
+			expectedStatus: http.StatusNotModified,
+			expectedETag:   systemETag("4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61", powerStateOn, healthOK),
+		},
+		{
+			name:           "stale If-None-Match after a power change",
+			cimPowerState:  cimPowerSoftOff,
+			ifNoneMatch:    systemETag("4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61", powerStateOn, healthOK),
+			expectedStatus: http.StatusOK,
+			expectedETag:   systemETag("4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61", powerStateOff, healthOK),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockLogger := mocks.NewMockLogger(ctrl)
+
+			mockFeature.EXPECT().GetPowerState(gomock.Any(), "4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61").
+				Return(dto.PowerState{PowerState: tt.cimPowerState}, nil)
+			mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), "4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61").Return(dto.HardwareInfo{}, nil)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/redfish/v1/Systems/:id", getSystemInstanceHandler(mockFeature, nil, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/redfish/v1/Systems/4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61", http.NoBody)
+
+			if tt.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedETag, w.Header().Get("ETag"))
+
+			if tt.expectedStatus == http.StatusNotModified {
+				assert.Empty(t, w.Body.String())
+
+				return
+			}
+
+			var body map[string]any
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, tt.expectedETag, body["@odata.etag"])
+		})
+	}
+}
+
+func TestSystemETagStability(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, systemETag("4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61", powerStateOn, healthOK), systemETag("4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61", powerStateOn, healthOK))
+	assert.NotEqual(t, systemETag("4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61", powerStateOn, healthOK), systemETag("4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61", powerStateOff, healthOK))
+	assert.NotEqual(t, systemETag("4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a61", powerStateOn, healthOK), systemETag("4f0e9c1a-8d2b-4e6f-a7c3-1b5d9e2f4a62", powerStateOn, healthOK))
+}
+
+func TestResetTypeAllowableValuesMatchDispatch(t *testing.T) {
+	t.Parallel()
+
+	payload := computerSystemPayload(DefaultRedfishBasePath, testSystemGUID, powerStateOn, healthOK, systemInventory{})
+
+	actions, ok := payload["Actions"].(map[string]any)
+	require.True(t, ok)
+
+	reset, ok := actions["#ComputerSystem.Reset"].(map[string]any)
+	require.True(t, ok)
+
+	advertised, ok := reset["ResetType@Redfish.AllowableValues"].([]string)
+	require.True(t, ok)
+
+	// Every advertised value is accepted, and every accepted value is advertised
+	assert.ElementsMatch(t, slices.Collect(maps.Keys(resetTypeToAction)), advertised)
+
+	for _, resetType := range advertised {
+		reset, ok := newSystemReset(resetType)
+		require.True(t, ok, resetType)
+		assert.Equal(t, resetType, reset.resetType)
+	}
+
+	// The remaining DMTF ResetType values are rejected
+	for _, resetType := range []string{"ForceOn", "GracefulShutdown", "GracefulRestart", "Nmi", "PushPowerButton", "Suspend", "Pause", "Resume"} {
+		_, ok := newSystemReset(resetType)
+		assert.False(t, ok, resetType)
+		assert.NotContains(t, advertised, resetType)
+	}
+}
+
+func TestPostSystemResetHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		systemID         string
+		requestBody      string
+		setupMocks       func(*mocks.MockDeviceManagementFeature, *mocks.MockLogger)
+		expectedStatus   int
+		validateResponse func(t *testing.T, body string)
+	}{
+		{
+			name:        "successful power on",
+			systemID:    testSystemGUID,
+			requestBody: `{"ResetType": "On"}`,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				expectedResult := power.PowerActionResponse{
+					ReturnValue: power.ReturnValue(0),
+				}
+				mockFeature.EXPECT().
+					SendPowerAction(gomock.Any(), testSystemGUID, actionPowerUp).
+					Return(expectedResult, nil)
+
+				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectedStatus: http.StatusOK,
+			validateResponse: func(t *testing.T, body string) {
+				t.Helper()
+				assert.Contains(t, body, `"TaskState":"Completed"`)
+			},
+		},
+		{
+			name:        "successful force off",
+			systemID:    testSystemGUID,
+			requestBody: `{"ResetType": "ForceOff"}`,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				expectedResult := power.PowerActionResponse{
+					ReturnValue: power.ReturnValue(0),
+				}
+				mockFeature.EXPECT().
+					SendPowerAction(gomock.Any(), testSystemGUID, actionPowerDown).
+					Return(expectedResult, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateResponse: func(t *testing.T, body string) {
+				t.Helper()
+				assert.Contains(t, body, `"TaskState":"Completed"`)
+			},
+		},
+		{
+			name:        "successful force restart",
+			systemID:    testSystemGUID,
+			requestBody: `{"ResetType": "ForceRestart"}`,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				expectedResult := power.PowerActionResponse{
+					ReturnValue: power.ReturnValue(0),
+				}
+				mockFeature.EXPECT().
+					SendPowerAction(gomock.Any(), testSystemGUID, actionReset).
+					Return(expectedResult, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateResponse: func(t *testing.T, body string) {
+				t.Helper()
+				assert.Contains(t, body, `"TaskState":"Completed"`)
+			},
+		},
+		{
+			name:        "successful power cycle",
+			systemID:    testSystemGUID,
+			requestBody: `{"ResetType": "PowerCycle"}`,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				expectedResult := power.PowerActionResponse{
+					ReturnValue: power.ReturnValue(0),
+				}
+				mockFeature.EXPECT().
+					SendPowerAction(gomock.Any(), testSystemGUID, actionPowerCycle).
+					Return(expectedResult, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateResponse: func(t *testing.T, body string) {
+				t.Helper()
+				assert.Contains(t, body, `"TaskState":"Completed"`)
+			},
+		},
+		{
+			name:        "invalid reset type",
+			systemID:    testSystemGUID,
+			requestBody: `{"ResetType": "InvalidType"}`,
+			setupMocks: func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				// No mock calls expected for invalid reset type
+			},
+			expectedStatus: http.StatusBadRequest,
+			validateResponse: func(t *testing.T, body string) {
+				t.Helper()
+				assert.Contains(t, body, BasePropertyValueNotInListID)
+				assert.Contains(t, body, "InvalidType")
+			},
+		},
+		{
+			name:        "malformed JSON",
+			systemID:    testSystemGUID,
+			requestBody: `{"ResetType": }`, // Invalid JSON
+			setupMocks: func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				// No mock calls expected for malformed JSON
+			},
+			expectedStatus: http.StatusBadRequest,
+			validateResponse: func(t *testing.T, body string) {
+				t.Helper()
+				assert.Contains(t, body, BaseMalformedJSONID)
+			},
+		},
+		{
+			name:        "missing reset type",
+			systemID:    testSystemGUID,
+			requestBody: `{}`, // Missing ResetType
+			setupMocks: func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				// No mock calls expected - empty ResetType is reported as missing
+			},
+			expectedStatus: http.StatusBadRequest,
+			validateResponse: func(t *testing.T, body string) {
+				t.Helper()
+				assert.Contains(t, body, BasePropertyMissingID)
+				assert.Contains(t, body, "ResetType")
+			},
+		},
+		{
+			name:        "backend error",
+			systemID:    testSystemGUID,
+			requestBody: `{"ResetType": "On"}`,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().
+					SendPowerAction(gomock.Any(), testSystemGUID, actionPowerUp).
+					Return(power.PowerActionResponse{}, fmt.Errorf("system not found"))
+
+				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			validateResponse: func(t *testing.T, body string) {
+				t.Helper()
+				assert.Contains(t, body, BaseErrorMessageID)
+				assert.NotContains(t, body, "system not found")
+			},
+		},
+		{
+			name:        "unreachable device: 502",
+			systemID:    testSystemGUID,
+			requestBody: `{"ResetType": "On"}`,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().
+					SendPowerAction(gomock.Any(), testSystemGUID, actionPowerUp).
+					Return(power.PowerActionResponse{}, fmt.Errorf("dial tcp 10.0.0.1:16993: connect: connection refused"))
+
+				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+			},
+			expectedStatus: http.StatusBadGateway,
+			validateResponse: func(t *testing.T, body string) {
+				t.Helper()
+				assert.Contains(t, body, "unavailable or unreachable")
+			},
+		},
+		{
+			name:        "device timeout: 504",
+			systemID:    testSystemGUID,
+			requestBody: `{"ResetType": "On"}`,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().
+					SendPowerAction(gomock.Any(), testSystemGUID, actionPowerUp).
+					Return(power.PowerActionResponse{}, context.DeadlineExceeded)
+
+				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+			},
+			expectedStatus: http.StatusGatewayTimeout,
+			validateResponse: func(t *testing.T, body string) {
+				t.Helper()
+				assert.Contains(t, body, "did not respond in time")
+			},
+		},
+		{
+			name:        "local overload: 503",
+			systemID:    testSystemGUID,
+			requestBody: `{"ResetType": "On"}`,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().
+					SendPowerAction(gomock.Any(), testSystemGUID, actionPowerUp).
+					Return(power.PowerActionResponse{}, fmt.Errorf("%w: too many sessions", consoleerrors.ErrServiceOverloaded))
+
+				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+			validateResponse: func(t *testing.T, body string) {
+				t.Helper()
+				assert.Contains(t, body, "temporarily unavailable")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockLogger := mocks.NewMockLogger(ctrl)
+
+			tt.setupMocks(mockFeature, mockLogger)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			systems := router.Group("/redfish/v1/Systems")
+			systems.POST(":id/Actions/ComputerSystem.Reset", postSystemResetHandler(mockFeature, nil, NewTaskStore(), newDeviceLocks(), nil, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(
+				context.Background(),
+				"POST",
+				"/redfish/v1/Systems/"+tt.systemID+"/Actions/ComputerSystem.Reset",
+				strings.NewReader(tt.requestBody),
+			)
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			tt.validateResponse(t, w.Body.String())
+		})
+	}
+}
+
+func TestPatchSystemInstanceHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMocks     func(*mocks.MockDeviceManagementFeature, *mocks.MockLogger)
+		expectedStatus int
+		expectedMsgID  string
+	}{
+		{
+			name:        "Pxe target",
+			requestBody: `{"Boot":{"BootSourceOverrideEnabled":"Once","BootSourceOverrideTarget":"Pxe"}}`,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().
+					ConfigureBootOptions(gomock.Any(), testSystemGUID, dto.BootSetting{Action: devices.BootActionResetToPXE}).
+					Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:        "Hdd target",
+			requestBody: `{"Boot":{"BootSourceOverrideEnabled":"Once","BootSourceOverrideTarget":"Hdd"}}`,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().
+					ConfigureBootOptions(gomock.Any(), testSystemGUID, dto.BootSetting{Action: devices.BootActionResetToHDD}).
+					Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:        "Cd target",
+			requestBody: `{"Boot":{"BootSourceOverrideEnabled":"Once","BootSourceOverrideTarget":"Cd"}}`,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().
+					ConfigureBootOptions(gomock.Any(), testSystemGUID, dto.BootSetting{Action: devices.BootActionResetToIDERCDROM}).
+					Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:        "BiosSetup target without Enabled",
+			requestBody: `{"Boot":{"BootSourceOverrideTarget":"BiosSetup"}}`,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().
+					ConfigureBootOptions(gomock.Any(), testSystemGUID, dto.BootSetting{Action: devices.BootActionResetToBIOS}).
+					Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:        "Disabled clears the override",
+			requestBody: `{"Boot":{"BootSourceOverrideEnabled":"Disabled"}}`,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().
+					ConfigureBootOptions(gomock.Any(), testSystemGUID, dto.BootSetting{Action: devices.BootActionNone}).
+					Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "invalid target",
+			requestBody:    `{"Boot":{"BootSourceOverrideEnabled":"Once","BootSourceOverrideTarget":"Floppy"}}`,
+			setupMocks:     func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyValueNotInListID,
+		},
+		{
+			name:           "unsupported Enabled value",
+			requestBody:    `{"Boot":{"BootSourceOverrideEnabled":"Continuous","BootSourceOverrideTarget":"Pxe"}}`,
+			setupMocks:     func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyValueNotInListID,
+		},
+		{
+			name:           "read-only property",
+			requestBody:    `{"PowerState":"Off","Boot":{"BootSourceOverrideTarget":"Pxe"}}`,
+			setupMocks:     func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyNotWritableID,
+		},
+		{
+			name:           "read-only Boot property",
+			requestBody:    `{"Boot":{"BootSourceOverrideMode":"UEFI","BootSourceOverrideTarget":"Pxe"}}`,
+			setupMocks:     func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyNotWritableID,
+		},
+		{
+			name:           "missing Boot",
+			requestBody:    `{}`,
+			setupMocks:     func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyMissingID,
+		},
+		{
+			name:           "missing target",
+			requestBody:    `{"Boot":{"BootSourceOverrideEnabled":"Once"}}`,
+			setupMocks:     func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyMissingID,
+		},
+		{
+			name:           "malformed JSON",
+			requestBody:    `{"Boot": }`,
+			setupMocks:     func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BaseMalformedJSONID,
+		},
+		{
+			name:        "device not found",
+			requestBody: `{"Boot":{"BootSourceOverrideTarget":"Pxe"}}`,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
+				mockFeature.EXPECT().
+					ConfigureBootOptions(gomock.Any(), testSystemGUID, gomock.Any()).
+					Return(devices.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedMsgID:  BaseResourceNotFoundID,
+		},
+		{
+			name:        "backend error",
+			requestBody: `{"Boot":{"BootSourceOverrideTarget":"Pxe"}}`,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().
+					ConfigureBootOptions(gomock.Any(), testSystemGUID, gomock.Any()).
+					Return(fmt.Errorf("boot configuration failed"))
+
+				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedMsgID:  BaseErrorMessageID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockLogger := mocks.NewMockLogger(ctrl)
+
+			tt.setupMocks(mockFeature, mockLogger)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			systems := router.Group("/redfish/v1/Systems")
+			systems.PATCH(":id", patchSystemInstanceHandler(mockFeature, nil, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(
+				context.Background(),
+				http.MethodPatch,
+				systemsInstanceURL,
+				strings.NewReader(tt.requestBody),
+			)
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedMsgID != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedMsgID)
+			}
+		})
+	}
+}
+
+func TestPatchSystemInstanceMalformedID(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	// A malformed id is rejected before the If-Match check or the boot configuration reach the device
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.PATCH("/redfish/v1/Systems/:id", patchSystemInstanceHandler(mockFeature, nil, mockLogger))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodPatch, systemsBasePath+"/not-a-guid",
+		strings.NewReader(`{"Boot":{"BootSourceOverrideEnabled":"Once","BootSourceOverrideTarget":"Pxe"}}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", `"abc"`)
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), BaseResourceNotFoundID)
+}
+
+func TestPatchSystemInstanceIfMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		ifMatch        string
+		expectWrite    bool
+		expectLookup   bool
+		expectedStatus int
+	}{
+		{
+			name:           "current ETag proceeds",
+			ifMatch:        systemETag(testSystemGUID, powerStateOn, healthOK),
+			expectLookup:   true,
+			expectWrite:    true,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "weak form of the current ETag is rejected",
+			ifMatch:        "W/" + systemETag(testSystemGUID, powerStateOn, healthOK),
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:           "stale ETag is rejected",
+			ifMatch:        systemETag(testSystemGUID, powerStateOff, healthOK),
+			expectLookup:   true,
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:           "wildcard proceeds",
+			ifMatch:        "*",
+			expectWrite:    true,
+			expectedStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			if tt.expectLookup {
+				mockFeature.EXPECT().GetPowerState(gomock.Any(), testSystemGUID).Return(dto.PowerState{PowerState: cimPowerOn}, nil)
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(dto.HardwareInfo{}, nil)
+			}
+
+			if tt.expectWrite {
+				mockFeature.EXPECT().ConfigureBootOptions(gomock.Any(), testSystemGUID, gomock.Any()).Return(nil)
+			}
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.PATCH("/redfish/v1/Systems/:id", patchSystemInstanceHandler(mockFeature, nil, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodPatch, systemsInstanceURL,
+				strings.NewReader(`{"Boot":{"BootSourceOverrideEnabled":"Once","BootSourceOverrideTarget":"Pxe"}}`))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("If-Match", tt.ifMatch)
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestPatchSystemInstanceWithETagFromGet(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().GetPowerState(gomock.Any(), testSystemGUID).Return(dto.PowerState{PowerState: cimPowerOn}, nil).AnyTimes()
+	mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(dto.HardwareInfo{}, nil).AnyTimes()
+	mockFeature.EXPECT().ConfigureBootOptions(gomock.Any(), testSystemGUID, gomock.Any()).Return(nil)
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	NewSystemsRoutes(router.Group("/redfish/v1"), mockFeature, nil, mockLogger)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, systemsInstanceURL, http.NoBody)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), http.MethodPatch, systemsInstanceURL,
+		strings.NewReader(`{"Boot":{"BootSourceOverrideEnabled":"Once","BootSourceOverrideTarget":"Pxe"}}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", etag)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+}
+
+func TestPatchSystemInstanceErrorArguments(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		requestBody string
+		expectedArg []interface{}
+	}{
+		{
+			name:        "first read-only property in sorted order",
+			requestBody: `{"PowerState":"Off","Name":"x","Boot":{}}`,
+			expectedArg: []interface{}{"Name"},
+		},
+		{
+			name:        "unquoted invalid Enabled value",
+			requestBody: `{"Boot":{"BootSourceOverrideEnabled":"Continuous","BootSourceOverrideTarget":"Pxe"}}`,
+			expectedArg: []interface{}{"Continuous", "BootSourceOverrideEnabled"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.PATCH("/redfish/v1/Systems/:id", patchSystemInstanceHandler(mocks.NewMockDeviceManagementFeature(ctrl), nil, mocks.NewMockLogger(ctrl)))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodPatch, systemsInstanceURL, strings.NewReader(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+
+			var resp map[string]map[string]interface{}
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			extendedInfo, ok := resp["error"]["@Message.ExtendedInfo"].([]interface{})
+			require.True(t, ok)
+			require.Len(t, extendedInfo, 1)
+
+			info, ok := extendedInfo[0].(map[string]interface{})
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedArg, info["MessageArgs"])
+		})
+	}
+}
+
+func TestDeviceTimeout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		cfg      *config.Config
+		expected time.Duration
+	}{
+		{name: "nil config", cfg: nil, expected: DefaultDeviceTimeout},
+		{name: "unset timeout", cfg: &config.Config{}, expected: DefaultDeviceTimeout},
+		{name: "configured timeout", cfg: &config.Config{Redfish: config.Redfish{DeviceTimeout: 5 * time.Second}}, expected: 5 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, deviceTimeout(tt.cfg))
+		})
+	}
+}
+
+func TestExpandWorkers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		cfg      *config.Config
+		expected int
+	}{
+		{name: "nil config", cfg: nil, expected: DefaultExpandWorkers},
+		{name: "unset workers", cfg: &config.Config{}, expected: DefaultExpandWorkers},
+		{name: "configured workers", cfg: &config.Config{Redfish: config.Redfish{ExpandWorkers: 3}}, expected: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, expandWorkers(tt.cfg))
+		})
+	}
+}
+
+func TestPageSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		cfg      *config.Config
+		expected int
+	}{
+		{name: "nil config", cfg: nil, expected: DefaultPageSize},
+		{name: "unset page size", cfg: &config.Config{}, expected: DefaultPageSize},
+		{name: "configured page size", cfg: &config.Config{Redfish: config.Redfish{DefaultPageSize: 20}}, expected: 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, pageSize(tt.cfg))
+		})
+	}
+}
+
+func TestFetchPowerStatesConcurrently(t *testing.T) {
+	t.Parallel()
+
+	const (
+		deviceCount = 40
+		maxWorkers  = 4
+	)
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	var inFlight, peak atomic.Int32
+
+	cimStates := []int{cimPowerOn, cimPowerSoftOff, cimPowerStandby}
+	guids := make([]string, 0, deviceCount)
+	expected := make(map[string]string, deviceCount)
+
+	for i := 0; i < deviceCount; i++ {
+		guid := fmt.Sprintf("system-%d", i)
+		guids = append(guids, guid)
+		expected[guid] = mapPowerState(cimStates[i%len(cimStates)])
+	}
+
+	const failingGUID = "system-7"
+
+	expected[failingGUID] = powerStateUnknown
+
+	mockFeature.EXPECT().GetPowerState(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, guid string) (dto.PowerState, error) {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+
+			for {
+				seen := peak.Load()
+				if current <= seen || peak.CompareAndSwap(seen, current) {
+					break
+				}
+			}
+
+			var index int
+
+			_, _ = fmt.Sscanf(guid, "system-%d", &index)
+
+			// Later devices answer first so results cannot line up with the input order by accident
+			time.Sleep(time.Duration(deviceCount-index) * 100 * time.Microsecond)
+
+			if guid == failingGUID {
+				return dto.PowerState{}, fmt.Errorf("device unreachable")
+			}
+
+			return dto.PowerState{PowerState: cimStates[index%len(cimStates)]}, nil
+		}).Times(deviceCount)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).Times(1)
+
+	states := fetchPowerStatesConcurrently(context.Background(), mockFeature, guids, maxWorkers, time.Second, mockLogger)
+
+	assert.Equal(t, expected, states)
+	assert.LessOrEqual(t, peak.Load(), int32(maxWorkers))
+}
+
+func TestSystemsHandlersDeviceTimeout(t *testing.T) {
+	t.Parallel()
+
+	// waitForDeadline simulates a hung device that only returns once the call context expires
+	waitForDeadline := func(ctx context.Context) error {
+		<-ctx.Done()
+
+		return ctx.Err()
+	}
+
+	tests := []struct {
+		name        string
+		method      string
+		url         string
+		requestBody string
+		setupMocks  func(*mocks.MockDeviceManagementFeature, *mocks.MockLogger)
+	}{
+		{
+			name:   "Systems collection",
+			method: http.MethodGet,
+			url:    systemsBasePath,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().
+					Get(gomock.Any(), DefaultPageSize+1, 0, "").
+					DoAndReturn(func(ctx context.Context, _, _ int, _ string) ([]dto.Device, error) {
+						return nil, waitForDeadline(ctx)
+					})
+				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+			},
+		},
+		{
+			name:   "Systems instance",
+			method: http.MethodGet,
+			url:    systemsInstanceURL,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().
+					GetPowerState(gomock.Any(), testSystemGUID).
+					DoAndReturn(func(ctx context.Context, _ string) (dto.PowerState, error) {
+						return dto.PowerState{}, waitForDeadline(ctx)
+					})
+				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+			},
+		},
+		{
+			name:        "ComputerSystem.Reset",
+			method:      http.MethodPost,
+			url:         resetActionURL,
+			requestBody: `{"ResetType": "On"}`,
+			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
+				mockFeature.EXPECT().
+					SendPowerAction(gomock.Any(), testSystemGUID, actionPowerUp).
+					DoAndReturn(func(ctx context.Context, _ string, _ int) (power.PowerActionResponse, error) {
+						return power.PowerActionResponse{}, waitForDeadline(ctx)
+					})
+				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockLogger := mocks.NewMockLogger(ctrl)
+
+			tt.setupMocks(mockFeature, mockLogger)
+
+			cfg := &config.Config{Redfish: config.Redfish{DeviceTimeout: 10 * time.Millisecond}}
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			systems := router.Group("/redfish/v1/Systems")
+			systems.GET("", getSystemsCollectionHandler(mockFeature, cfg, mockLogger))
+			systems.GET(":id", getSystemInstanceHandler(mockFeature, cfg, mockLogger))
+			systems.POST(":id/Actions/ComputerSystem.Reset", postSystemResetHandler(mockFeature, nil, NewTaskStore(), newDeviceLocks(), cfg, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), tt.method, tt.url, strings.NewReader(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusGatewayTimeout, w.Code)
+			assert.Contains(t, w.Body.String(), BaseErrorMessageID)
+		})
+	}
+}
+
+func TestPowerStateMapping(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		cimPowerState   int
+		expectedRedfish string
+	}{
+		{
+			name:            "CIM Power On maps to Redfish On",
+			cimPowerState:   actionPowerUp, // 2
+			expectedRedfish: powerStateOn,
+		},
+		{
+			name:            "CIM Sleep maps to Redfish On",
+			cimPowerState:   cimPowerSleep, // 3
+			expectedRedfish: powerStateOn,
+		},
+		{
+			name:            "CIM Standby maps to Redfish On",
+			cimPowerState:   cimPowerStandby, // 4
+			expectedRedfish: powerStateOn,
+		},
+		{
+			name:            "CIM Soft Off maps to Redfish Off",
+			cimPowerState:   cimPowerSoftOff, // 7
+			expectedRedfish: powerStateOff,
+		},
+		{
+			name:            "CIM Hard Off maps to Redfish Off",
+			cimPowerState:   cimPowerHardOff, // 8
+			expectedRedfish: powerStateOff,
+		},
+		{
+			name:            "CIM Power Cycle (Off-Soft) maps to Redfish PoweringOn",
+			cimPowerState:   cimPowerCycleSoft, // 5
+			expectedRedfish: powerStatePoweringOn,
+		},
+		{
+			name:            "CIM Power Cycle (Off-Hard) maps to Redfish PoweringOn",
+			cimPowerState:   cimPowerCycleHard, // 9
+			expectedRedfish: powerStatePoweringOn,
+		},
+		{
+			name:            "CIM Master Bus Reset maps to Redfish PoweringOn",
+			cimPowerState:   cimPowerMasterBusReset, // 10
+			expectedRedfish: powerStatePoweringOn,
+		},
+		{
+			name:            "CIM Master Bus Reset Graceful maps to Redfish PoweringOn",
+			cimPowerState:   cimPowerBusResetGraceful, // 14
+			expectedRedfish: powerStatePoweringOn,
+		},
+		{
+			name:            "CIM Power Cycle Off-Soft Graceful maps to Redfish PoweringOn",
+			cimPowerState:   cimPowerCycleSoftGraceful, // 15
+			expectedRedfish: powerStatePoweringOn,
+		},
+		{
+			name:            "CIM Power Cycle Off-Hard Graceful maps to Redfish PoweringOn",
+			cimPowerState:   cimPowerCycleHardGraceful, // 16
+			expectedRedfish: powerStatePoweringOn,
+		},
+		{
+			name:            "CIM Off-Soft Graceful maps to Redfish PoweringOff",
+			cimPowerState:   cimPowerSoftOffGraceful, // 12
+			expectedRedfish: powerStatePoweringOff,
+		},
+		{
+			name:            "CIM Off-Hard Graceful maps to Redfish PoweringOff",
+			cimPowerState:   cimPowerHardOffGraceful, // 13
+			expectedRedfish: powerStatePoweringOff,
+		},
+		{
+			name:            "Unknown CIM state maps to Redfish Unknown",
+			cimPowerState:   999,
+			expectedRedfish: powerStateUnknown,
+		},
+		{
+			name:            "Zero value maps to Redfish Unknown",
+			cimPowerState:   0,
+			expectedRedfish: powerStateUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockLogger := mocks.NewMockLogger(ctrl)
+
+			powerState := dto.PowerState{
+				PowerState: tt.cimPowerState,
+			}
+			mockFeature.EXPECT().
+				GetPowerState(gomock.Any(), testSystemGUID).
+				Return(powerState, nil)
+			mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(dto.HardwareInfo{}, nil)
+
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			systems := router.Group("/redfish/v1/Systems")
+			systems.GET(":id", getSystemInstanceHandler(mockFeature, nil, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(
+				context.Background(),
 				"GET",
-				"/redfish/v1/Systems/"+tt.systemID,
+				"/redfish/v1/Systems/"+testSystemGUID,
 				http.NoBody,
 			)
 
-			router.ServeHTTP(w, req)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var system map[string]interface{}
+
+			err := json.Unmarshal(w.Body.Bytes(), &system)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedRedfish, system["PowerState"])
+		})
+	}
+}
+
+func TestResetTypeMapping(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		redfishResetType  string
+		expectedCIMAction int
+	}{
+		{
+			name:              "On maps to PowerUp action",
+			redfishResetType:  resetTypeOn,
+			expectedCIMAction: actionPowerUp, // 2
+		},
+		{
+			name:              "ForceOff maps to PowerDown action",
+			redfishResetType:  resetTypeForceOff,
+			expectedCIMAction: actionPowerDown, // 8
+		},
+		{
+			name:              "ForceRestart maps to Reset action",
+			redfishResetType:  resetTypeForceRestart,
+			expectedCIMAction: actionReset, // 10
+		},
+		{
+			name:              "PowerCycle maps to PowerCycle action",
+			redfishResetType:  resetTypePowerCycle,
+			expectedCIMAction: actionPowerCycle, // 5
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockLogger := mocks.NewMockLogger(ctrl)
+
+			expectedResult := power.PowerActionResponse{
+				ReturnValue: power.ReturnValue(0),
+			}
+			mockFeature.EXPECT().
+				SendPowerAction(gomock.Any(), testSystemGUID, tt.expectedCIMAction).
+				Return(expectedResult, nil)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			systems := router.Group("/redfish/v1/Systems")
+			systems.POST(":id/Actions/ComputerSystem.Reset", postSystemResetHandler(mockFeature, nil, NewTaskStore(), newDeviceLocks(), nil, mockLogger))
+
+			requestBody := fmt.Sprintf(`{"ResetType": %q}`, tt.redfishResetType)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(
+				context.Background(),
+				"POST",
+				"/redfish/v1/Systems/"+testSystemGUID+"/Actions/ComputerSystem.Reset",
+				strings.NewReader(requestBody),
+			)
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+		})
+	}
+}
+
+func TestSystemsIntegrationWithFirmware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("firmware routes integrated with systems", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+		mockLogger := mocks.NewMockLogger(ctrl)
+
+		// Mock for firmware inventory access
+		mockFeature.EXPECT().
+			GetVersion(gomock.Any(), testSystemGUID).
+			Return(dto.Version{}, dtov2.Version{AMT: "15.0.25"}, nil)
+
+		// Mock for BIOS hardware info (required by firmware routes)
+		mockFeature.EXPECT().
+			GetHardwareInfo(gomock.Any(), testSystemGUID).
+			Return(dto.HardwareInfo{
+				CIMBIOSElement: dto.CIMResponse{
+					Response: map[string]interface{}{
+						"Version":      "BIOS.15.25.10",
+						"Manufacturer": "Intel Corp.",
+					},
+				},
+			}, nil)
+
+		mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+
+		// Setup complete systems routes including firmware
+		redfishGroup := router.Group("/redfish/v1")
+		NewSystemsRoutes(redfishGroup, mockFeature, nil, mockLogger)
+
+		// Test that firmware inventory endpoint is accessible via systems routes
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(
+			context.Background(),
+			"GET",
+			"/redfish/v1/Systems/"+testSystemGUID+"/FirmwareInventory",
+			http.NoBody,
+		)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var collection map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &collection)
+		require.NoError(t, err)
+
+		assert.Equal(t, "#SoftwareInventoryCollection.SoftwareInventoryCollection", collection["@odata.type"])
+		assert.Equal(t, "/redfish/v1/Systems/"+testSystemGUID+"/FirmwareInventory", collection["@odata.id"])
+	})
+}
+
+func TestConstants(t *testing.T) {
+	t.Parallel()
+
+	t.Run("power state constants", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "Unknown", powerStateUnknown)
+		assert.Equal(t, "On", powerStateOn)
+		assert.Equal(t, "Off", powerStateOff)
+		assert.Equal(t, "PoweringOn", powerStatePoweringOn)
+		assert.Equal(t, "PoweringOff", powerStatePoweringOff)
+	})
+
+	t.Run("reset type constants", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "On", resetTypeOn)
+		assert.Equal(t, "ForceOff", resetTypeForceOff)
+		assert.Equal(t, "ForceRestart", resetTypeForceRestart)
+		assert.Equal(t, "PowerCycle", resetTypePowerCycle)
+	})
+
+	t.Run("action constants", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, 2, actionPowerUp)
+		assert.Equal(t, 5, actionPowerCycle)
+		assert.Equal(t, 8, actionPowerDown)
+		assert.Equal(t, 10, actionReset)
+	})
+
+	t.Run("CIM power state constants", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, 2, cimPowerOn)
+		assert.Equal(t, 3, cimPowerSleep)
+		assert.Equal(t, 4, cimPowerStandby)
+		assert.Equal(t, 7, cimPowerSoftOff)
+		assert.Equal(t, 8, cimPowerHardOff)
+		assert.Equal(t, 5, cimPowerCycleSoft)
+		assert.Equal(t, 9, cimPowerCycleHard)
+		assert.Equal(t, 10, cimPowerMasterBusReset)
+		assert.Equal(t, 12, cimPowerSoftOffGraceful)
+		assert.Equal(t, 13, cimPowerHardOffGraceful)
+		assert.Equal(t, 14, cimPowerBusResetGraceful)
+		assert.Equal(t, 15, cimPowerCycleSoftGraceful)
+		assert.Equal(t, 16, cimPowerCycleHardGraceful)
+	})
+
+	t.Run("limits constants", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, 100, maxSystemsList)
+	})
+}
+
+func TestSystemResponseStructure(t *testing.T) {
+	t.Parallel()
+
+	t.Run("system response contains all required fields", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+		mockLogger := mocks.NewMockLogger(ctrl)
+
+		powerState := dto.PowerState{PowerState: actionPowerUp}
+		mockFeature.EXPECT().
+			GetPowerState(gomock.Any(), testSystemGUID).
+			Return(powerState, nil)
+		mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(dto.HardwareInfo{}, nil)
+
+		mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		systems := router.Group("/redfish/v1/Systems")
+		systems.GET(":id", getSystemInstanceHandler(mockFeature, nil, mockLogger))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(
+			context.Background(),
+			"GET",
+			"/redfish/v1/Systems/"+testSystemGUID,
+			http.NoBody,
+		)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var system map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &system)
+		require.NoError(t, err)
+
+		// Check required Redfish properties
+		requiredFields := []string{
+			"@odata.type",
+			"@odata.id",
+			"Id",
+			"Name",
+			"PowerState",
+			"Actions",
+		}
+
+		for _, field := range requiredFields {
+			assert.Contains(t, system, field, "System response missing required field: %s", field)
+		}
+
+		// Check Actions structure
+		actions, ok := system["Actions"].(map[string]interface{})
+		require.True(t, ok, "Actions should be a map")
+		assert.Contains(t, actions, "#ComputerSystem.Reset")
+
+		resetAction, ok := actions["#ComputerSystem.Reset"].(map[string]interface{})
+		require.True(t, ok, "Reset action should be a map")
+		assert.Contains(t, resetAction, "target")
+		assert.Contains(t, resetAction, "ResetType@Redfish.AllowableValues")
+
+		allowedValues, ok := resetAction["ResetType@Redfish.AllowableValues"].([]interface{})
+		require.True(t, ok, "AllowableValues should be a slice of interfaces")
+		assert.Equal(t, 4, len(allowedValues)) // On, ForceOff, ForceRestart, PowerCycle
+	})
+}
+
+func TestSystemNavigationLinks(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().GetPowerState(gomock.Any(), testSystemGUID).Return(dto.PowerState{PowerState: cimPowerOn}, nil)
+	mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(dto.HardwareInfo{}, nil)
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	NewSystemsRoutes(router.Group("/redfish/v1"), mockFeature, nil, mockLogger)
+
+	registered := make(map[string]bool)
+	for _, route := range router.Routes() {
+		if route.Method == http.MethodGet {
+			registered[route.Path] = true
+		}
+	}
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, systemsInstanceURL, http.NoBody)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var system map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &system))
+
+	expected := map[string]string{
+		"LogServices":        systemsInstanceURL + "/LogServices",
+		"Storage":            systemsInstanceURL + "/Storage",
+		"EthernetInterfaces": systemsInstanceURL + "/EthernetInterfaces",
+		"SecureBoot":         systemsInstanceURL + "/SecureBoot",
+		"Bios":               systemsInstanceURL + "/Bios",
+		"PCIeDevices":        systemsInstanceURL + "/PCIeDevices",
+	}
+
+	for property, path := range expected {
+		link, ok := system[property].(map[string]any)
+		require.True(t, ok, "%s should be a navigation link", property)
+		assert.Equal(t, path, link["@odata.id"], property)
+
+		route := strings.Replace(path, testSystemGUID, ":id", 1)
+		assert.True(t, registered[route], "%s links to %s, which is not registered", property, route)
+	}
+
+	for _, property := range []string{"Processors", "Memory", "Links"} {
+		assert.NotContains(t, system, property, "%s is not served, so it must not be linked", property)
+	}
+}
+
+func TestSystemInstanceStatus(t *testing.T) {
+	t.Parallel()
+
+	degraded := dto.HardwareInfo{
+		CIMPhysicalMemory: dto.CIMResponse{Responses: []any{
+			map[string]any{"ElementName": "DIMM 0", "OperationalStatus": []any{2}},
+			map[string]any{"ElementName": "DIMM 1", "OperationalStatus": []any{2, 3}},
+		}},
+	}
+
+	tests := []struct {
+		name           string
+		cimPowerState  int
+		hwInfo         dto.HardwareInfo
+		hwErr          error
+		expectedState  string
+		expectedHealth string
+	}{
+		{
+			name:           "On is Enabled",
+			cimPowerState:  cimPowerOn,
+			expectedState:  systemStateEnabled,
+			expectedHealth: healthOK,
+		},
+		{
+			name:           "Off is StandbyOffline",
+			cimPowerState:  cimPowerSoftOff,
+			expectedState:  systemStateStandbyOffline,
+			expectedHealth: healthOK,
+		},
+		{
+			name:           "degraded component",
+			cimPowerState:  cimPowerOn,
+			hwInfo:         degraded,
+			expectedState:  systemStateEnabled,
+			expectedHealth: healthWarning,
+		},
+		{
+			name:           "hardware info failure defaults to OK",
+			cimPowerState:  cimPowerOn,
+			hwErr:          fmt.Errorf("unreachable"),
+			expectedState:  systemStateEnabled,
+			expectedHealth: healthOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().GetPowerState(gomock.Any(), testSystemGUID).Return(dto.PowerState{PowerState: tt.cimPowerState}, nil)
+			mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(tt.hwInfo, tt.hwErr)
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			if tt.hwErr != nil {
+				mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).Times(1)
+			}
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/redfish/v1/Systems/:id", getSystemInstanceHandler(mockFeature, nil, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, systemsInstanceURL, http.NoBody)
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var system map[string]any
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &system))
+
+			status, ok := system["Status"].(map[string]any)
+			require.True(t, ok, "Status should be an object")
+			assert.Equal(t, tt.expectedState, status["State"])
+			assert.Equal(t, tt.expectedHealth, status["Health"])
+			assert.Equal(t, systemETag(testSystemGUID, mapPowerState(tt.cimPowerState), tt.expectedHealth), w.Header().Get("ETag"))
+		})
+	}
+}
+
+func TestSystemInstanceIdentity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		hwInfo   dto.HardwareInfo
+		hwErr    error
+		expected map[string]string
+	}{
+		{
+			name: "populated from the chassis",
+			hwInfo: dto.HardwareInfo{
+				CIMComputerSystemPackage: dto.CIMResponse{Response: map[string]any{"PlatformGUID": "4C4C4544"}},
+				CIMChassis: dto.CIMResponse{Response: map[string]any{
+					"Manufacturer": "Intel Corporation",
+					"Model":        "NUC13ANKi7",
+					"SerialNumber": "G6BN1234ABCD",
+					"SKU":          "RNUC13ANKI70000",
+				}},
+			},
+			expected: map[string]string{
+				"Manufacturer": "Intel Corporation",
+				"Model":        "NUC13ANKi7",
+				"SerialNumber": "G6BN1234ABCD",
+				"SKU":          "RNUC13ANKI70000",
+			},
+		},
+		{
+			name: "blank properties are omitted",
+			hwInfo: dto.HardwareInfo{
+				CIMChassis: dto.CIMResponse{Response: map[string]any{"Manufacturer": "Intel Corporation", "Model": " ", "SerialNumber": ""}},
+			},
+			expected: map[string]string{"Manufacturer": "Intel Corporation"},
+		},
+		{
+			name:     "hardware info unavailable",
+			hwErr:    fmt.Errorf("unreachable"),
+			expected: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().GetPowerState(gomock.Any(), testSystemGUID).Return(dto.PowerState{PowerState: cimPowerOn}, nil)
+			mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(tt.hwInfo, tt.hwErr)
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/redfish/v1/Systems/:id", getSystemInstanceHandler(mockFeature, nil, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, systemsInstanceURL, http.NoBody)
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var system map[string]any
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &system))
+
+			for _, property := range []string{"Manufacturer", "Model", "SerialNumber", "SKU"} {
+				if value, ok := tt.expected[property]; ok {
+					assert.Equal(t, value, system[property], property)
+				} else {
+					assert.NotContains(t, system, property)
+				}
+			}
+		})
+	}
+}
+
+func TestSystemInstanceSummaries(t *testing.T) {
+	t.Parallel()
+
+	const gib = 1 << 30
+
+	tests := []struct {
+		name             string
+		hwInfo           dto.HardwareInfo
+		expectProcessors map[string]any
+		expectMemory     map[string]any
+	}{
+		{
+			name: "multi-CPU multi-DIMM system",
+			hwInfo: dto.HardwareInfo{
+				CIMProcessor: dto.CIMResponse{Responses: []any{
+					map[string]any{"DeviceID": "CPU 0", "MaxClockSpeed": 4800},
+					map[string]any{"DeviceID": "CPU 1", "MaxClockSpeed": 4800},
+				}},
+				CIMChip: dto.CIMResponse{Response: map[string]any{
+					"ElementName": "Managed System Processor Chip",
+					"Version":     "13th Gen Intel(R) Core(TM) i7-1360P",
+				}},
+				CIMPhysicalMemory: dto.CIMResponse{Responses: []any{
+					map[string]any{"ElementName": "DIMM 0", "Capacity": 8 * gib},
+					map[string]any{"ElementName": "DIMM 1", "Capacity": 16 * gib},
+					map[string]any{"ElementName": "Empty slot"},
+				}},
+			},
+			expectProcessors: map[string]any{"Count": float64(2), "Model": "13th Gen Intel(R) Core(TM) i7-1360P"},
+			expectMemory:     map[string]any{"TotalSystemMemoryGiB": float64(24)},
+		},
+		{
+			name: "processor without a model",
+			hwInfo: dto.HardwareInfo{
+				CIMProcessor: dto.CIMResponse{Response: map[string]any{"DeviceID": "CPU 0"}},
+			},
+			expectProcessors: map[string]any{"Count": float64(1)},
+		},
+		{
+			name: "no hardware data",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().GetPowerState(gomock.Any(), testSystemGUID).Return(dto.PowerState{PowerState: cimPowerOn}, nil)
+			mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(tt.hwInfo, nil)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/redfish/v1/Systems/:id", getSystemInstanceHandler(mockFeature, nil, mocks.NewMockLogger(ctrl)))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, systemsInstanceURL, http.NoBody)
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var system map[string]any
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &system))
+
+			if tt.expectProcessors == nil {
+				assert.NotContains(t, system, "ProcessorSummary")
+			} else {
+				assert.Equal(t, tt.expectProcessors, system["ProcessorSummary"])
+			}
+
+			if tt.expectMemory == nil {
+				assert.NotContains(t, system, "MemorySummary")
+			} else {
+				assert.Equal(t, tt.expectMemory, system["MemorySummary"])
+			}
+		})
+	}
+}
+
+func TestTotalMemoryGiB(t *testing.T) {
+	t.Parallel()
 
-			assert.Equal(t, tt.expectedStatus, w.Code)
-			tt.validateResponse(t, w.Body.String())
-		})
+	hwInfo := dto.HardwareInfo{
+		CIMPhysicalMemory: dto.CIMResponse{Responses: []any{
+			map[string]any{"Capacity": 1 << 29},
+			map[string]any{"Capacity": "8589934592"},
+			map[string]any{"Capacity": -1},
+		}},
 	}
+
+	assert.InDelta(t, 0.5, totalMemoryGiB(hwInfo), 0)
+	assert.InDelta(t, 0, totalMemoryGiB(dto.HardwareInfo{}), 0)
 }
 
-func TestPostSystemResetHandler(t *testing.T) {
+func TestSystemHealth(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name             string
-		systemID         string
-		requestBody      string
-		setupMocks       func(*mocks.MockDeviceManagementFeature, *mocks.MockLogger)
-		expectedStatus   int
-		validateResponse func(t *testing.T, body string)
+		name     string
+		hwInfo   dto.HardwareInfo
+		expected string
 	}{
 		{
-			name:        "successful power on",
-			systemID:    testSystemGUID,
-			requestBody: `{"ResetType": "On"}`,
-			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
-				expectedResult := power.PowerActionResponse{
-					ReturnValue: power.ReturnValue(0),
-				}
-				mockFeature.EXPECT().
-					SendPowerAction(gomock.Any(), testSystemGUID, actionPowerUp).
-					Return(expectedResult, nil)
-
-				mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
-			},
-			expectedStatus: http.StatusOK,
-			validateResponse: func(t *testing.T, body string) {
-				t.Helper()
-				assert.Contains(t, body, "ReturnValue")
-			},
+			name:     "no components",
+			expected: healthOK,
 		},
 		{
-			name:        "successful force off",
-			systemID:    testSystemGUID,
-			requestBody: `{"ResetType": "ForceOff"}`,
-			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
-				expectedResult := power.PowerActionResponse{
-					ReturnValue: power.ReturnValue(0),
-				}
-				mockFeature.EXPECT().
-					SendPowerAction(gomock.Any(), testSystemGUID, actionPowerDown).
-					Return(expectedResult, nil)
-			},
-			expectedStatus: http.StatusOK,
-			validateResponse: func(t *testing.T, body string) {
-				t.Helper()
-				assert.Contains(t, body, "ReturnValue")
+			name: "healthy components",
+			hwInfo: dto.HardwareInfo{
+				CIMChassis:   dto.CIMResponse{Response: map[string]any{"HealthState": 5, "OperationalStatus": []any{2}}},
+				CIMProcessor: dto.CIMResponse{Response: map[string]any{"OperationalStatus": []any{2}}},
 			},
+			expected: healthOK,
 		},
 		{
-			name:        "successful force restart",
-			systemID:    testSystemGUID,
-			requestBody: `{"ResetType": "ForceRestart"}`,
-			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
-				expectedResult := power.PowerActionResponse{
-					ReturnValue: power.ReturnValue(0),
-				}
-				mockFeature.EXPECT().
-					SendPowerAction(gomock.Any(), testSystemGUID, actionReset).
-					Return(expectedResult, nil)
-			},
-			expectedStatus: http.StatusOK,
-			validateResponse: func(t *testing.T, body string) {
-				t.Helper()
-				assert.Contains(t, body, "ReturnValue")
-			},
+			name:     "predictive failure is a warning",
+			hwInfo:   dto.HardwareInfo{CIMProcessor: dto.CIMResponse{Response: map[string]any{"OperationalStatus": []any{5}}}},
+			expected: healthWarning,
 		},
 		{
-			name:        "successful power cycle",
-			systemID:    testSystemGUID,
-			requestBody: `{"ResetType": "PowerCycle"}`,
-			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
-				expectedResult := power.PowerActionResponse{
-					ReturnValue: power.ReturnValue(0),
-				}
-				mockFeature.EXPECT().
-					SendPowerAction(gomock.Any(), testSystemGUID, actionPowerCycle).
-					Return(expectedResult, nil)
-			},
-			expectedStatus: http.StatusOK,
-			validateResponse: func(t *testing.T, body string) {
-				t.Helper()
-				assert.Contains(t, body, "ReturnValue")
-			},
+			name:     "minor failure health state is a warning",
+			hwInfo:   dto.HardwareInfo{CIMChassis: dto.CIMResponse{Response: map[string]any{"HealthState": 15}}},
+			expected: healthWarning,
 		},
 		{
-			name:        "invalid reset type",
-			systemID:    testSystemGUID,
-			requestBody: `{"ResetType": "InvalidType"}`,
-			setupMocks: func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
-				// No mock calls expected for invalid reset type
-			},
-			expectedStatus: http.StatusBadRequest,
-			validateResponse: func(t *testing.T, body string) {
-				t.Helper()
-				assert.Contains(t, body, "unsupported ResetType")
+			name: "the worst component wins",
+			hwInfo: dto.HardwareInfo{
+				CIMPhysicalMemory: dto.CIMResponse{Response: map[string]any{"OperationalStatus": []any{3}}},
+				CIMCard:           dto.CIMResponse{Response: map[string]any{"HealthState": 25}},
 			},
+			expected: healthCritical,
 		},
 		{
-			name:        "malformed JSON",
-			systemID:    testSystemGUID,
-			requestBody: `{"ResetType": }`, // Invalid JSON
-			setupMocks: func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
-				// No mock calls expected for malformed JSON
-			},
-			expectedStatus: http.StatusBadRequest,
-			validateResponse: func(t *testing.T, body string) {
-				t.Helper()
-				assert.Contains(t, body, "error")
-			},
+			name:     "non-recoverable error is critical",
+			hwInfo:   dto.HardwareInfo{CIMChip: dto.CIMResponse{Response: map[string]any{"OperationalStatus": []any{2, 7}}}},
+			expected: healthCritical,
 		},
 		{
-			name:        "missing reset type",
-			systemID:    testSystemGUID,
-			requestBody: `{}`, // Missing ResetType
-			setupMocks: func(_ *mocks.MockDeviceManagementFeature, _ *mocks.MockLogger) {
-				// No mock calls expected - empty ResetType will be treated as unsupported
-			},
-			expectedStatus: http.StatusBadRequest,
-			validateResponse: func(t *testing.T, body string) {
-				t.Helper()
-				assert.Contains(t, body, "unsupported ResetType")
-			},
+			name:     "malformed values are ignored",
+			hwInfo:   dto.HardwareInfo{CIMChip: dto.CIMResponse{Response: map[string]any{"HealthState": "bad", "OperationalStatus": "bad"}}},
+			expected: healthOK,
 		},
-		{
-			name:        "backend error",
-			systemID:    testSystemGUID,
-			requestBody: `{"ResetType": "On"}`,
-			setupMocks: func(mockFeature *mocks.MockDeviceManagementFeature, mockLogger *mocks.MockLogger) {
-				mockFeature.EXPECT().
-					SendPowerAction(gomock.Any(), testSystemGUID, actionPowerUp).
-					Return(power.PowerActionResponse{}, fmt.Errorf("system not found"))
+	}
 
-				mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
-			},
-			expectedStatus: http.StatusInternalServerError,
-			validateResponse: func(t *testing.T, body string) {
-				t.Helper()
-				assert.Contains(t, body, "system not found")
-			},
-		},
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, systemHealth(tt.hwInfo))
+		})
+	}
+}
+
+func TestSystemState(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, systemStateEnabled, systemState(powerStateOn))
+	assert.Equal(t, systemStateEnabled, systemState(powerStatePoweringOff))
+	assert.Equal(t, systemStateStarting, systemState(powerStatePoweringOn))
+	assert.Equal(t, systemStateStandbyOffline, systemState(powerStateOff))
+	assert.Equal(t, systemStateUnavailableOffline, systemState(powerStateUnknown))
+
+	assert.Equal(t, map[string]any{"State": systemStateStandbyOffline}, systemStatus(powerStateOff, ""),
+		"Health is left out when unknown")
+}
+
+func TestErrorHandling(t *testing.T) {
+	t.Parallel()
+
+	t.Run("handles context cancellation", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+		mockLogger := mocks.NewMockLogger(ctrl)
+
+		mockFeature.EXPECT().
+			Get(gomock.Any(), DefaultPageSize+1, 0, "").
+			Return(nil, context.Canceled)
+
+		mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		systems := router.Group("/redfish/v1/Systems")
+		systems.GET("", getSystemsCollectionHandler(mockFeature, nil, mockLogger))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel() // Cancel immediately
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(ctx, "GET", "/redfish/v1/Systems", http.NoBody)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("handles nil device feature gracefully", func(t *testing.T) {
+		t.Parallel()
+
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		systems := router.Group("/redfish/v1/Systems")
+
+		// This should not panic, but will result in a runtime error when called
+		require.NotPanics(t, func() {
+			systems.GET("", getSystemsCollectionHandler(nil, nil, nil))
+		})
+	})
+}
+
+func TestPostSystemResetDryRun(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		query          string
+		header         string
+		body           string
+		expectedStatus int
+		expectTask     bool
+	}{
+		{name: "query parameter", query: "?dry-run=true", body: `{"ResetType":"ForceOff"}`, expectedStatus: http.StatusOK, expectTask: true},
+		{name: "header", header: "true", body: `{"ResetType":"ForceOff"}`, expectedStatus: http.StatusOK, expectTask: true},
+		{name: "header 1", header: "1", body: `{"ResetType":"ForceOff"}`, expectedStatus: http.StatusOK, expectTask: true},
+		{name: "invalid reset type is still rejected", query: "?dry-run=true", body: `{"ResetType":"Explode"}`, expectedStatus: http.StatusBadRequest},
+		{name: "malformed query value", query: "?dry-run=perhaps", body: `{"ResetType":"ForceOff"}`, expectedStatus: http.StatusBadRequest},
+		{name: "malformed header value", header: "perhaps", body: `{"ResetType":"ForceOff"}`, expectedStatus: http.StatusBadRequest},
 	}
 
 	for _, tt := range tests {
@@ -590,75 +3102,96 @@ func TestPostSystemResetHandler(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			t.Cleanup(ctrl.Finish)
 
+			// SendPowerAction is never expected: a dry run must not reach the device
 			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
 			mockLogger := mocks.NewMockLogger(ctrl)
-
-			tt.setupMocks(mockFeature, mockLogger)
+			store := NewTaskStore()
 
 			gin.SetMode(gin.TestMode)
 			router := gin.New()
-			systems := router.Group("/redfish/v1/Systems")
-			systems.POST(":id/Actions/ComputerSystem.Reset", postSystemResetHandler(mockFeature, mockLogger))
+			router.POST("/redfish/v1/Systems/:id/Actions/ComputerSystem.Reset", postSystemResetHandler(mockFeature, nil, store, newDeviceLocks(), nil, mockLogger))
 
 			w := httptest.NewRecorder()
-			req, _ := http.NewRequestWithContext(
-				context.Background(),
-				"POST",
-				"/redfish/v1/Systems/"+tt.systemID+"/Actions/ComputerSystem.Reset",
-				strings.NewReader(tt.requestBody),
-			)
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost,
+				"/redfish/v1/Systems/"+testSystemGUID+"/Actions/ComputerSystem.Reset"+tt.query, strings.NewReader(tt.body))
 			req.Header.Set("Content-Type", "application/json")
 
+			if tt.header != "" {
+				req.Header.Set(dryRunHeader, tt.header)
+			}
+
 			router.ServeHTTP(w, req)
 
-			assert.Equal(t, tt.expectedStatus, w.Code)
-			tt.validateResponse(t, w.Body.String())
+			require.Equal(t, tt.expectedStatus, w.Code)
+
+			if !tt.expectTask {
+				assert.Empty(t, store.List())
+
+				return
+			}
+
+			var task Task
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &task))
+			assert.Equal(t, TaskStateCompleted, task.TaskState)
+			assert.Equal(t, TaskStatusOK, task.TaskStatus)
+			assert.Equal(t, task.ODataID, w.Header().Get("Location"))
+			require.Len(t, task.Messages, 1)
+			assert.Contains(t, task.Messages[0]["Message"], "no action was taken")
+
+			stored, ok := store.Get(task.ID)
+			require.True(t, ok)
+			assert.Equal(t, task.TaskState, stored.TaskState)
+			assert.Equal(t, task.Messages, stored.Messages)
 		})
 	}
 }
 
-func TestPowerStateMapping(t *testing.T) {
+func TestSystemInstanceSelect(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name            string
-		cimPowerState   int
-		expectedRedfish string
+		name           string
+		query          string
+		expectedStatus int
+		expectedKeys   []string
+		expectedArgs   []string
 	}{
 		{
-			name:            "CIM Power On maps to Redfish On",
-			cimPowerState:   actionPowerUp, // 2
-			expectedRedfish: powerStateOn,
-		},
-		{
-			name:            "CIM Sleep maps to Redfish On",
-			cimPowerState:   cimPowerSleep, // 3
-			expectedRedfish: powerStateOn,
+			name:           "selected properties plus odata fields",
+			query:          "?$select=PowerState,Id",
+			expectedStatus: http.StatusOK,
+			expectedKeys:   []string{"@odata.id", "@odata.type", "Id", "PowerState"},
 		},
 		{
-			name:            "CIM Standby maps to Redfish On",
-			cimPowerState:   cimPowerStandby, // 4
-			expectedRedfish: powerStateOn,
+			name:           "spaces around names",
+			query:          "?$select=" + url.QueryEscape(" Status , Bios"),
+			expectedStatus: http.StatusOK,
+			expectedKeys:   []string{"@odata.id", "@odata.type", "Bios", "Status"},
 		},
 		{
-			name:            "CIM Soft Off maps to Redfish Off",
-			cimPowerState:   cimPowerSoftOff, // 7
-			expectedRedfish: powerStateOff,
+			name:           "odata fields only",
+			query:          "?$select=@odata.id",
+			expectedStatus: http.StatusOK,
+			expectedKeys:   []string{"@odata.id", "@odata.type"},
 		},
 		{
-			name:            "CIM Hard Off maps to Redfish Off",
-			cimPowerState:   cimPowerHardOff, // 8
-			expectedRedfish: powerStateOff,
+			name:           "selected property the device did not report",
+			query:          "?$select=SerialNumber",
+			expectedStatus: http.StatusOK,
+			expectedKeys:   []string{"@odata.id", "@odata.type"},
 		},
 		{
-			name:            "Unknown CIM state maps to Redfish Unknown",
-			cimPowerState:   999,
-			expectedRedfish: powerStateUnknown,
+			name:           "unknown property",
+			query:          "?$select=PowerState,Temperature",
+			expectedStatus: http.StatusBadRequest,
+			expectedArgs:   []string{"Temperature", "$select"},
 		},
 		{
-			name:            "Zero value maps to Redfish Unknown",
-			cimPowerState:   0,
-			expectedRedfish: powerStateUnknown,
+			name:           "empty selection",
+			query:          "?$select=",
+			expectedStatus: http.StatusBadRequest,
+			expectedArgs:   []string{"", "$select"},
 		},
 	}
 
@@ -670,71 +3203,104 @@ func TestPowerStateMapping(t *testing.T) {
 			t.Cleanup(ctrl.Finish)
 
 			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
-			mockLogger := mocks.NewMockLogger(ctrl)
 
-			powerState := dto.PowerState{
-				PowerState: tt.cimPowerState,
+			if tt.expectedStatus == http.StatusOK {
+				mockFeature.EXPECT().GetPowerState(gomock.Any(), testSystemGUID).Return(dto.PowerState{PowerState: cimPowerOn}, nil)
+				mockFeature.EXPECT().GetHardwareInfo(gomock.Any(), testSystemGUID).Return(dto.HardwareInfo{}, nil)
 			}
-			mockFeature.EXPECT().
-				GetPowerState(gomock.Any(), testSystemGUID).
-				Return(powerState, nil)
-
-			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
 
 			gin.SetMode(gin.TestMode)
 			router := gin.New()
-			systems := router.Group("/redfish/v1/Systems")
-			systems.GET(":id", getSystemInstanceHandler(mockFeature, mockLogger))
+			router.GET("/redfish/v1/Systems/:id", getSystemInstanceHandler(mockFeature, nil, mocks.NewMockLogger(ctrl)))
 
 			w := httptest.NewRecorder()
-			req, _ := http.NewRequestWithContext(
-				context.Background(),
-				"GET",
-				"/redfish/v1/Systems/"+testSystemGUID,
-				http.NoBody,
-			)
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, systemsInstanceURL+tt.query, http.NoBody)
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedStatus, w.Code)
+
+			var body map[string]any
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+
+			if tt.expectedArgs != nil {
+				errorBody, ok := body["error"].(map[string]any)
+				require.True(t, ok)
+
+				extended, ok := errorBody["@Message.ExtendedInfo"].([]any)
+				require.True(t, ok)
+				require.Len(t, extended, 1)
+
+				info, ok := extended[0].(map[string]any)
+				require.True(t, ok)
+				assert.Equal(t, BaseQueryParameterOutOfRangeID, info["MessageId"])
+				assert.ElementsMatch(t, tt.expectedArgs, info["MessageArgs"])
+
+				return
+			}
 
-			router.ServeHTTP(w, req)
+			keys := make([]string, 0, len(body))
+			for key := range body {
+				keys = append(keys, key)
+			}
 
-			assert.Equal(t, http.StatusOK, w.Code)
+			assert.ElementsMatch(t, tt.expectedKeys, keys)
+			assert.Equal(t, "/redfish/v1/Systems/"+testSystemGUID, body["@odata.id"])
+			assert.Equal(t, "#ComputerSystem.v1_0_0.ComputerSystem", body["@odata.type"])
+			assert.NotEmpty(t, w.Header().Get("ETag"))
+		})
+	}
+}
 
-			var system map[string]interface{}
+func TestSystemSelectableProperties(t *testing.T) {
+	t.Parallel()
 
-			err := json.Unmarshal(w.Body.Bytes(), &system)
-			require.NoError(t, err)
+	inventory := systemInventory{
+		Manufacturer: "Intel", Model: "NUC", SerialNumber: "S1", SKU: "K1",
+		ProcessorCount: 1, MemoryGiB: 8,
+	}
 
-			assert.Equal(t, tt.expectedRedfish, system["PowerState"])
-		})
+	for property := range computerSystemPayload(DefaultRedfishBasePath, testSystemGUID, powerStateOn, healthOK, inventory) {
+		assert.True(t, systemSelectableProperties[property], "%s should be selectable", property)
 	}
 }
 
-func TestResetTypeMapping(t *testing.T) {
+func TestPostSystemResetContentType(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name              string
-		redfishResetType  string
-		expectedCIMAction int
+		name           string
+		contentType    string
+		body           string
+		expectReset    bool
+		expectedStatus int
+		expectedMsgID  string
 	}{
 		{
-			name:              "On maps to PowerUp action",
-			redfishResetType:  resetTypeOn,
-			expectedCIMAction: actionPowerUp, // 2
+			name:           "JSON body",
+			contentType:    "application/json; charset=utf-8",
+			body:           `{"ResetType":"On"}`,
+			expectReset:    true,
+			expectedStatus: http.StatusOK,
 		},
 		{
-			name:              "ForceOff maps to PowerDown action",
-			redfishResetType:  resetTypeForceOff,
-			expectedCIMAction: actionPowerDown, // 8
+			name:           "JSON body sent as text",
+			contentType:    "text/plain",
+			body:           `{"ResetType":"On"}`,
+			expectedStatus: http.StatusUnsupportedMediaType,
+			expectedMsgID:  BaseUnsupportedMediaTypeID,
 		},
 		{
-			name:              "ForceRestart maps to Reset action",
-			redfishResetType:  resetTypeForceRestart,
-			expectedCIMAction: actionReset, // 10
+			name:           "body without a content type",
+			body:           `{"ResetType":"On"}`,
+			expectedStatus: http.StatusUnsupportedMediaType,
+			expectedMsgID:  BaseUnsupportedMediaTypeID,
 		},
 		{
-			name:              "PowerCycle maps to PowerCycle action",
-			redfishResetType:  resetTypePowerCycle,
-			expectedCIMAction: actionPowerCycle, // 5
+			name:           "empty body",
+			contentType:    "text/plain",
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyMissingID,
 		},
 	}
 
@@ -746,259 +3312,188 @@ func TestResetTypeMapping(t *testing.T) {
 			t.Cleanup(ctrl.Finish)
 
 			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
-			mockLogger := mocks.NewMockLogger(ctrl)
-
-			expectedResult := power.PowerActionResponse{
-				ReturnValue: power.ReturnValue(0),
+			if tt.expectReset {
+				mockFeature.EXPECT().SendPowerAction(gomock.Any(), testSystemGUID, actionPowerUp).
+					Return(power.PowerActionResponse{ReturnValue: 0}, nil)
 			}
-			mockFeature.EXPECT().
-				SendPowerAction(gomock.Any(), testSystemGUID, tt.expectedCIMAction).
-				Return(expectedResult, nil)
 
 			gin.SetMode(gin.TestMode)
 			router := gin.New()
-			systems := router.Group("/redfish/v1/Systems")
-			systems.POST(":id/Actions/ComputerSystem.Reset", postSystemResetHandler(mockFeature, mockLogger))
-
-			requestBody := fmt.Sprintf(`{"ResetType": %q}`, tt.redfishResetType)
+			router.POST("/redfish/v1/Systems/:id/Actions/ComputerSystem.Reset",
+				postSystemResetHandler(mockFeature, nil, NewTaskStore(), newDeviceLocks(), nil, mocks.NewMockLogger(ctrl)))
 
 			w := httptest.NewRecorder()
-			req, _ := http.NewRequestWithContext(
-				context.Background(),
-				"POST",
-				"/redfish/v1/Systems/"+testSystemGUID+"/Actions/ComputerSystem.Reset",
-				strings.NewReader(requestBody),
-			)
-			req.Header.Set("Content-Type", "application/json")
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, resetActionURL, strings.NewReader(tt.body))
+
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
 
 			router.ServeHTTP(w, req)
 
-			assert.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedMsgID != "" {
+				var body RedfishError
+
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				assert.Equal(t, tt.expectedMsgID, body.Error.Code)
+			}
 		})
 	}
 }
 
-func TestSystemsIntegrationWithFirmware(t *testing.T) {
+func TestPostSystemResetLocation(t *testing.T) {
 	t.Parallel()
 
-	t.Run("firmware routes integrated with systems", func(t *testing.T) {
-		t.Parallel()
-
-		ctrl := gomock.NewController(t)
-		t.Cleanup(ctrl.Finish)
-
-		mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
-		mockLogger := mocks.NewMockLogger(ctrl)
-
-		// Mock for firmware inventory access
-		mockFeature.EXPECT().
-			GetVersion(gomock.Any(), testSystemGUID).
-			Return(dto.Version{}, dtov2.Version{AMT: "15.0.25"}, nil)
-
-		// Mock for BIOS hardware info (required by firmware routes)
-		mockFeature.EXPECT().
-			GetHardwareInfo(gomock.Any(), testSystemGUID).
-			Return(map[string]interface{}{
-				"CIM_BIOSElement": map[string]interface{}{
-					"Version":      "BIOS.15.25.10",
-					"Manufacturer": "Intel Corp.",
-				},
-			}, nil)
-
-		mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
-		mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
-
-		gin.SetMode(gin.TestMode)
-		router := gin.New()
-
-		// Setup complete systems routes including firmware
-		redfishGroup := router.Group("/redfish/v1")
-		NewSystemsRoutes(redfishGroup, mockFeature, mockLogger)
-
-		// Test that firmware inventory endpoint is accessible via systems routes
-		w := httptest.NewRecorder()
-		req, _ := http.NewRequestWithContext(
-			context.Background(),
-			"GET",
-			"/redfish/v1/Systems/"+testSystemGUID+"/FirmwareInventory",
-			http.NoBody,
-		)
-
-		router.ServeHTTP(w, req)
+	tests := []struct {
+		name           string
+		sendErr        error
+		expectedStatus int
+		expectedState  string
+	}{
+		{name: "successful reset", expectedStatus: http.StatusOK, expectedState: TaskStateCompleted},
+		{name: "failed reset", sendErr: fmt.Errorf("connection refused"), expectedStatus: http.StatusBadGateway, expectedState: TaskStateException},
+	}
 
-		assert.Equal(t, http.StatusOK, w.Code)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
 
-		var collection map[string]interface{}
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
 
-		err := json.Unmarshal(w.Body.Bytes(), &collection)
-		require.NoError(t, err)
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().SendPowerAction(gomock.Any(), testSystemGUID, actionPowerCycle).
+				Return(power.PowerActionResponse{ReturnValue: 0}, tt.sendErr)
 
-		assert.Equal(t, "#SoftwareInventoryCollection.SoftwareInventoryCollection", collection["@odata.type"])
-		assert.Equal(t, "/redfish/v1/Systems/"+testSystemGUID+"/FirmwareInventory", collection["@odata.id"])
-	})
-}
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
-func TestConstants(t *testing.T) {
-	t.Parallel()
+			store := NewTaskStore()
 
-	t.Run("power state constants", func(t *testing.T) {
-		t.Parallel()
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/redfish/v1/Systems/:id/Actions/ComputerSystem.Reset", postSystemResetHandler(mockFeature, nil, store, newDeviceLocks(), nil, mockLogger))
 
-		assert.Equal(t, "Unknown", powerStateUnknown)
-		assert.Equal(t, "On", powerStateOn)
-		assert.Equal(t, "Off", powerStateOff)
-	})
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost,
+				"/redfish/v1/Systems/"+testSystemGUID+"/Actions/ComputerSystem.Reset", strings.NewReader(`{"ResetType":"PowerCycle"}`))
+			req.Header.Set("Content-Type", "application/json")
 
-	t.Run("reset type constants", func(t *testing.T) {
-		t.Parallel()
+			router.ServeHTTP(w, req)
 
-		assert.Equal(t, "On", resetTypeOn)
-		assert.Equal(t, "ForceOff", resetTypeForceOff)
-		assert.Equal(t, "ForceRestart", resetTypeForceRestart)
-		assert.Equal(t, "PowerCycle", resetTypePowerCycle)
-	})
+			require.Equal(t, tt.expectedStatus, w.Code)
 
-	t.Run("action constants", func(t *testing.T) {
-		t.Parallel()
+			tasks := store.List()
+			require.Len(t, tasks, 1)
+			assert.Equal(t, tt.expectedState, tasks[0].TaskState)
 
-		assert.Equal(t, 2, actionPowerUp)
-		assert.Equal(t, 5, actionPowerCycle)
-		assert.Equal(t, 8, actionPowerDown)
-		assert.Equal(t, 10, actionReset)
-	})
+			if tt.sendErr != nil {
+				assert.Empty(t, w.Header().Get("Location"))
 
-	t.Run("CIM power state constants", func(t *testing.T) {
-		t.Parallel()
+				return
+			}
 
-		assert.Equal(t, 2, cimPowerOn)
-		assert.Equal(t, 3, cimPowerSleep)
-		assert.Equal(t, 4, cimPowerStandby)
-		assert.Equal(t, 7, cimPowerSoftOff)
-		assert.Equal(t, 8, cimPowerHardOff)
-	})
+			assert.Equal(t, tasks[0].ODataID, w.Header().Get("Location"))
+			assert.Equal(t, tasksBasePath+"/"+tasks[0].ID, w.Header().Get("Location"))
+			assert.Equal(t, odataVersion, w.Header().Get(odataVersionHeader))
 
-	t.Run("limits constants", func(t *testing.T) {
-		t.Parallel()
+			var task Task
 
-		assert.Equal(t, 100, maxSystemsList)
-	})
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &task))
+			assert.Equal(t, w.Header().Get("Location"), task.ODataID, "Location names the Task in the body")
+			assert.Equal(t, TaskStateCompleted, task.TaskState)
+			assert.NotEmpty(t, task.EndTime)
+		})
+	}
 }
 
-func TestSystemResponseStructure(t *testing.T) {
+func TestPostSystemResetAsync(t *testing.T) {
 	t.Parallel()
 
-	t.Run("system response contains all required fields", func(t *testing.T) {
-		t.Parallel()
-
-		ctrl := gomock.NewController(t)
-		t.Cleanup(ctrl.Finish)
-
-		mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
-		mockLogger := mocks.NewMockLogger(ctrl)
-
-		powerState := dto.PowerState{PowerState: actionPowerUp}
-		mockFeature.EXPECT().
-			GetPowerState(gomock.Any(), testSystemGUID).
-			Return(powerState, nil)
-
-		mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
-
-		gin.SetMode(gin.TestMode)
-		router := gin.New()
-		systems := router.Group("/redfish/v1/Systems")
-		systems.GET(":id", getSystemInstanceHandler(mockFeature, mockLogger))
-
-		w := httptest.NewRecorder()
-		req, _ := http.NewRequestWithContext(
-			context.Background(),
-			"GET",
-			"/redfish/v1/Systems/"+testSystemGUID,
-			http.NoBody,
-		)
-
-		router.ServeHTTP(w, req)
+	tests := []struct {
+		name          string
+		sendErr       error
+		expectedState string
+	}{
+		{name: "task completes", expectedState: TaskStateCompleted},
+		{name: "task records the failure", sendErr: fmt.Errorf("connection refused"), expectedState: TaskStateException},
+	}
 
-		assert.Equal(t, http.StatusOK, w.Code)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
 
-		var system map[string]interface{}
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
 
-		err := json.Unmarshal(w.Body.Bytes(), &system)
-		require.NoError(t, err)
+			release := make(chan struct{})
 
-		// Check required Redfish properties
-		requiredFields := []string{
-			"@odata.type",
-			"@odata.id",
-			"Id",
-			"Name",
-			"PowerState",
-			"Actions",
-		}
+			mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+			mockFeature.EXPECT().SendPowerAction(gomock.Any(), testSystemGUID, actionPowerCycle).
+				DoAndReturn(func(ctx context.Context, _ string, _ int) (power.PowerActionResponse, error) {
+					<-release
 
-		for _, field := range requiredFields {
-			assert.Contains(t, system, field, "System response missing required field: %s", field)
-		}
+					// The action must not be cancelled when the request completes
+					if ctx.Err() != nil {
+						return power.PowerActionResponse{}, ctx.Err()
+					}
 
-		// Check Actions structure
-		actions, ok := system["Actions"].(map[string]interface{})
-		require.True(t, ok, "Actions should be a map")
-		assert.Contains(t, actions, "#ComputerSystem.Reset")
+					return power.PowerActionResponse{ReturnValue: 0}, tt.sendErr
+				})
 
-		resetAction, ok := actions["#ComputerSystem.Reset"].(map[string]interface{})
-		require.True(t, ok, "Reset action should be a map")
-		assert.Contains(t, resetAction, "target")
-		assert.Contains(t, resetAction, "ResetType@Redfish.AllowableValues")
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
-		allowedValues, ok := resetAction["ResetType@Redfish.AllowableValues"].([]interface{})
-		require.True(t, ok, "AllowableValues should be a slice of interfaces")
-		assert.Equal(t, 4, len(allowedValues)) // On, ForceOff, ForceRestart, PowerCycle
-	})
-}
+			cfg := &config.Config{}
+			cfg.Redfish.AsyncResets = true
+			cfg.Redfish.DeviceLockTimeout = 20 * time.Millisecond
 
-func TestErrorHandling(t *testing.T) {
-	t.Parallel()
+			store := NewTaskStore()
 
-	t.Run("handles context cancellation", func(t *testing.T) {
-		t.Parallel()
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/redfish/v1/Systems/:id/Actions/ComputerSystem.Reset", postSystemResetHandler(mockFeature, nil, store, newDeviceLocks(), cfg, mockLogger))
 
-		ctrl := gomock.NewController(t)
-		t.Cleanup(ctrl.Finish)
+			reset := func() *httptest.ResponseRecorder {
+				w := httptest.NewRecorder()
+				req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost,
+					"/redfish/v1/Systems/"+testSystemGUID+"/Actions/ComputerSystem.Reset", strings.NewReader(`{"ResetType":"PowerCycle"}`))
+				req.Header.Set("Content-Type", "application/json")
 
-		mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
-		mockLogger := mocks.NewMockLogger(ctrl)
+				router.ServeHTTP(w, req)
 
-		mockFeature.EXPECT().
-			Get(gomock.Any(), maxSystemsList, 0, "").
-			Return(nil, context.Canceled)
+				return w
+			}
 
-		mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+			w := reset()
+			require.Equal(t, http.StatusAccepted, w.Code)
 
-		gin.SetMode(gin.TestMode)
-		router := gin.New()
-		systems := router.Group("/redfish/v1/Systems")
-		systems.GET("", getSystemsCollectionHandler(mockFeature, mockLogger))
+			var task Task
 
-		ctx, cancel := context.WithCancel(context.Background())
-		cancel() // Cancel immediately
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &task))
+			assert.Equal(t, TaskStateRunning, task.TaskState)
+			assert.Equal(t, task.ODataID, w.Header().Get("Location"))
 
-		w := httptest.NewRecorder()
-		req, _ := http.NewRequestWithContext(ctx, "GET", "/redfish/v1/Systems", http.NoBody)
+			stored, ok := store.Get(task.ID)
+			require.True(t, ok)
+			assert.Equal(t, TaskStateRunning, stored.TaskState)
 
-		router.ServeHTTP(w, req)
+			// The running action still holds the device lock
+			assert.Equal(t, http.StatusServiceUnavailable, reset().Code)
 
-		assert.Equal(t, http.StatusInternalServerError, w.Code)
-	})
+			close(release)
 
-	t.Run("handles nil device feature gracefully", func(t *testing.T) {
-		t.Parallel()
+			require.Eventually(t, func() bool {
+				stored, _ = store.Get(task.ID)
 
-		gin.SetMode(gin.TestMode)
-		router := gin.New()
-		systems := router.Group("/redfish/v1/Systems")
+				return stored.TaskState != TaskStateRunning
+			}, time.Second, 5*time.Millisecond)
 
-		// This should not panic, but will result in a runtime error when called
-		require.NotPanics(t, func() {
-			systems.GET("", getSystemsCollectionHandler(nil, nil))
+			assert.Equal(t, tt.expectedState, stored.TaskState)
+			assert.NotEmpty(t, stored.EndTime)
 		})
-	})
+	}
 }
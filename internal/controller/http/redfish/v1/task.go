@@ -0,0 +1,256 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements Redfish API v1 TaskService resources.
+package v1
+
+import (
+	"maps"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// Task states and statuses (Task.v1_4_3)
+const (
+	TaskStateRunning   = "Running"
+	TaskStateCompleted = "Completed"
+	TaskStateException = "Exception"
+	TaskStatusOK       = "OK"
+	TaskStatusCritical = "Critical"
+	taskServicePath    = "/redfish/v1/TaskService"
+	tasksBasePath      = taskServicePath + "/Tasks"
+	maxStoredTasks     = 1000
+	// taskTimeFormat is RFC 3339 with milliseconds, so that short tasks show a real duration
+	taskTimeFormat = "2006-01-02T15:04:05.000Z07:00"
+)
+
+// Task represents a Redfish Task resource
+type Task struct {
+	ODataType  string           `json:"@odata.type"`
+	ODataID    string           `json:"@odata.id"`
+	ID         string           `json:"Id"`
+	Name       string           `json:"Name"`
+	TaskState  string           `json:"TaskState"`
+	TaskStatus string           `json:"TaskStatus"`
+	StartTime  string           `json:"StartTime"`
+	EndTime    string           `json:"EndTime,omitempty"`
+	Messages   []map[string]any `json:"Messages"`
+	Oem        map[string]any   `json:"Oem,omitempty"`
+
+	// started is when the task was started, kept to compute the duration once it finishes
+	started time.Time
+}
+
+// TaskStore keeps the tasks created by Redfish actions in memory.
+// The oldest tasks are evicted once maxStoredTasks is reached.
+type TaskStore struct {
+	mu    sync.RWMutex
+	tasks map[string]*Task
+	order []string
+	now   func() time.Time
+}
+
+// DefaultTaskStore is the task store shared by the Redfish route registrars
+var DefaultTaskStore = NewTaskStore()
+
+// NewTaskStore creates an empty task store
+func NewTaskStore() *TaskStore {
+	return &TaskStore{tasks: map[string]*Task{}, now: time.Now}
+}
+
+// Start creates a new Running task and returns a copy of it. Call it right before the work the
+// task tracks, since its StartTime is taken here.
+func (s *TaskStore) Start(name string) Task {
+	id := uuid.NewString()
+	started := s.now().UTC()
+	task := &Task{
+		ODataType:  "#Task.v1_4_3.Task",
+		ODataID:    tasksBasePath + "/" + id,
+		ID:         id,
+		Name:       name,
+		TaskState:  TaskStateRunning,
+		TaskStatus: TaskStatusOK,
+		StartTime:  started.Format(taskTimeFormat),
+		Messages:   []map[string]any{},
+		started:    started,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.order) >= maxStoredTasks {
+		delete(s.tasks, s.order[0])
+		s.order = s.order[1:]
+	}
+
+	s.tasks[id] = task
+	s.order = append(s.order, id)
+
+	return copyTask(task)
+}
+
+// Finish moves a task to its final state, records the given messages and sets EndTime and the
+// task's duration in milliseconds, taken from its Start. Call it right after the tracked work returns.
+func (s *TaskStore) Finish(id, state, status string, messages ...map[string]any) (Task, bool) {
+	ended := s.now().UTC()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	task, ok := s.tasks[id]
+	if !ok {
+		return Task{}, false
+	}
+
+	task.TaskState = state
+	task.TaskStatus = status
+	task.EndTime = ended.Format(taskTimeFormat)
+	task.Messages = append(task.Messages, messages...)
+	task.Oem = taskDurationOem(max(ended.Sub(task.started), 0))
+
+	return copyTask(task), true
+}
+
+// Get returns a copy of the task with the given id
+func (s *TaskStore) Get(id string) (Task, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	task, ok := s.tasks[id]
+	if !ok {
+		return Task{}, false
+	}
+
+	return copyTask(task), true
+}
+
+// List returns copies of all stored tasks in creation order
+func (s *TaskStore) List() []Task {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tasks := make([]Task, 0, len(s.order))
+	for _, id := range s.order {
+		tasks = append(tasks, copyTask(s.tasks[id]))
+	}
+
+	return tasks
+}
+
+// copyTask returns a copy of the task that is safe to hand out of the store
+func copyTask(task *Task) Task {
+	out := *task
+	out.Oem = maps.Clone(task.Oem)
+	out.Messages = make([]map[string]any, 0, len(task.Messages))
+
+	for _, message := range task.Messages {
+		out.Messages = append(out.Messages, maps.Clone(message))
+	}
+
+	return out
+}
+
+// linkedUnder returns the task with its @odata.id under base. The store keeps the links under
+// DefaultRedfishBasePath; handlers move them under the base path of the request.
+func (t Task) linkedUnder(base string) Task {
+	t.ODataID = rebaseLink(base, t.ODataID)
+
+	return t
+}
+
+// taskDurationOem reports how long a finished task ran. Task v1_4_3 has no duration property,
+// so it is carried in the Intel OEM section like the other console extensions.
+func taskDurationOem(duration time.Duration) map[string]any {
+	return map[string]any{
+		"Intel": map[string]any{
+			"@odata.type":          "#Intel.v1_0_0.Intel",
+			"DurationMilliseconds": duration.Milliseconds(),
+		},
+	}
+}
+
+// taskMessage builds a Redfish message entry for a task
+func taskMessage(messageID, message string) map[string]any {
+	return map[string]any{
+		"MessageId": messageID,
+		"Message":   message,
+	}
+}
+
+// NewTaskServiceRoutes registers the read-only Redfish TaskService routes.
+// It exposes:
+// - GET /redfish/v1/TaskService
+// - GET /redfish/v1/TaskService/Tasks
+// - GET /redfish/v1/TaskService/Tasks/:taskId
+func NewTaskServiceRoutes(r *gin.RouterGroup, store *TaskStore, l logger.Interface) {
+	r.GET("/TaskService", taskServiceHandler)
+	r.GET("/TaskService/Tasks", tasksCollectionHandler(store))
+	r.GET("/TaskService/Tasks/:taskId", taskInstanceHandler(store))
+
+	l.Info("Registered Redfish TaskService routes under %s", r.BasePath()+"/TaskService")
+}
+
+// taskServiceHandler handles TaskService requests
+func taskServiceHandler(c *gin.Context) {
+	SetRedfishHeaders(c)
+
+	base := linkBase(c)
+	payload := map[string]any{
+		"@odata.type":    "#TaskService.v1_2_0.TaskService",
+		"@odata.id":      rebaseLink(base, taskServicePath),
+		"Id":             "TaskService",
+		"Name":           "Task Service",
+		"ServiceEnabled": true,
+		"Tasks":          map[string]any{"@odata.id": rebaseLink(base, tasksBasePath)},
+	}
+
+	c.JSON(http.StatusOK, payload)
+}
+
+func tasksCollectionHandler(store *TaskStore) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		SetRedfishHeaders(c)
+
+		tasks := store.List()
+		base := linkBase(c)
+
+		members := make([]any, 0, len(tasks))
+		for i := range tasks {
+			members = append(members, map[string]any{"@odata.id": rebaseLink(base, tasks[i].ODataID)})
+		}
+
+		payload := map[string]any{
+			"@odata.type":         "#TaskCollection.TaskCollection",
+			"@odata.id":           rebaseLink(base, tasksBasePath),
+			"Name":                "Task Collection",
+			"Members@odata.count": len(members),
+			"Members":             members,
+		}
+
+		c.JSON(http.StatusOK, payload)
+	}
+}
+
+func taskInstanceHandler(store *TaskStore) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.Param("taskId")
+
+		task, ok := store.Get(id)
+		if !ok {
+			ResourceNotFoundError(c, "Task", id)
+
+			return
+		}
+
+		SetRedfishHeaders(c)
+		c.JSON(http.StatusOK, task.linkedUnder(linkBase(c)))
+	}
+}
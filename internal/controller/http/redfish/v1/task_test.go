@@ -0,0 +1,204 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/mocks"
+)
+
+func TestTaskStoreLifecycle(t *testing.T) {
+	t.Parallel()
+
+	store := NewTaskStore()
+
+	task := store.Start("Test Task")
+	assert.Equal(t, TaskStateRunning, task.TaskState)
+	assert.Equal(t, tasksBasePath+"/"+task.ID, task.ODataID)
+	assert.Empty(t, task.EndTime)
+
+	finished, ok := store.Finish(task.ID, TaskStateCompleted, TaskStatusOK, taskMessage(BaseSuccessMessageID, "done"))
+	require.True(t, ok)
+	assert.Equal(t, TaskStateCompleted, finished.TaskState)
+	assert.NotEmpty(t, finished.EndTime)
+	require.Len(t, finished.Messages, 1)
+
+	// Copies handed out must not alias the stored task
+	finished.Messages[0]["MessageId"] = "changed"
+
+	stored, ok := store.Get(task.ID)
+	require.True(t, ok)
+	assert.Equal(t, BaseSuccessMessageID, stored.Messages[0]["MessageId"])
+
+	_, ok = store.Finish("missing", TaskStateCompleted, TaskStatusOK)
+	assert.False(t, ok)
+}
+
+func TestTaskStoreTimes(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 3, 4, 5, 6, 7, 250*int(time.Millisecond), time.UTC)
+
+	store := NewTaskStore()
+	store.now = func() time.Time { return now }
+
+	task := store.Start("Reset")
+
+	now = now.Add(1500 * time.Millisecond)
+
+	finished, ok := store.Finish(task.ID, TaskStateCompleted, TaskStatusOK)
+	require.True(t, ok)
+
+	assert.Equal(t, "2025-03-04T05:06:07.250Z", finished.StartTime)
+	assert.Equal(t, "2025-03-04T05:06:08.750Z", finished.EndTime)
+
+	start, err := time.Parse(time.RFC3339, finished.StartTime)
+	require.NoError(t, err)
+
+	end, err := time.Parse(time.RFC3339, finished.EndTime)
+	require.NoError(t, err)
+
+	assert.False(t, end.Before(start), "EndTime must not precede StartTime")
+
+	intel, ok := finished.Oem["Intel"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, int64(1500), intel["DurationMilliseconds"])
+}
+
+func TestTaskStoreRealTimes(t *testing.T) {
+	t.Parallel()
+
+	store := NewTaskStore()
+	task := store.Start("Clear event log")
+
+	time.Sleep(5 * time.Millisecond)
+
+	finished, ok := store.Finish(task.ID, TaskStateCompleted, TaskStatusOK)
+	require.True(t, ok)
+
+	body, err := json.Marshal(finished)
+	require.NoError(t, err)
+
+	var decoded map[string]any
+
+	require.NoError(t, json.Unmarshal(body, &decoded))
+
+	startTime, ok := decoded["StartTime"].(string)
+	require.True(t, ok)
+
+	endTime, ok := decoded["EndTime"].(string)
+	require.True(t, ok)
+
+	start, err := time.Parse(time.RFC3339, startTime)
+	require.NoError(t, err)
+
+	end, err := time.Parse(time.RFC3339, endTime)
+	require.NoError(t, err)
+
+	assert.GreaterOrEqual(t, end.Sub(start), 5*time.Millisecond)
+	assert.NotContains(t, decoded, "started")
+
+	oem, ok := decoded["Oem"].(map[string]any)
+	require.True(t, ok)
+
+	intel, ok := oem["Intel"].(map[string]any)
+	require.True(t, ok)
+	assert.GreaterOrEqual(t, intel["DurationMilliseconds"], float64(5))
+}
+
+func TestTaskStoreEviction(t *testing.T) {
+	t.Parallel()
+
+	store := NewTaskStore()
+
+	first := store.Start("first")
+	for i := 0; i < maxStoredTasks; i++ {
+		store.Start("filler")
+	}
+
+	_, ok := store.Get(first.ID)
+	assert.False(t, ok, "oldest task should be evicted")
+	assert.Len(t, store.List(), maxStoredTasks)
+}
+
+func TestTaskServiceRoutes(t *testing.T) {
+	t.Parallel()
+
+	store := NewTaskStore()
+	task := store.Start("Reset")
+
+	tests := []struct {
+		name           string
+		path           string
+		expectedStatus int
+		expectedBody   []string
+	}{
+		{
+			name:           "TaskService",
+			path:           "/redfish/v1/TaskService",
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{`"#TaskService.v1_2_0.TaskService"`, `"Tasks":{"@odata.id":"/redfish/v1/TaskService/Tasks"}`},
+		},
+		{
+			name:           "Tasks collection",
+			path:           "/redfish/v1/TaskService/Tasks",
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{`"Members@odata.count":1`, task.ODataID},
+		},
+		{
+			name:           "Task instance",
+			path:           task.ODataID,
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{`"TaskState":"Running"`, task.ID},
+		},
+		{
+			name:           "unknown task",
+			path:           "/redfish/v1/TaskService/Tasks/unknown",
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   []string{BaseResourceNotFoundID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).Times(1)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			NewTaskServiceRoutes(router.Group("/redfish/v1"), store, mockLogger)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, tt.path, http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			for _, expected := range tt.expectedBody {
+				assert.Contains(t, w.Body.String(), expected)
+			}
+
+			assert.True(t, json.Valid(w.Body.Bytes()))
+		})
+	}
+}
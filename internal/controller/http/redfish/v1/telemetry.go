@@ -0,0 +1,162 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements the Redfish API v1 TelemetryService resources.
+package v1
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// TelemetryService constants
+const (
+	telemetryServicePath = "/redfish/v1/TelemetryService"
+	metricReportsPath    = telemetryServicePath + "/MetricReports"
+	powerStateReportID   = "PowerState"
+	powerStateMetricID   = "PowerState"
+	// maxMetricReportSystems caps the systems sampled by one report, since each costs a power state query
+	maxMetricReportSystems = maxSystemsList
+)
+
+// MetricValue is one sampled value of a MetricReport. MetricProperty points at the sampled property.
+type MetricValue struct {
+	MetricID       string `json:"MetricId"`
+	MetricValue    string `json:"MetricValue"`
+	MetricProperty string `json:"MetricProperty"`
+	Timestamp      string `json:"Timestamp"`
+}
+
+// MetricReport represents a Redfish MetricReport resource
+type MetricReport struct {
+	ODataID      string        `json:"@odata.id"`
+	ODataType    string        `json:"@odata.type"`
+	ID           string        `json:"Id"`
+	Name         string        `json:"Name"`
+	Timestamp    string        `json:"Timestamp"`
+	MetricValues []MetricValue `json:"MetricValues"`
+}
+
+// NewTelemetryServiceRoutes registers the read-only Redfish TelemetryService routes.
+// It exposes:
+// - GET /redfish/v1/TelemetryService
+// - GET /redfish/v1/TelemetryService/MetricReports
+// - GET /redfish/v1/TelemetryService/MetricReports/PowerState
+func NewTelemetryServiceRoutes(r *gin.RouterGroup, d devices.Feature, cfg *config.Config, l logger.Interface) {
+	telemetry := r.Group("/TelemetryService", RedfishErrorClassifierMiddleware(cfg))
+
+	telemetry.GET("", telemetryServiceHandler)
+	telemetry.GET("/MetricReports", metricReportsCollectionHandler)
+	telemetry.GET("/MetricReports/:reportId", metricReportHandler(d, cfg, l))
+
+	l.Info("Registered Redfish TelemetryService routes under %s", r.BasePath()+"/TelemetryService")
+}
+
+func telemetryServiceHandler(c *gin.Context) {
+	SetRedfishHeaders(c)
+
+	base := linkBase(c)
+
+	payload := map[string]any{
+		"@odata.type":    "#TelemetryService.v1_2_0.TelemetryService",
+		"@odata.id":      rebaseLink(base, telemetryServicePath),
+		"Id":             "TelemetryService",
+		"Name":           "Telemetry Service",
+		"ServiceEnabled": true,
+		"Status": map[string]any{
+			"State":  "Enabled",
+			"Health": "OK",
+		},
+		"MetricReports": map[string]any{"@odata.id": rebaseLink(base, metricReportsPath)},
+	}
+
+	c.JSON(http.StatusOK, payload)
+}
+
+func metricReportsCollectionHandler(c *gin.Context) {
+	SetRedfishHeaders(c)
+
+	reports := rebaseLink(linkBase(c), metricReportsPath)
+
+	payload := map[string]any{
+		"@odata.type":         "#MetricReportCollection.MetricReportCollection",
+		"@odata.id":           reports,
+		"Name":                "Metric Report Collection",
+		"Members@odata.count": 1,
+		"Members":             []any{map[string]any{"@odata.id": reports + "/" + powerStateReportID}},
+	}
+
+	c.JSON(http.StatusOK, payload)
+}
+
+// metricReportHandler samples the PowerState report when it is requested. The report covers the
+// first maxMetricReportSystems systems in GUID order, queried with at most expandWorkers
+// concurrent calls; a system whose power state cannot be read reports Unknown.
+func metricReportHandler(d devices.Feature, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+	workers := expandWorkers(cfg)
+
+	return func(c *gin.Context) {
+		id := c.Param("reportId")
+
+		if id != powerStateReportID {
+			ResourceNotFoundError(c, "MetricReport", id)
+
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		items, err := d.Get(ctx, maxMetricReportSystems, 0, "")
+		if err != nil {
+			l.Error(err, "http - redfish - PowerState metric report [request %s]", requestID(c))
+			deviceCallError(c, err)
+
+			return
+		}
+
+		guids := make([]string, 0, len(items))
+		for i := range items { // avoid value copy
+			if items[i].GUID != "" {
+				guids = append(guids, items[i].GUID)
+			}
+		}
+
+		sort.Strings(guids)
+
+		powerStates := fetchPowerStatesConcurrently(c.Request.Context(), d, guids, workers, timeout, l)
+		timestamp := time.Now().UTC().Format(time.RFC3339)
+		base := linkBase(c)
+
+		values := make([]MetricValue, 0, len(guids))
+		for _, guid := range guids {
+			values = append(values, MetricValue{
+				MetricID:       powerStateMetricID,
+				MetricValue:    powerStates[guid],
+				MetricProperty: systemPath(base, guid) + "#/PowerState",
+				Timestamp:      timestamp,
+			})
+		}
+
+		SetRedfishHeaders(c)
+		c.JSON(http.StatusOK, MetricReport{
+			ODataID:      rebaseLink(base, metricReportsPath) + "/" + powerStateReportID,
+			ODataType:    "#MetricReport.v1_4_0.MetricReport",
+			ID:           powerStateReportID,
+			Name:         "Power State Report",
+			Timestamp:    timestamp,
+			MetricValues: values,
+		})
+	}
+}
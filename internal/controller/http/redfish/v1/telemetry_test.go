@@ -0,0 +1,158 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dto "github.com/device-management-toolkit/console/internal/entity/dto/v1"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+func newTelemetryRouter(d *mocks.MockDeviceManagementFeature) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	NewTelemetryServiceRoutes(router.Group("/redfish/v1"), d, nil, logger.New("test"))
+
+	return router
+}
+
+func getTelemetry(router *gin.Engine, path string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, path, http.NoBody)
+
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestTelemetryServiceResources(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	// Neither resource contacts a device
+	router := newTelemetryRouter(mocks.NewMockDeviceManagementFeature(ctrl))
+
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{
+			path: telemetryServicePath,
+			expected: `{
+				"@odata.type": "#TelemetryService.v1_2_0.TelemetryService",
+				"@odata.id": "/redfish/v1/TelemetryService",
+				"Id": "TelemetryService",
+				"Name": "Telemetry Service",
+				"ServiceEnabled": true,
+				"Status": {"State": "Enabled", "Health": "OK"},
+				"MetricReports": {"@odata.id": "/redfish/v1/TelemetryService/MetricReports"}
+			}`,
+		},
+		{
+			path: metricReportsPath,
+			expected: `{
+				"@odata.type": "#MetricReportCollection.MetricReportCollection",
+				"@odata.id": "/redfish/v1/TelemetryService/MetricReports",
+				"Name": "Metric Report Collection",
+				"Members@odata.count": 1,
+				"Members": [{"@odata.id": "/redfish/v1/TelemetryService/MetricReports/PowerState"}]
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		w := getTelemetry(router, tt.path)
+
+		require.Equal(t, http.StatusOK, w.Code, tt.path)
+		assert.JSONEq(t, tt.expected, w.Body.String(), tt.path)
+	}
+}
+
+func TestPowerStateMetricReport(t *testing.T) {
+	t.Parallel()
+
+	const (
+		guidOn          = "00000000-0000-4000-8000-000000000001"
+		guidOff         = "00000000-0000-4000-8000-000000000002"
+		guidUnreachable = "00000000-0000-4000-8000-000000000003"
+	)
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+	mockFeature.EXPECT().Get(gomock.Any(), maxMetricReportSystems, 0, "").
+		Return([]dto.Device{{GUID: guidUnreachable}, {GUID: guidOn}, {GUID: ""}, {GUID: guidOff}}, nil)
+	mockFeature.EXPECT().GetPowerState(gomock.Any(), guidOn).Return(dto.PowerState{PowerState: cimPowerOn}, nil)
+	mockFeature.EXPECT().GetPowerState(gomock.Any(), guidOff).Return(dto.PowerState{PowerState: cimPowerSoftOff}, nil)
+	mockFeature.EXPECT().GetPowerState(gomock.Any(), guidUnreachable).Return(dto.PowerState{}, errors.New("connection refused"))
+
+	w := getTelemetry(newTelemetryRouter(mockFeature), metricReportsPath+"/"+powerStateReportID)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var report MetricReport
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+	assert.Equal(t, "#MetricReport.v1_4_0.MetricReport", report.ODataType)
+	assert.Equal(t, metricReportsPath+"/PowerState", report.ODataID)
+
+	_, err := time.Parse(time.RFC3339, report.Timestamp)
+	require.NoError(t, err)
+
+	// One value per system in GUID order; an unreadable system reports Unknown
+	expected := []MetricValue{
+		{MetricID: "PowerState", MetricValue: powerStateOn, MetricProperty: "/redfish/v1/Systems/" + guidOn + "#/PowerState", Timestamp: report.Timestamp},
+		{MetricID: "PowerState", MetricValue: powerStateOff, MetricProperty: "/redfish/v1/Systems/" + guidOff + "#/PowerState", Timestamp: report.Timestamp},
+		{MetricID: "PowerState", MetricValue: powerStateUnknown, MetricProperty: "/redfish/v1/Systems/" + guidUnreachable + "#/PowerState", Timestamp: report.Timestamp},
+	}
+	assert.Equal(t, expected, report.MetricValues)
+}
+
+func TestPowerStateMetricReportErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("device list failure", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		mockFeature := mocks.NewMockDeviceManagementFeature(ctrl)
+		mockFeature.EXPECT().Get(gomock.Any(), maxMetricReportSystems, 0, "").Return(nil, context.DeadlineExceeded)
+
+		w := getTelemetry(newTelemetryRouter(mockFeature), metricReportsPath+"/"+powerStateReportID)
+
+		assert.Equal(t, http.StatusGatewayTimeout, w.Code)
+	})
+
+	t.Run("unknown report", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		w := getTelemetry(newTelemetryRouter(mocks.NewMockDeviceManagementFeature(ctrl)), metricReportsPath+"/Thermal")
+
+		require.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), BaseResourceNotFoundID)
+	})
+}
@@ -0,0 +1,176 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package v1 implements Redfish API v1 UpdateService resources.
+package v1
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// UpdateService constants
+const (
+	updateServicePath      = "/redfish/v1/UpdateService"
+	simpleUpdateAction     = "UpdateService.SimpleUpdate"
+	simpleUpdateTarget     = updateServicePath + "/Actions/" + simpleUpdateAction
+	systemsPathPrefix      = "/redfish/v1/Systems/"
+	propertyImageURI       = "ImageURI"
+	propertyTargets        = "Targets"
+	simpleUpdateTaskPrefix = "SimpleUpdate "
+)
+
+// FirmwareUpdater is implemented by device features that can apply a firmware image to a device.
+// The UpdateService only accepts SimpleUpdate requests when the devices feature provides it.
+type FirmwareUpdater interface {
+	UpdateFirmware(ctx context.Context, guid, imageURI string) error
+}
+
+// simpleUpdateRequest is the body of an UpdateService.SimpleUpdate action
+type simpleUpdateRequest struct {
+	ImageURI string   `json:"ImageURI"`
+	Targets  []string `json:"Targets"`
+}
+
+// NewUpdateServiceRoutes registers the Redfish UpdateService routes.
+// It exposes:
+// - GET /redfish/v1/UpdateService
+// - POST /redfish/v1/UpdateService/Actions/UpdateService.SimpleUpdate
+func NewUpdateServiceRoutes(r *gin.RouterGroup, d devices.Feature, store *TaskStore, cfg *config.Config, l logger.Interface) {
+	r.GET("/UpdateService", updateServiceHandler(d))
+	r.POST("/UpdateService/Actions/"+simpleUpdateAction, RequireRole(roleAdministrator), RedfishRateLimitMiddleware(cfg), simpleUpdateHandler(d, store, cfg, l))
+
+	l.Info("Registered Redfish UpdateService routes under %s", r.BasePath()+"/UpdateService")
+}
+
+func updateServiceHandler(d devices.Feature) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		SetRedfishHeaders(c)
+
+		_, canUpdate := d.(FirmwareUpdater)
+		base := linkBase(c)
+
+		payload := map[string]any{
+			"@odata.type":    "#UpdateService.v1_11_0.UpdateService",
+			"@odata.id":      rebaseLink(base, updateServicePath),
+			"Id":             "UpdateService",
+			"Name":           "Update Service",
+			"ServiceEnabled": canUpdate,
+			"Actions": map[string]any{
+				"#" + simpleUpdateAction: map[string]any{
+					"target": rebaseLink(base, simpleUpdateTarget),
+				},
+			},
+		}
+
+		c.JSON(http.StatusOK, payload)
+	}
+}
+
+// simpleUpdateHandler applies a firmware image to each target system and answers with the finished
+// Task. Targets must name systems by their GUID; no update starts when one of them does not.
+func simpleUpdateHandler(d devices.Feature, store *TaskStore, cfg *config.Config, l logger.Interface) gin.HandlerFunc {
+	timeout := deviceTimeout(cfg)
+
+	return func(c *gin.Context) {
+		var body simpleUpdateRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			MalformedJSONError(c, err)
+
+			return
+		}
+
+		if body.ImageURI == "" {
+			PropertyMissingError(c, propertyImageURI)
+
+			return
+		}
+
+		if len(body.Targets) == 0 {
+			PropertyMissingError(c, propertyTargets)
+
+			return
+		}
+
+		systemIDs := make([]string, 0, len(body.Targets))
+
+		for _, target := range body.Targets {
+			systemID, ok := systemIDFromTarget(target, linkBase(c))
+			if !ok || !isSystemID(systemID) {
+				PropertyValueNotInListError(c, target, propertyTargets)
+
+				return
+			}
+
+			systemIDs = append(systemIDs, systemID)
+		}
+
+		updater, ok := d.(FirmwareUpdater)
+		if !ok {
+			ActionNotSupportedError(c, simpleUpdateAction)
+
+			return
+		}
+
+		task := store.Start(simpleUpdateTaskPrefix + body.ImageURI)
+		state, status := TaskStateCompleted, TaskStatusOK
+		messages := make([]map[string]any, 0, len(systemIDs))
+
+		for _, systemID := range systemIDs {
+			ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+			err := updater.UpdateFirmware(ctx, systemID, body.ImageURI)
+
+			cancel()
+
+			if err != nil {
+				l.Error(err, "http - redfish - SimpleUpdate failed for %s [request %s]", systemID, requestID(c))
+
+				state, status = TaskStateException, TaskStatusCritical
+				messages = append(messages, taskMessage(BaseErrorMessageID, "Firmware update failed for system "+systemID+"."))
+
+				continue
+			}
+
+			messages = append(messages, taskMessage(BaseSuccessMessageID, "Firmware update applied to system "+systemID+"."))
+		}
+
+		task, _ = store.Finish(task.ID, state, status, messages...)
+
+		// The updates ran within the request, so the finished Task is answered with 200 rather than 202
+		linked := task.linkedUnder(linkBase(c))
+
+		SetRedfishHeaders(c)
+		c.Header("Location", linked.ODataID)
+		c.JSON(http.StatusOK, linked)
+	}
+}
+
+// systemIDFromTarget extracts the system id from a Systems resource URI such as
+// /redfish/v1/Systems/{id} or /redfish/v1/Systems/{id}/FirmwareInventory/{firmwareId}. Targets
+// copied from links under a configured basePath are accepted too.
+func systemIDFromTarget(target, basePath string) (string, bool) {
+	rest, ok := strings.CutPrefix(target, systemsPathPrefix)
+	if !ok {
+		rest, ok = strings.CutPrefix(target, basePath+"/Systems/")
+	}
+
+	if !ok {
+		return "", false
+	}
+
+	systemID, _, _ := strings.Cut(rest, "/")
+	if systemID == "" {
+		return "", false
+	}
+
+	return systemID, true
+}
@@ -0,0 +1,233 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2025
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/devices"
+)
+
+var errUpdateFailed = errors.New("firmware update failed")
+
+// updatableFeature adds the FirmwareUpdater capability to the devices feature mock
+type updatableFeature struct {
+	*mocks.MockDeviceManagementFeature
+	failFor string
+}
+
+func (f *updatableFeature) UpdateFirmware(_ context.Context, guid, _ string) error {
+	if guid == f.failFor {
+		return errUpdateFailed
+	}
+
+	return nil
+}
+
+func TestUpdateServiceHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		updatable       bool
+		expectedEnabled bool
+	}{
+		{name: "without update capability", updatable: false, expectedEnabled: false},
+		{name: "with update capability", updatable: true, expectedEnabled: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			var feature devices.Feature = mocks.NewMockDeviceManagementFeature(ctrl)
+			if tt.updatable {
+				feature = &updatableFeature{MockDeviceManagementFeature: mocks.NewMockDeviceManagementFeature(ctrl)}
+			}
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/redfish/v1/UpdateService", updateServiceHandler(feature))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, updateServicePath, http.NoBody)
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var body map[string]any
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, "#UpdateService.v1_11_0.UpdateService", body["@odata.type"])
+			assert.Equal(t, tt.expectedEnabled, body["ServiceEnabled"])
+			assert.Contains(t, w.Body.String(), simpleUpdateTarget)
+		})
+	}
+}
+
+func TestSimpleUpdateHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		updatable      bool
+		failFor        string
+		requestBody    string
+		expectedStatus int
+		expectedMsgID  string
+		expectedState  string
+	}{
+		{
+			name:           "well-formed request",
+			updatable:      true,
+			requestBody:    `{"ImageURI":"https://images.example.com/amt.bin","Targets":["/redfish/v1/Systems/` + testSystemGUID + `","/redfish/v1/Systems/` + otherSystemGUID + `/FirmwareInventory/AMT"]}`,
+			expectedStatus: http.StatusOK,
+			expectedState:  TaskStateCompleted,
+		},
+		{
+			name:           "update failure yields exception task",
+			updatable:      true,
+			failFor:        otherSystemGUID,
+			requestBody:    `{"ImageURI":"https://images.example.com/amt.bin","Targets":["/redfish/v1/Systems/` + testSystemGUID + `","/redfish/v1/Systems/` + otherSystemGUID + `"]}`,
+			expectedStatus: http.StatusOK,
+			expectedState:  TaskStateException,
+		},
+		{
+			name:           "well-formed request without update capability",
+			updatable:      false,
+			requestBody:    `{"ImageURI":"https://images.example.com/amt.bin","Targets":["/redfish/v1/Systems/` + testSystemGUID + `"]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BaseActionNotSupportedID,
+		},
+		{
+			name:           "missing ImageURI",
+			updatable:      true,
+			requestBody:    `{"Targets":["/redfish/v1/Systems/` + testSystemGUID + `"]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyMissingID,
+		},
+		{
+			name:           "missing Targets",
+			updatable:      true,
+			requestBody:    `{"ImageURI":"https://images.example.com/amt.bin"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyMissingID,
+		},
+		{
+			name:           "target outside Systems",
+			updatable:      true,
+			requestBody:    `{"ImageURI":"https://images.example.com/amt.bin","Targets":["/redfish/v1/Managers/bmc"]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyValueNotInListID,
+		},
+		{
+			name:           "malformed system id",
+			updatable:      true,
+			requestBody:    `{"ImageURI":"https://images.example.com/amt.bin","Targets":["/redfish/v1/Systems/sys-1"]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BasePropertyValueNotInListID,
+		},
+		{
+			name:           "malformed JSON",
+			updatable:      true,
+			requestBody:    `{"ImageURI":`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMsgID:  BaseMalformedJSONID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+			var feature devices.Feature = mocks.NewMockDeviceManagementFeature(ctrl)
+			if tt.updatable {
+				feature = &updatableFeature{MockDeviceManagementFeature: mocks.NewMockDeviceManagementFeature(ctrl), failFor: tt.failFor}
+			}
+
+			store := NewTaskStore()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST(simpleUpdateTarget, simpleUpdateHandler(feature, store, nil, mockLogger))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, simpleUpdateTarget, strings.NewReader(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedMsgID != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedMsgID)
+				assert.Empty(t, store.List(), "no task should be created for rejected requests")
+
+				return
+			}
+
+			var task Task
+
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &task))
+			assert.Equal(t, tt.expectedState, task.TaskState)
+			assert.Equal(t, task.ODataID, w.Header().Get("Location"))
+			assert.Len(t, task.Messages, 2)
+
+			stored, ok := store.Get(task.ID)
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedState, stored.TaskState)
+		})
+	}
+}
+
+func TestSystemIDFromTarget(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		target   string
+		expected string
+		ok       bool
+	}{
+		{target: "/redfish/v1/Systems/abc", expected: "abc", ok: true},
+		{target: "/redfish/v1/Systems/abc/FirmwareInventory/BIOS", expected: "abc", ok: true},
+		{target: "/redfish/v1/Systems/", ok: false},
+		{target: "/redfish/v1/Chassis/abc", ok: false},
+		{target: "/proxy/redfish/v1/Systems/abc", expected: "abc", ok: true},
+		{target: "/other/Systems/abc", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			t.Parallel()
+
+			systemID, ok := systemIDFromTarget(tt.target, "/proxy/redfish/v1")
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, systemID)
+		})
+	}
+}
@@ -2,6 +2,7 @@
 package http
 
 import (
+	"context"
 	"embed"
 	"io/fs"
 	"log"
@@ -114,17 +115,45 @@ func NewRouter(handler *gin.Engine, l logger.Interface, t usecase.Usecases, cfg
 		v2.NewAmtRoutes(h3, t.Devices, l)
 	}
 
-	// Redfish API v1 routes
-	redfish := handler.Group("/redfish/v1")
+	// Redfish protocol version document
+	redfishv1.NewRedfishProtocolRoutes(handler.Group("/redfish"), cfg, l)
+
+	// Redfish API v1 routes; recovery is installed on the group so that a panic in any Redfish
+	// handler or middleware answers with a Redfish error rather than gin's bare 500
+	redfish := handler.Group("/redfish/v1", redfishv1.RedfishRequestIDMiddleware(), redfishv1.RedfishRecoveryMiddleware(l))
 	{
-		redfishv1.NewServiceRootRoutes(redfish, cfg, l)
-		redfishv1.NewSystemsRoutes(redfish, t.Devices, l)
+		accounts, err := redfishv1.NewAccountStore(context.Background(), cfg, t.RedfishAccounts)
+		if err != nil {
+			l.Error(err, "http - redfish - load persisted accounts")
+		}
+
+		redfishv1.NewServiceRootRoutes(redfish, accounts, cfg, redfishv1.ServiceCapabilities{
+			Systems:          true,
+			TaskService:      true,
+			UpdateService:    true,
+			AccountService:   true,
+			EventService:     true,
+			Registries:       true,
+			TelemetryService: true,
+		}, l)
+		redfishv1.NewSystemsRoutes(redfish, t.Devices, cfg, l)
+		redfishv1.NewTaskServiceRoutes(redfish, redfishv1.DefaultTaskStore, l)
+		redfishv1.NewUpdateServiceRoutes(redfish, t.Devices, redfishv1.DefaultTaskStore, cfg, l)
+		redfishv1.NewAccountServiceRoutes(redfish, accounts, cfg, l)
+		redfishv1.NewEventServiceRoutes(redfish, redfishv1.DefaultSubscriptionStore, cfg, l)
+		redfishv1.NewRegistriesRoutes(redfish, l)
+		redfishv1.NewTelemetryServiceRoutes(redfish, t.Devices, cfg, l)
 	}
 
-	// Catch-all route to serve index.html for any route not matched above to be handled by Angular
-	handler.NoRoute(func(c *gin.Context) {
+	// Catch-all route to serve index.html for any route not matched above to be handled by Angular;
+	// unmatched Redfish paths get a Redfish 404, or a 405 when only the method is wrong
+	spa := func(c *gin.Context) {
 		c.FileFromFS("./", http.FS(staticFiles)) // Serve static files from "/" route
-	})
+	}
+
+	handler.HandleMethodNotAllowed = true
+	handler.NoMethod(redfishv1.RedfishNoMethodHandler(spa))
+	handler.NoRoute(redfishv1.RedfishNoRouteHandler(spa))
 }
 
 func injectConfigToMainJS(l logger.Interface, cfg *config.Config) string {
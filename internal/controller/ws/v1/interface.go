@@ -52,8 +52,10 @@ type Feature interface {
 	SendConsentCode(ctx context.Context, code dto.UserConsentCode, guid string) (dto.UserConsentMessage, error)
 	SendPowerAction(ctx context.Context, guid string, action int) (power.PowerActionResponse, error)
 	SetBootOptions(ctx context.Context, guid string, bootSetting dto.BootSetting) (power.PowerActionResponse, error)
+	ConfigureBootOptions(ctx context.Context, guid string, bootSetting dto.BootSetting) error
 	GetAuditLog(ctx context.Context, startIndex int, guid string) (dto.AuditLog, error)
 	GetEventLog(ctx context.Context, startIndex, maxReadRecords int, guid string) (dto.EventLogs, error)
+	ClearEventLog(ctx context.Context, guid string) error
 	Redirect(ctx context.Context, conn *websocket.Conn, guid, mode string) error
 	GetNetworkSettings(c context.Context, guid string) (dto.NetworkSettings, error)
 	GetCertificates(c context.Context, guid string) (dto.SecuritySettings, error)
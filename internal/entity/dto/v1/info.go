@@ -15,6 +15,7 @@ type HardwareInfo struct {
 	CIMBIOSElement           CIMResponse `json:"CIM_BIOSElement,omitempty"`
 	CIMProcessor             CIMResponse `json:"CIM_Processor,omitempty"`
 	CIMPhysicalMemory        CIMResponse `json:"CIM_PhysicalMemory,omitempty"`
+	CIMPCIDevice             CIMResponse `json:"CIM_PCIDevice,omitempty"`
 }
 
 type DiskInfo struct {
@@ -0,0 +1,9 @@
+package entity
+
+type RedfishAccount struct {
+	UserName     string
+	PasswordHash string
+	RoleID       string
+	Enabled      bool
+	Locked       bool
+}
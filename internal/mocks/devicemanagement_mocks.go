@@ -462,6 +462,34 @@ func (mr *MockDeviceManagementFeatureMockRecorder) CancelUserConsent(ctx, guid a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUserConsent", reflect.TypeOf((*MockDeviceManagementFeature)(nil).CancelUserConsent), ctx, guid)
 }
 
+// ClearEventLog mocks base method.
+func (m *MockDeviceManagementFeature) ClearEventLog(ctx context.Context, guid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearEventLog", ctx, guid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearEventLog indicates an expected call of ClearEventLog.
+func (mr *MockDeviceManagementFeatureMockRecorder) ClearEventLog(ctx, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearEventLog", reflect.TypeOf((*MockDeviceManagementFeature)(nil).ClearEventLog), ctx, guid)
+}
+
+// ConfigureBootOptions mocks base method.
+func (m *MockDeviceManagementFeature) ConfigureBootOptions(ctx context.Context, guid string, bootSetting dto.BootSetting) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureBootOptions", ctx, guid, bootSetting)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfigureBootOptions indicates an expected call of ConfigureBootOptions.
+func (mr *MockDeviceManagementFeatureMockRecorder) ConfigureBootOptions(ctx, guid, bootSetting any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureBootOptions", reflect.TypeOf((*MockDeviceManagementFeature)(nil).ConfigureBootOptions), ctx, guid, bootSetting)
+}
+
 // CreateAlarmOccurrences mocks base method.
 func (m *MockDeviceManagementFeature) CreateAlarmOccurrences(ctx context.Context, guid string, alarm dto.AlarmClockOccurrenceInput) (dto.AddAlarmOutput, error) {
 	m.ctrl.T.Helper()
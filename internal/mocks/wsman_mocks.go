@@ -136,6 +136,20 @@ func (mr *MockManagementMockRecorder) ChangeBootOrder(bootSource any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeBootOrder", reflect.TypeOf((*MockManagement)(nil).ChangeBootOrder), bootSource)
 }
 
+// ClearEventLog mocks base method.
+func (m *MockManagement) ClearEventLog() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearEventLog")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearEventLog indicates an expected call of ClearEventLog.
+func (mr *MockManagementMockRecorder) ClearEventLog() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearEventLog", reflect.TypeOf((*MockManagement)(nil).ClearEventLog))
+}
+
 // CreateAlarmOccurrences mocks base method.
 func (m *MockManagement) CreateAlarmOccurrences(name string, startTime time.Time, interval int, deleteOnCompletion bool) (alarmclock.AddAlarmOutput, error) {
 	m.ctrl.T.Helper()
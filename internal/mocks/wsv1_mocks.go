@@ -153,6 +153,34 @@ func (mr *MockFeatureMockRecorder) CancelUserConsent(ctx, guid any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUserConsent", reflect.TypeOf((*MockFeature)(nil).CancelUserConsent), ctx, guid)
 }
 
+// ClearEventLog mocks base method.
+func (m *MockFeature) ClearEventLog(ctx context.Context, guid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearEventLog", ctx, guid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearEventLog indicates an expected call of ClearEventLog.
+func (mr *MockFeatureMockRecorder) ClearEventLog(ctx, guid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearEventLog", reflect.TypeOf((*MockFeature)(nil).ClearEventLog), ctx, guid)
+}
+
+// ConfigureBootOptions mocks base method.
+func (m *MockFeature) ConfigureBootOptions(ctx context.Context, guid string, bootSetting dto.BootSetting) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureBootOptions", ctx, guid, bootSetting)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfigureBootOptions indicates an expected call of ConfigureBootOptions.
+func (mr *MockFeatureMockRecorder) ConfigureBootOptions(ctx, guid, bootSetting any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureBootOptions", reflect.TypeOf((*MockFeature)(nil).ConfigureBootOptions), ctx, guid, bootSetting)
+}
+
 // CreateAlarmOccurrences mocks base method.
 func (m *MockFeature) CreateAlarmOccurrences(ctx context.Context, guid string, alarm dto.AlarmClockOccurrenceInput) (dto.AddAlarmOutput, error) {
 	m.ctrl.T.Helper()
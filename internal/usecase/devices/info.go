@@ -97,6 +97,7 @@ func (uc *UseCase) hardwareInfoToDTO(hw interface{}) dto.HardwareInfo {
 	result.CIMBIOSElement = uc.parseCIMResponse(hwInfo["CIM_BIOSElement"])
 	result.CIMProcessor = uc.parseCIMResponse(hwInfo["CIM_Processor"])
 	result.CIMPhysicalMemory = uc.parseCIMResponse(hwInfo["CIM_PhysicalMemory"])
+	result.CIMPCIDevice = uc.parseCIMResponse(hwInfo["CIM_PCIDevice"])
 
 	return result
 }
@@ -238,6 +239,21 @@ func (uc *UseCase) GetEventLog(c context.Context, startIndex, maxReadRecords int
 	}, nil
 }
 
+func (uc *UseCase) ClearEventLog(c context.Context, guid string) error {
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return err
+	}
+
+	if item == nil || item.GUID == "" {
+		return ErrNotFound
+	}
+
+	device := uc.device.SetupWsmanClient(*item, false, true)
+
+	return device.ClearEventLog()
+}
+
 func (uc *UseCase) GetGeneralSettings(c context.Context, guid string) (dto.GeneralSettings, error) {
 	item, err := uc.repo.GetByID(c, guid, "")
 	if err != nil {
@@ -498,6 +498,79 @@ func TestGetEventLog(t *testing.T) {
 	}
 }
 
+func TestClearEventLog(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{
+		GUID: "device-guid-123", TenantID: "tenant-id-456",
+	}
+
+	tests := []test{
+		{
+			name: "success",
+			manMock: func(man *mocks.MockWSMAN, man2 *mocks.MockManagement) {
+				man.EXPECT().
+					SetupWsmanClient(gomock.Any(), false, true).
+					Return(man2)
+				man2.EXPECT().
+					ClearEventLog().
+					Return(nil)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(device, nil)
+			},
+			err: nil,
+		},
+		{
+			name:    "device not found",
+			manMock: func(_ *mocks.MockWSMAN, _ *mocks.MockManagement) {},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(nil, nil)
+			},
+			err: devices.ErrNotFound,
+		},
+		{
+			name: "ClearEventLog fails",
+			manMock: func(man *mocks.MockWSMAN, man2 *mocks.MockManagement) {
+				man.EXPECT().
+					SetupWsmanClient(gomock.Any(), false, true).
+					Return(man2)
+				man2.EXPECT().
+					ClearEventLog().
+					Return(ErrGeneral)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(device, nil)
+			},
+			err: ErrGeneral,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase, wsmanMock, management, repo := initInfoTest(t)
+
+			tc.manMock(wsmanMock, management)
+
+			tc.repoMock(repo)
+
+			err := useCase.ClearEventLog(context.Background(), device.GUID)
+
+			require.Equal(t, tc.err, err)
+		})
+	}
+}
+
 func TestGetGeneralSettings(t *testing.T) {
 	t.Parallel()
 
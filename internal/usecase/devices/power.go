@@ -19,6 +19,7 @@ import (
 )
 
 const (
+	BootActionNone              = 0 // clear any boot override
 	BootActionHTTPSBoot         = 105
 	BootActionPowerOnHTTPSBoot  = 106
 	BootActionPBA               = 107
@@ -31,6 +32,7 @@ const (
 	BootActionResetToPXE        = 400
 	BootActionPowerOnToPXE      = 401
 	BootActionResetToDiag       = 301
+	BootActionResetToHDD        = 300 // force hard-drive boot
 	BootActionResetToIDERFloppy = 200
 	OsToFullPower               = 500
 	OsToPowerSaving             = 501
@@ -232,15 +234,51 @@ func (uc *UseCase) SetBootOptions(c context.Context, guid string, bootSetting dt
 
 	device := uc.device.SetupWsmanClient(*item, false, true)
 
-	bootData, err := device.GetBootData()
+	err = uc.applyBootSettings(device, guid, bootSetting)
+	if err != nil {
+		return power.PowerActionResponse{}, err
+	}
+
+	// reset
+	// power on
+	determineBootAction(&bootSetting)
+
+	powerActionResult, err := device.SendPowerAction(bootSetting.Action)
 	if err != nil {
 		return power.PowerActionResponse{}, err
 	}
 
+	return powerActionResult, nil
+}
+
+// ConfigureBootOptions writes the boot configuration for the given boot action without
+// sending a power action, so the override takes effect on the next boot of the device.
+// BootActionNone clears any pending override.
+func (uc *UseCase) ConfigureBootOptions(c context.Context, guid string, bootSetting dto.BootSetting) error {
+	item, err := uc.repo.GetByID(c, guid, "")
+	if err != nil {
+		return err
+	}
+
+	if item == nil || item.GUID == "" {
+		return ErrNotFound
+	}
+
+	device := uc.device.SetupWsmanClient(*item, false, true)
+
+	return uc.applyBootSettings(device, guid, bootSetting)
+}
+
+func (uc *UseCase) applyBootSettings(device wsman.Management, guid string, bootSetting dto.BootSetting) error {
+	bootData, err := device.GetBootData()
+	if err != nil {
+		return err
+	}
+
 	newData := boot.BootSettingDataRequest{
 		BIOSLastStatus:         bootData.BIOSLastStatus,
 		BIOSPause:              false,
-		BIOSSetup:              bootSetting.Action < 104,
+		BIOSSetup:              bootSetting.Action != BootActionNone && bootSetting.Action < 104,
 		BootMediaIndex:         0,
 		BootguardStatus:        bootData.BootguardStatus,
 		ConfigurationDataReset: false,
@@ -269,40 +307,28 @@ func (uc *UseCase) SetBootOptions(c context.Context, guid string, bootSetting dt
 	// boot on floppy
 	err = determineBootDevice(bootSetting, &newData)
 	if err != nil {
-		return power.PowerActionResponse{}, err
+		return err
 	}
 
 	_, err = device.ChangeBootOrder("")
 	if err != nil {
-		return power.PowerActionResponse{}, err
+		return err
 	}
 
 	_, err = device.SetBootData(newData)
 	if err != nil {
-		return power.PowerActionResponse{}, err
+		return err
 	}
 
 	// set boot config role
 	_, err = device.SetBootConfigRole(1)
 	if err != nil {
-		return power.PowerActionResponse{}, err
+		return err
 	}
 
 	_, err = device.ChangeBootOrder(bootSource)
-	if err != nil {
-		return power.PowerActionResponse{}, err
-	}
-
-	// reset
-	// power on
-	determineBootAction(&bootSetting)
 
-	powerActionResult, err := device.SendPowerAction(bootSetting.Action)
-	if err != nil {
-		return power.PowerActionResponse{}, err
-	}
-
-	return powerActionResult, nil
+	return err
 }
 
 func determineBootDevice(bootSetting dto.BootSetting, newData *boot.BootSettingDataRequest) error {
@@ -459,6 +485,8 @@ func (uc *UseCase) getBootSource(guid string, bootSetting *dto.BootSetting) stri
 		return string(cimBoot.PXE)
 	case BootActionResetToIDERCDROM, BootActionPowerOnIDERCDROM:
 		return string(cimBoot.CD)
+	case BootActionResetToHDD:
+		return string(cimBoot.HardDrive)
 	case BootActionHTTPSBoot, BootActionPowerOnHTTPSBoot:
 		return string(cimBoot.OCRUEFIHTTPS)
 	case BootActionPBA, BootActionPowerOnPBA:
@@ -515,7 +543,7 @@ func determineBootAction(bootSetting *dto.BootSetting) {
 	switch bootSetting.Action {
 	case BootActionResetToBIOS, BootActionHTTPSBoot, BootActionResetToIDERFloppy,
 		BootActionResetToIDERCDROM, BootActionResetToDiag, BootActionResetToPXE,
-		BootActionPBA, BootActionWinREBoot:
+		BootActionResetToHDD, BootActionPBA, BootActionWinREBoot:
 		bootSetting.Action = int(power.MasterBusReset)
 	default:
 		bootSetting.Action = int(power.PowerOn)
@@ -112,6 +112,13 @@ func TestGetBootSource(t *testing.T) {
 				Action: 202,
 			},
 		},
+		{
+			name: "Action 300",
+			res:  string(cimBoot.HardDrive),
+			bootSettings: dto.BootSetting{
+				Action: 300,
+			},
+		},
 		{
 			name: "Action 999",
 			res:  "",
@@ -145,6 +152,13 @@ func TestDetermineBootAction(t *testing.T) {
 				Action: 200,
 			},
 		},
+		{
+			name: "Master Bus Reset to HDD",
+			res:  10,
+			bootSettings: dto.BootSetting{
+				Action: BootActionResetToHDD,
+			},
+		},
 		{
 			name: "Power On",
 			res:  2,
@@ -747,6 +747,120 @@ func TestSetBootOptions(t *testing.T) {
 	}
 }
 
+func TestConfigureBootOptions(t *testing.T) {
+	t.Parallel()
+
+	device := &entity.Device{
+		GUID:     "device-guid-123",
+		TenantID: "tenant-id-456",
+	}
+
+	tests := []test{
+		{
+			name:   "success without power action",
+			action: devices.BootActionResetToHDD,
+			manMock: func(man *mocks.MockWSMAN, hmm *mocks.MockManagement) {
+				man.EXPECT().
+					SetupWsmanClient(gomock.Any(), false, true).
+					Return(hmm)
+				hmm.EXPECT().
+					GetBootData().
+					Return(boot.BootSettingDataResponse{}, nil)
+				hmm.EXPECT().
+					ChangeBootOrder("").
+					Return(cimBoot.ChangeBootOrder_OUTPUT{}, nil)
+				hmm.EXPECT().
+					SetBootData(gomock.Any()).
+					Return(nil, nil)
+				hmm.EXPECT().
+					SetBootConfigRole(1).
+					Return(power.PowerActionResponse{}, nil)
+				hmm.EXPECT().
+					ChangeBootOrder(string(cimBoot.HardDrive)).
+					Return(cimBoot.ChangeBootOrder_OUTPUT{}, nil)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(device, nil)
+			},
+			err: nil,
+		},
+		{
+			name:   "clear override",
+			action: devices.BootActionNone,
+			manMock: func(man *mocks.MockWSMAN, hmm *mocks.MockManagement) {
+				man.EXPECT().
+					SetupWsmanClient(gomock.Any(), false, true).
+					Return(hmm)
+				hmm.EXPECT().
+					GetBootData().
+					Return(boot.BootSettingDataResponse{}, nil)
+				hmm.EXPECT().
+					ChangeBootOrder("").
+					Return(cimBoot.ChangeBootOrder_OUTPUT{}, nil).
+					Times(2)
+				hmm.EXPECT().
+					SetBootData(gomock.Cond(func(data boot.BootSettingDataRequest) bool {
+						return !data.BIOSSetup && !data.UseIDER
+					})).
+					Return(nil, nil)
+				hmm.EXPECT().
+					SetBootConfigRole(1).
+					Return(power.PowerActionResponse{}, nil)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(device, nil)
+			},
+			err: nil,
+		},
+		{
+			name:    "device not found",
+			manMock: func(_ *mocks.MockWSMAN, _ *mocks.MockManagement) {},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(nil, nil)
+			},
+			err: devices.ErrNotFound,
+		},
+		{
+			name: "GetBootData fails",
+			manMock: func(man *mocks.MockWSMAN, hmm *mocks.MockManagement) {
+				man.EXPECT().
+					SetupWsmanClient(gomock.Any(), false, true).
+					Return(hmm)
+				hmm.EXPECT().
+					GetBootData().
+					Return(boot.BootSettingDataResponse{}, ErrGeneral)
+			},
+			repoMock: func(repo *mocks.MockDeviceManagementRepository) {
+				repo.EXPECT().
+					GetByID(context.Background(), device.GUID, "").
+					Return(device, nil)
+			},
+			err: ErrGeneral,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase, wsmanMock, management, repo := initPowerTest(t)
+			tc.manMock(wsmanMock, management)
+			tc.repoMock(repo)
+
+			err := useCase.ConfigureBootOptions(context.Background(), device.GUID, dto.BootSetting{Action: tc.action})
+
+			require.Equal(t, tc.err, err)
+		})
+	}
+}
+
 func TestGetBootSourceSetting(t *testing.T) {
 	t.Parallel()
 
@@ -58,6 +58,7 @@ type Management interface {
 	ChangeBootOrder(bootSource string) (cimBoot.ChangeBootOrder_OUTPUT, error)
 	GetAuditLog(startIndex int) (auditlog.Response, error)
 	GetEventLog(startIndex, maxReadRecords int) (messagelog.GetRecordsResponse, error)
+	ClearEventLog() error
 	GetNetworkSettings() (NetworkResults, error)
 	GetCertificates() (Certificates, error)
 	GetTLSSettingData() ([]tls.SettingDataResponse, error)
@@ -2,6 +2,9 @@ package wsman
 
 import (
 	gotls "crypto/tls"
+	"encoding/xml"
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -18,6 +21,7 @@ import (
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/kerberos"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/managementpresence"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
+	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/mps"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/publickey"
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/wsman/amt/publicprivate"
@@ -65,8 +69,22 @@ import (
 const (
 	deviceCallBuffer = 100
 	maxReadRecords   = 390
+	clearLogMethod   = "ClearLog"
 )
 
+// ErrClearLogFailed is returned when AMT rejects an AMT_MessageLog ClearLog request.
+var ErrClearLogFailed = errors.New("AMT_MessageLog ClearLog failed")
+
+// clearLogResponse is the body of an AMT_MessageLog ClearLog response.
+type clearLogResponse struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		Output struct {
+			ReturnValue int `xml:"ReturnValue"`
+		} `xml:"ClearLog_OUTPUT"`
+	} `xml:"Body"`
+}
+
 var (
 	connections         = make(map[string]*ConnectionEntry)
 	connectionsMu       sync.Mutex
@@ -645,6 +663,32 @@ func (g *ConnectionEntry) GetEventLog(startIndex, maxReadRecords int) (messagelo
 	return response.Body.GetRecordsResponse, nil
 }
 
+// ClearEventLog invokes the CIM ClearLog method on AMT_MessageLog. The wsman library does not wrap
+// this method, so the request is built with the message log service's own message creator.
+func (g *ConnectionEntry) ClearEventLog() error {
+	messageLog := g.WsmanMessages.AMT.MessageLog
+	creator := messageLog.Base.WSManMessageCreator
+
+	header := creator.CreateHeader(methods.GenerateAction(messagelog.AMTMessageLog, clearLogMethod), messagelog.AMTMessageLog, nil, "", "")
+	body := creator.CreateBody(methods.GenerateInputMethod(clearLogMethod), messagelog.AMTMessageLog, nil)
+	message := &client.Message{XMLInput: creator.CreateXML(header, body)}
+
+	if err := messageLog.Base.Execute(message); err != nil {
+		return err
+	}
+
+	var response clearLogResponse
+	if err := xml.Unmarshal([]byte(message.XMLOutput), &response); err != nil {
+		return err
+	}
+
+	if response.Body.Output.ReturnValue != 0 {
+		return fmt.Errorf("%w: return value %d", ErrClearLogFailed, response.Body.Output.ReturnValue)
+	}
+
+	return nil
+}
+
 func (g *ConnectionEntry) SendPowerAction(action int) (power.PowerActionResponse, error) {
 	response, err := g.WsmanMessages.CIM.PowerManagementService.RequestPowerStateChange(power.PowerState(action))
 	if err != nil {
@@ -0,0 +1,87 @@
+package sqldb
+
+import (
+	"context"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/pkg/consoleerrors"
+	"github.com/device-management-toolkit/console/pkg/db"
+	"github.com/device-management-toolkit/console/pkg/logger"
+)
+
+// RedfishAccountRepo persists the manager accounts created through the Redfish AccountService.
+type RedfishAccountRepo struct {
+	*db.SQL
+	log logger.Interface
+}
+
+var (
+	ErrRedfishAccountDatabase  = DatabaseError{Console: consoleerrors.CreateConsoleError("RedfishAccountRepo")}
+	ErrRedfishAccountNotUnique = NotUniqueError{Console: consoleerrors.CreateConsoleError("RedfishAccountRepo")}
+)
+
+// NewRedfishAccountRepo -.
+func NewRedfishAccountRepo(database *db.SQL, log logger.Interface) *RedfishAccountRepo {
+	return &RedfishAccountRepo{database, log}
+}
+
+// Get returns every stored account ordered by user name.
+func (r *RedfishAccountRepo) Get(_ context.Context) ([]entity.RedfishAccount, error) {
+	sqlQuery, _, err := r.Builder.
+		Select("user_name", "password_hash", "role_id", "enabled", "locked").
+		From("redfish_accounts").
+		OrderBy("user_name").
+		ToSql()
+	if err != nil {
+		return nil, ErrRedfishAccountDatabase.Wrap("Get", "r.Builder", err)
+	}
+
+	rows, err := r.Pool.QueryContext(context.Background(), sqlQuery)
+	if err != nil {
+		return nil, ErrRedfishAccountDatabase.Wrap("Get", "r.Pool.Query", err)
+	}
+
+	defer rows.Close()
+
+	if rows.Err() != nil {
+		return nil, ErrRedfishAccountDatabase.Wrap("Get", "rows.Err", rows.Err())
+	}
+
+	accounts := make([]entity.RedfishAccount, 0)
+
+	for rows.Next() {
+		a := entity.RedfishAccount{}
+
+		err = rows.Scan(&a.UserName, &a.PasswordHash, &a.RoleID, &a.Enabled, &a.Locked)
+		if err != nil {
+			return nil, ErrRedfishAccountDatabase.Wrap("Get", "rows.Scan", err)
+		}
+
+		accounts = append(accounts, a)
+	}
+
+	return accounts, nil
+}
+
+// Insert stores a new account and reports a NotUniqueError when the user name is taken.
+func (r *RedfishAccountRepo) Insert(_ context.Context, a *entity.RedfishAccount) error {
+	sqlQuery, args, err := r.Builder.
+		Insert("redfish_accounts").
+		Columns("user_name", "password_hash", "role_id", "enabled", "locked").
+		Values(a.UserName, a.PasswordHash, a.RoleID, a.Enabled, a.Locked).
+		ToSql()
+	if err != nil {
+		return ErrRedfishAccountDatabase.Wrap("Insert", "r.Builder", err)
+	}
+
+	_, err = r.Pool.ExecContext(context.Background(), sqlQuery, args...)
+	if err != nil {
+		if db.CheckNotUnique(err) {
+			return ErrRedfishAccountNotUnique.Wrap(err.Error())
+		}
+
+		return ErrRedfishAccountDatabase.Wrap("Insert", "r.Pool.Exec", err)
+	}
+
+	return nil
+}
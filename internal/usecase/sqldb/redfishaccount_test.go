@@ -0,0 +1,88 @@
+package sqldb_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/device-management-toolkit/console/internal/entity"
+	"github.com/device-management-toolkit/console/internal/mocks"
+	"github.com/device-management-toolkit/console/internal/usecase/sqldb"
+)
+
+func setupRedfishAccountTable(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dbConn, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	_, err = dbConn.ExecContext(context.Background(), `
+		CREATE TABLE redfish_accounts (
+			user_name TEXT NOT NULL,
+			password_hash TEXT NOT NULL,
+			role_id TEXT NOT NULL,
+			enabled BOOLEAN NOT NULL,
+			locked BOOLEAN NOT NULL,
+			PRIMARY KEY (user_name)
+		);
+	`)
+	require.NoError(t, err)
+
+	return dbConn
+}
+
+func TestRedfishAccountRepo_InsertAndGet(t *testing.T) {
+	t.Parallel()
+
+	dbConn := setupRedfishAccountTable(t)
+	defer dbConn.Close()
+
+	repo := sqldb.NewRedfishAccountRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+
+	operator := entity.RedfishAccount{UserName: "operator1", PasswordHash: "hash1", RoleID: "Operator", Enabled: true}
+	reader := entity.RedfishAccount{UserName: "auditor", PasswordHash: "hash2", RoleID: "ReadOnly", Enabled: true, Locked: true}
+
+	require.NoError(t, repo.Insert(context.Background(), &operator))
+	require.NoError(t, repo.Insert(context.Background(), &reader))
+
+	accounts, err := repo.Get(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []entity.RedfishAccount{reader, operator}, accounts)
+}
+
+func TestRedfishAccountRepo_InsertDuplicate(t *testing.T) {
+	t.Parallel()
+
+	dbConn := setupRedfishAccountTable(t)
+	defer dbConn.Close()
+
+	repo := sqldb.NewRedfishAccountRepo(CreateSQLConfig(dbConn, false), mocks.NewMockLogger(nil))
+
+	account := entity.RedfishAccount{UserName: "operator1", PasswordHash: "hash1", RoleID: "Operator", Enabled: true}
+	require.NoError(t, repo.Insert(context.Background(), &account))
+
+	err := repo.Insert(context.Background(), &account)
+
+	var notUnique sqldb.NotUniqueError
+
+	assert.True(t, errors.As(err, &notUnique), "expected NotUniqueError, got %v", err)
+}
+
+func TestRedfishAccountRepo_QueryError(t *testing.T) {
+	t.Parallel()
+
+	dbConn := setupRedfishAccountTable(t)
+	defer dbConn.Close()
+
+	repo := sqldb.NewRedfishAccountRepo(CreateSQLConfig(dbConn, true), mocks.NewMockLogger(nil))
+
+	err := repo.Insert(context.Background(), &entity.RedfishAccount{UserName: "operator1"})
+
+	var dbErr sqldb.DatabaseError
+
+	assert.True(t, errors.As(err, &dbErr), "expected DatabaseError, got %v", err)
+}
@@ -1,9 +1,12 @@
 package usecase
 
 import (
+	"context"
+
 	"github.com/device-management-toolkit/go-wsman-messages/v2/pkg/security"
 
 	"github.com/device-management-toolkit/console/config"
+	"github.com/device-management-toolkit/console/internal/entity"
 	"github.com/device-management-toolkit/console/internal/usecase/amtexplorer"
 	"github.com/device-management-toolkit/console/internal/usecase/ciraconfigs"
 	"github.com/device-management-toolkit/console/internal/usecase/devices"
@@ -19,6 +22,12 @@ import (
 	"github.com/device-management-toolkit/console/pkg/logger"
 )
 
+// RedfishAccountRepository persists the accounts created through the Redfish AccountService.
+type RedfishAccountRepository interface {
+	Get(ctx context.Context) ([]entity.RedfishAccount, error)
+	Insert(ctx context.Context, a *entity.RedfishAccount) error
+}
+
 // Usecases -.
 type Usecases struct {
 	Devices            devices.Feature
@@ -30,6 +39,7 @@ type Usecases struct {
 	CIRAConfigs        ciraconfigs.Feature
 	WirelessProfiles   wificonfigs.Feature
 	Exporter           export.Exporter
+	RedfishAccounts    RedfishAccountRepository
 }
 
 // New -.
@@ -61,5 +71,6 @@ func NewUseCases(database *db.SQL, log logger.Interface) *Usecases {
 		WirelessProfiles:   wificonfig,
 		ProfileWiFiConfigs: pwc,
 		Exporter:           export.NewFileExporter(),
+		RedfishAccounts:    sqldb.NewRedfishAccountRepo(database, log),
 	}
 }
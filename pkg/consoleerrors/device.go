@@ -0,0 +1,16 @@
+package consoleerrors
+
+import "errors"
+
+// Sentinel errors a device call can wrap so that callers classify the failure without
+// inspecting the message, e.g. fmt.Errorf("%w: %w", consoleerrors.ErrUpstreamUnavailable, err)
+var (
+	// ErrUpstreamTimeout means the device did not answer in time
+	ErrUpstreamTimeout = errors.New("upstream device timed out")
+	// ErrUpstreamUnavailable means the device could not be reached
+	ErrUpstreamUnavailable = errors.New("upstream device unavailable")
+	// ErrDeviceAuthentication means the device rejected the stored credentials
+	ErrDeviceAuthentication = errors.New("device authentication failed")
+	// ErrServiceOverloaded means the call was refused because the service or device is busy
+	ErrServiceOverloaded = errors.New("service overloaded")
+)
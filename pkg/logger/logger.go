@@ -18,6 +18,14 @@ type Interface interface {
 	Fatal(message interface{}, args ...interface{})
 }
 
+// FieldLogger is implemented by loggers that can attach key/value fields to an entry instead of
+// formatting them into the message, so that log aggregators can index them.
+type FieldLogger interface {
+	InfoFields(message string, fields map[string]any)
+	WarnFields(message string, fields map[string]any)
+	ErrorFields(message string, fields map[string]any)
+}
+
 // logger -.
 type logger struct {
 	logger *zerolog.Logger
@@ -110,6 +118,21 @@ func (l *logger) Fatal(message interface{}, args ...any) {
 	os.Exit(1)
 }
 
+// InfoFields -.
+func (l *logger) InfoFields(message string, fields map[string]any) {
+	l.logger.Info().Fields(fields).Msg(message)
+}
+
+// WarnFields -.
+func (l *logger) WarnFields(message string, fields map[string]any) {
+	l.logger.Warn().Fields(fields).Msg(message)
+}
+
+// ErrorFields -.
+func (l *logger) ErrorFields(message string, fields map[string]any) {
+	l.logger.Error().Fields(fields).Msg(message)
+}
+
 func (l *logger) log(e *zerolog.Event, m string, args ...any) {
 	if len(args) == 0 {
 		e.Msg(m)
@@ -3,6 +3,7 @@ package logger
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -153,3 +154,54 @@ func TestNewLogger(t *testing.T) {
 		})
 	}
 }
+
+func TestFieldLogger(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		log           func(FieldLogger)
+		expectedLevel string
+	}{
+		{
+			name:          "info",
+			log:           func(l FieldLogger) { l.InfoFields("request", map[string]any{"status": 200, "path": "/x"}) },
+			expectedLevel: levelInfo,
+		},
+		{
+			name:          "warn",
+			log:           func(l FieldLogger) { l.WarnFields("request", map[string]any{"status": 200, "path": "/x"}) },
+			expectedLevel: levelWarn,
+		},
+		{
+			name:          "error",
+			log:           func(l FieldLogger) { l.ErrorFields("request", map[string]any{"status": 200, "path": "/x"}) },
+			expectedLevel: levelError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			zl := zerolog.New(&buf).Level(zerolog.DebugLevel)
+
+			var log Interface = &logger{logger: &zl}
+
+			fieldLogger, ok := log.(FieldLogger)
+			require.True(t, ok)
+
+			tc.log(fieldLogger)
+
+			var entry map[string]any
+
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+			assert.Equal(t, tc.expectedLevel, entry["level"])
+			assert.Equal(t, "request", entry["message"])
+			assert.InDelta(t, 200, entry["status"], 0)
+			assert.Equal(t, "/x", entry["path"])
+		})
+	}
+}